@@ -16,13 +16,23 @@ package geocoding
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/oursportsnation/k-geocode/internal/cache"
+	"github.com/oursportsnation/k-geocode/internal/model"
 	"github.com/oursportsnation/k-geocode/internal/provider"
 	"github.com/oursportsnation/k-geocode/internal/service"
+	"github.com/oursportsnation/k-geocode/internal/utils"
 	"github.com/oursportsnation/k-geocode/pkg/httpclient"
 	"github.com/oursportsnation/k-geocode/pkg/logger"
+
+	"golang.org/x/time/rate"
 )
 
 // Client is the k-geocode geocoding client that provides unified access
@@ -31,11 +41,19 @@ type Client struct {
 	service   *service.GeocodingService
 	providers []provider.GeocodingProvider
 	config    Config
+	lifecycle *service.Lifecycle // Close 시 정리할 구성 요소 (nil이면 정리할 것 없음)
 }
 
 // New creates a new geocoding client with the given configuration.
 // At least one API key (VWorldAPIKey or KakaoAPIKey) must be provided.
-func New(cfg Config) (*Client, error) {
+// Options are applied to cfg before it is validated.
+func New(cfg Config, opts ...Option) (*Client, error) {
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, fmt.Errorf("invalid option: %w", err)
+		}
+	}
+
 	// 설정 검증
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
@@ -52,6 +70,10 @@ func New(cfg Config) (*Client, error) {
 
 	// HTTP 클라이언트 생성
 	httpClient := httpclient.NewClient(cfg.Timeout)
+	httpClient.MaxRetries = cfg.MaxRetries
+	httpClient.UserAgent = cfg.UserAgent
+	// 호출자가 나중에 맵을 바꿔도 진행 중인 요청과 경합하지 않도록 복사
+	httpClient.Headers = maps.Clone(cfg.DefaultHeaders)
 
 	// Provider들 초기화
 	var providers []provider.GeocodingProvider
@@ -65,6 +87,15 @@ func New(cfg Config) (*Client, error) {
 				continue
 			}
 			vworldProvider := provider.NewVWorldProvider(key, httpClient, log)
+			vworldProvider.SetClock(cfg.Clock)
+			if err := vworldProvider.SetAddressTypes(toStrings(cfg.VWorldAddressTypes)); err != nil {
+				return nil, fmt.Errorf("vWorld provider: %w", err)
+			}
+			if cfg.VWorldBaseURL != "" {
+				if err := vworldProvider.SetBaseURL(cfg.VWorldBaseURL); err != nil {
+					return nil, fmt.Errorf("vWorld provider: %w", err)
+				}
+			}
 			providers = append(providers, vworldProvider)
 			log.Info(fmt.Sprintf("vWorld provider #%d registered", i+1))
 		}
@@ -73,6 +104,17 @@ func New(cfg Config) (*Client, error) {
 	// Kakao Provider
 	if cfg.KakaoAPIKey != "" {
 		kakaoProvider := provider.NewKakaoProvider(cfg.KakaoAPIKey, httpClient, log)
+		kakaoProvider.SetClock(cfg.Clock)
+		kakaoProvider.SetKeywordFallback(cfg.KakaoKeywordFallback)
+		kakaoProvider.SetExactFallback(cfg.KakaoExactFallback)
+		if err := kakaoProvider.SetAnalyzeType(string(cfg.KakaoAnalyzeType)); err != nil {
+			return nil, fmt.Errorf("Kakao provider: %w", err)
+		}
+		if cfg.KakaoBaseURL != "" {
+			if err := kakaoProvider.SetBaseURL(cfg.KakaoBaseURL); err != nil {
+				return nil, fmt.Errorf("Kakao provider: %w", err)
+			}
+		}
 		providers = append(providers, kakaoProvider)
 	}
 
@@ -80,19 +122,92 @@ func New(cfg Config) (*Client, error) {
 		return nil, fmt.Errorf("at least one API key (VWorld or Kakao) is required")
 	}
 
+	// 도로명주소 API - 선택 사항, 좌표가 없으므로 Provider가 아닌 정규화 단계로 사용
+	var normalizer provider.AddressNormalizer
+	if cfg.JusoAPIKey != "" {
+		normalizer = provider.NewJusoProvider(cfg.JusoAPIKey, httpClient, log)
+	}
+
+	// Nominatim Provider - 선택 사항, 항상 마지막 순서
+	if cfg.NominatimBaseURL != "" {
+		nominatimProvider, err := provider.NewNominatimProvider(cfg.NominatimBaseURL, httpClient, log)
+		if err != nil {
+			return nil, fmt.Errorf("Nominatim provider: %w", err)
+		}
+		if err := nominatimProvider.SetUserAgent(cfg.NominatimUserAgent); err != nil {
+			return nil, fmt.Errorf("Nominatim provider: %w", err)
+		}
+		nominatimProvider.SetClock(cfg.Clock)
+		providers = append(providers, nominatimProvider)
+	}
+
+	// 로컬 데이터셋 Provider - 선택 사항, 외부 호출이 없으므로 항상 맨 앞 순서
+	if cfg.LocalDatasetPath != "" {
+		localProvider, err := provider.NewLocalProvider(cfg.LocalDatasetPath)
+		if err != nil {
+			return nil, fmt.Errorf("Local provider: %w", err)
+		}
+		localProvider.SetClock(cfg.Clock)
+		providers = append([]provider.GeocodingProvider{localProvider}, providers...)
+		log.Info(fmt.Sprintf("Local provider registered (%d indexed addresses)", localProvider.Len()))
+	}
+
+	for sido, name := range cfg.RegionProviders {
+		if !hasProviderNamed(providers, name) {
+			return nil, fmt.Errorf("regionProviders[%s]: unknown provider: %s", sido, name)
+		}
+	}
+
 	// 지오코딩 서비스 생성
-	geocodingService := service.NewGeocodingService(providers, log)
+	resultCache := newCache(cfg.CacheTTL, cfg.CacheSize, cfg.Clock)
+	geocodingService := service.NewGeocodingServiceWithOptions(providers, log, service.Options{
+		AdminCodeLength:          cfg.AdminCodeLength,
+		CoordinatePrecision:      cfg.CoordinatePrecision,
+		ConcurrentLimit:          cfg.ConcurrentLimit,
+		ReverseConcurrentLimit:   cfg.ReverseConcurrentLimit,
+		Normalizer:               normalizer,
+		BatchItemTimeout:         cfg.BatchItemTimeout,
+		Limiter:                  newLimiter(cfg.RateLimit, cfg.RateBurst),
+		ProviderLimiters:         newProviderLimiters(cfg.ProviderRateLimits, cfg.RateBurst),
+		EnforceKoreanBounds:      cfg.EnforceKoreanBounds,
+		KoreanBounds:             toUtilsBounds(cfg.KoreanBounds),
+		Debug:                    cfg.Debug,
+		SequentialBatchThreshold: cfg.SequentialBatchThreshold,
+		FallbackAfter:            cfg.FallbackAfter,
+		Strategy:                 service.Strategy(cfg.Strategy),
+		AdaptiveWindow:           cfg.AdaptiveWindow,
+		DailyLimits:              maps.Clone(cfg.ProviderDailyLimits),
+		RegionFallback:           cfg.RegionFallback,
+		BuildingNameFallback:     cfg.BuildingNameFallback,
+		RegionProviders:          cfg.RegionProviders,
+		StopOnNotFound:           cfg.StopOnNotFound,
+		Preprocessors:            toPreprocessors(cfg.Preprocessors),
+		MaxAddressLength:         cfg.MaxAddressLength,
+		MinConfidence:            cfg.MinConfidence,
+		DisableBackoff:           cfg.RateLimitBackoff,
+		DisableBackoffReset:      cfg.RateLimitBackoffReset,
+		Clock:                    cfg.Clock,
+		Timeout:                  cfg.Timeout,
+		ProviderTimeout:          cfg.ProviderTimeout,
+		MinAttemptBudget:         cfg.MinAttemptBudget,
+		Cache:                    resultCache,
+	})
 
 	return &Client{
 		service:   geocodingService,
 		providers: providers,
 		config:    cfg,
+		lifecycle: service.NewLifecycle(geocodingService, providers, resultCache, httpClient),
 	}, nil
 }
 
 // Geocode converts a Korean address to WGS84 coordinates.
 // It automatically falls back through providers (vWorld → Kakao) and
 // address types (ROAD → PARCEL) until a result is found.
+//
+// Geocode is safe for concurrent use. When [Config.RateLimit] or
+// [Config.ProviderRateLimits] is set, it may block waiting for a rate-limit
+// token up to the context deadline.
 func (c *Client) Geocode(ctx context.Context, address string) (*Result, error) {
 	return c.GeocodeWithType(ctx, address, "")
 }
@@ -102,49 +217,271 @@ func (c *Client) Geocode(ctx context.Context, address string) (*Result, error) {
 //
 // Use [AddressTypeRoad] for road-based addresses (도로명) or
 // [AddressTypeParcel] for parcel-based addresses (지번).
-// Pass an empty string to automatically try ROAD then PARCEL.
+// Pass an empty string to detect the likely type from the address and try
+// it first, falling back to the other type only if the first fails.
 func (c *Client) GeocodeWithType(ctx context.Context, address string, addressType AddressType) (*Result, error) {
-	resp, err := c.service.Geocode(ctx, address, string(addressType))
+	return c.GeocodeWithOptions(ctx, address, GeocodeOptions{AddressType: addressType})
+}
+
+// GeocodeWithOptions converts a Korean address to WGS84 coordinates,
+// overriding client defaults for this call only. Zero-valued fields in opts
+// fall back to the client configuration.
+//
+// It returns an error if opts.Providers names a provider that is not
+// configured on the client.
+func (c *Client) GeocodeWithOptions(ctx context.Context, address string, opts GeocodeOptions) (*Result, error) {
+	result, err := c.geocode(ctx, address, opts)
+	if errors.Is(err, errGeocodingFailed) && ctx.Err() == nil {
+		if fallback := c.fallbackResult(address, opts); fallback != nil {
+			result, err = fallback, nil
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if err := c.runResultHook(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// errGeocodingFailed marks a lookup in which every provider failed, the only
+// failure that [Config.FallbackCoordinate] replaces. Lookups cut short by the
+// caller's context or [GeocodeOptions.Timeout] return the context error
+// instead.
+var errGeocodingFailed = errors.New("geocoding failed")
+
+// fallbackResult returns the configured fallback for an address no provider
+// matched, or nil when none applies.
+func (c *Client) fallbackResult(address string, opts GeocodeOptions) *Result {
+	if crs, err := provider.ParseVWorldCRS(opts.CRS); err != nil || crs != provider.CRSWGS84 {
+		return nil
+	}
+	if c.config.FallbackToRegionCenter {
+		if lat, lng, ok := utils.SidoOffice(c.service.NormalizeAddress(address)); ok {
+			return &Result{Latitude: lat, Longitude: lng, MatchLevel: MatchLevelRegion, IsFallback: true}
+		}
+	}
+	if p := c.config.FallbackCoordinate; p != nil {
+		return &Result{Latitude: p.Latitude, Longitude: p.Longitude, IsFallback: true}
+	}
+	return nil
+}
+
+// geocode converts address like [Client.GeocodeWithOptions] without running
+// [Config.ResultHook], for methods that combine several lookups.
+func (c *Client) geocode(ctx context.Context, address string, opts GeocodeOptions) (*Result, error) {
+	for _, name := range opts.Providers {
+		if !c.hasProvider(name) {
+			return nil, fmt.Errorf("unknown provider: %s", name)
+		}
+	}
+	if _, err := utils.NormalizeAddressType(string(opts.AddressType)); err != nil {
+		return nil, err
+	}
+	if _, err := provider.ParseKakaoAnalyzeType(string(opts.KakaoAnalyzeType)); err != nil {
+		return nil, err
+	}
+	addressTypes, err := provider.ParseAddressTypes(toStrings(opts.AddressTypes))
+	if err != nil {
+		return nil, err
+	}
+	crs, err := provider.ParseVWorldCRS(opts.CRS)
+	if err != nil {
+		return nil, err
+	}
+	var bias *provider.Bias
+	if opts.Bias != nil {
+		bias = &provider.Bias{
+			Center: model.Coordinate{Latitude: opts.Bias.Latitude, Longitude: opts.Bias.Longitude},
+			Radius: opts.BiasRadius,
+		}
+		if err := bias.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	providers := opts.Providers
+	if crs != provider.CRSWGS84 {
+		// 다른 Provider는 WGS84 좌표만 반환하므로 vWorld로 제한
+		if providers, err = c.crsProviders(crs, opts.Providers); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	resp, err := c.service.GeocodeWithOptions(ctx, address, string(opts.AddressType), service.GeocodeOptions{
+		Providers:        providers,
+		SkipCache:        opts.SkipCache,
+		KakaoAnalyzeType: string(opts.KakaoAnalyzeType),
+		AddressTypes:     addressTypes,
+		BothTypes:        opts.BothCoordinates,
+		CRS:              crs,
+		Bias:             bias,
+		IncludeRaw:       opts.IncludeRaw,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 취소나 시간 초과로 끝난 조회는 주소를 찾지 못한 것이 아니므로 대체 좌표를 쓰지 않도록 컨텍스트 에러 반환
+	if !resp.Success {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
 	if !resp.Success {
-		return nil, fmt.Errorf("geocoding failed: %s", resp.Error)
+		if resp.ErrorCode == model.ErrorCodeOutsideKorea {
+			return nil, fmt.Errorf("geocoding failed: %w", ErrOutsideKorea)
+		}
+		if resp.ErrorCode == model.ErrorCodeInvalidAddress {
+			return nil, fmt.Errorf("geocoding failed: %w", ErrInvalidAddress)
+		}
+		return nil, fmt.Errorf("%w: %s", errGeocodingFailed, resp.Error)
 	}
 
 	// 내부 응답을 공개 타입으로 변환
 	result := &Result{
-		Latitude:  resp.Coordinate.Latitude,
-		Longitude: resp.Coordinate.Longitude,
-		Provider:  resp.Provider,
+		Latitude:   resp.Coordinate.Latitude,
+		Longitude:  resp.Coordinate.Longitude,
+		Provider:   resp.Provider,
+		Source:     resp.Source,
+		MatchLevel: MatchLevel(resp.MatchLevel),
+		Confidence: resp.Confidence,
+
+		LowConfidence:  resp.LowConfidence,
+		MatchedAddress: resp.MatchedAddress,
+		CRS:            resp.CRS,
+		Raw:            resp.Raw,
 	}
 
 	// 주소 상세 정보가 있으면 추가
-	if resp.AddressDetail != nil {
-		result.AddressDetail = &AddressDetail{
-			RoadAddress:   resp.AddressDetail.RoadAddress,
-			ParcelAddress: resp.AddressDetail.ParcelAddress,
-			BuildingName:  resp.AddressDetail.BuildingName,
-			Zipcode:       resp.AddressDetail.Zipcode,
-		}
-	}
+	result.AddressDetail = toAddressDetail(resp.AddressDetail)
+	result.RoadCoordinate = toCoordinate(resp.RoadCoordinate)
+	result.ParcelCoordinate = toCoordinate(resp.ParcelCoordinate)
 
 	// Provider 시도 내역
 	for _, attempt := range resp.Attempts {
 		result.Attempts = append(result.Attempts, Attempt{
-			Provider: attempt.Provider,
-			Success:  attempt.Success,
-			Error:    attempt.Error,
+			Provider:   attempt.Provider,
+			Success:    attempt.Success,
+			Error:      attempt.Error,
+			RequestURL: attempt.RequestURL,
 		})
 	}
 
 	return result, nil
 }
 
+// crsProviders returns the providers to use for a lookup in crs, which only
+// vWorld can return. It fails when vWorld is not configured or requested.
+func (c *Client) crsProviders(crs string, requested []string) ([]string, error) {
+	const name = "vWorld"
+	if !c.hasProvider(name) {
+		return nil, fmt.Errorf("CRS %s requires the vWorld provider", crs)
+	}
+	if len(requested) > 0 && !slices.ContainsFunc(requested, func(p string) bool { return strings.EqualFold(p, name) }) {
+		return nil, fmt.Errorf("CRS %s is only supported by vWorld", crs)
+	}
+	return []string{name}, nil
+}
+
+// GeocodeSnapped geocodes address and moves the coordinate onto the road
+// network, for routing. The road address (도로명) of the match, found by
+// reverse geocoding the coordinate when the provider did not return one, is
+// geocoded as [AddressTypeRoad], which resolves to the point where the
+// building meets its road. The returned Result keeps the original match
+// details, carries the road-aligned coordinate and has Snapped set.
+//
+// Snapping costs up to two provider calls on top of the geocoding call: a
+// reverse geocoding call (skipped when the match already has a road address)
+// and a road address geocoding call. Both count against provider quotas and
+// rate limits. When no road address can be found, e.g. for land without a
+// building, or the road address only matches at [MatchLevelStreet] or
+// coarser, the unsnapped result is returned with Snapped false. Snapped is
+// also false when the road address resolves to the original coordinate.
+func (c *Client) GeocodeSnapped(ctx context.Context, address string) (*Result, error) {
+	result, err := c.geocode(ctx, address, GeocodeOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if snapped, err := c.snapToRoad(ctx, result); err == nil {
+		result = snapped
+	} else if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if err := c.runResultHook(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// snapToRoad returns a copy of result moved to the coordinate of its road
+// address, reverse geocoding the coordinate first if the road address is
+// unknown.
+func (c *Client) snapToRoad(ctx context.Context, result *Result) (*Result, error) {
+	snapped := *result
+	snapped.Attempts = append([]Attempt(nil), result.Attempts...)
+
+	var roadAddress string
+	if result.AddressDetail != nil {
+		roadAddress = result.AddressDetail.RoadAddress
+	}
+	if roadAddress == "" {
+		reverse, err := c.ReverseGeocode(ctx, result.Latitude, result.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		snapped.Attempts = append(snapped.Attempts, reverse.Attempts...)
+		if reverse.AddressDetail == nil || reverse.AddressDetail.RoadAddress == "" {
+			return nil, fmt.Errorf("%w: %s", ErrAddressFormUnavailable, AddressTypeRoad)
+		}
+		roadAddress = reverse.AddressDetail.RoadAddress
+
+		detail := AddressDetail{}
+		if result.AddressDetail != nil {
+			detail = *result.AddressDetail
+		}
+		detail.RoadAddress = roadAddress
+		snapped.AddressDetail = &detail
+	}
+
+	road, err := c.geocode(ctx, roadAddress, GeocodeOptions{AddressType: AddressTypeRoad})
+	if err != nil {
+		return nil, err
+	}
+	// 도로 구간이나 행정구역 대표 좌표로 옮기면 오히려 정밀도가 떨어지므로 정확히 일치한 결과만 사용
+	if road.MatchLevel != MatchLevelRooftop || road.LowConfidence {
+		return nil, fmt.Errorf("%w: %s", ErrAddressFormUnavailable, AddressTypeRoad)
+	}
+	snapped.Attempts = append(snapped.Attempts, road.Attempts...)
+	if road.Latitude != result.Latitude || road.Longitude != result.Longitude {
+		snapped.Latitude = road.Latitude
+		snapped.Longitude = road.Longitude
+		snapped.Snapped = true
+	}
+	return &snapped, nil
+}
+
 // GeocodeBatch converts multiple addresses concurrently (max 100).
-// Up to 10 addresses are processed in parallel.
+// Up to [Config.ConcurrentLimit] addresses are processed in parallel.
 // Partial failures are allowed; successful results are returned alongside nil entries for failures.
+// Results rejected by [Config.ResultHook] are nil as well, and are reported
+// by a *[ResultHookError] returned together with the results.
+//
+// An entry of the form "lat,lng" (two numbers inside Korea, e.g.
+// "37.5665,126.978") is reverse geocoded instead, so addresses and
+// coordinates can be mixed in one batch. The Result for such an entry
+// carries the input coordinates and the resolved address.
 func (c *Client) GeocodeBatch(ctx context.Context, addresses []string) ([]*Result, error) {
 	if len(addresses) == 0 {
 		return []*Result{}, nil
@@ -154,11 +491,355 @@ func (c *Client) GeocodeBatch(ctx context.Context, addresses []string) ([]*Resul
 		return nil, fmt.Errorf("too many addresses: maximum 100, got %d", len(addresses))
 	}
 
-	bulkResp, err := c.service.GeocodeBatch(ctx, addresses)
+	bulkResp, err := c.service.GeocodeBatch(ctx, addresses, "")
+	if err != nil {
+		return nil, err
+	}
+
+	results := toBatchResults(bulkResp)
+	if hookErrs := c.applyResultHook(ctx, results, 0); hookErrs != nil {
+		return results, &ResultHookError{Errors: hookErrs}
+	}
+	return results, nil
+}
+
+// largeBatchChunkSize is the number of addresses GeocodeLarge hands to the
+// batch geocoder at a time.
+const largeBatchChunkSize = 100
+
+// GeocodeLarge is like [Client.GeocodeBatch] without the 100-address limit.
+// It geocodes addresses in chunks of at most 100, one chunk after another, so
+// at most [Config.ConcurrentLimit] addresses are in flight at any time across
+// the whole call. Results are in input order, with nil entries for addresses
+// that failed. Results rejected by [Config.ResultHook] are reported like in
+// GeocodeBatch, with indices into addresses.
+//
+// A fatal error, such as [ErrNoProvidersAvailable] or a cancelled context,
+// stops the remaining chunks and is returned without results.
+func (c *Client) GeocodeLarge(ctx context.Context, addresses []string) ([]*Result, error) {
+	results := make([]*Result, 0, len(addresses))
+	var hookErrs map[int]error
+	for start := 0; start < len(addresses); start += largeBatchChunkSize {
+		end := min(start+largeBatchChunkSize, len(addresses))
+		bulkResp, err := c.service.GeocodeBatch(ctx, addresses[start:end], "")
+		if err != nil {
+			return nil, fmt.Errorf("addresses %d-%d: %w", start, end-1, err)
+		}
+
+		// 취소된 컨텍스트에서는 항목이 모두 실패로 채워지므로 결과 대신 에러 반환
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		chunk := toBatchResults(bulkResp)
+		if errs := c.applyResultHook(ctx, chunk, start); errs != nil {
+			if hookErrs == nil {
+				hookErrs = make(map[int]error)
+			}
+			maps.Copy(hookErrs, errs)
+		}
+		results = append(results, chunk...)
+	}
+	if hookErrs != nil {
+		return results, &ResultHookError{Errors: hookErrs}
+	}
+	return results, nil
+}
+
+// PreloadCache geocodes addresses and stores the successful results in the
+// cache, so later lookups of the same addresses are answered without calling
+// a provider. Use it at startup to warm the cache with common addresses.
+//
+// Addresses are processed in chunks like [Client.GeocodeLarge], so at most
+// [Config.ConcurrentLimit] are in flight at a time. Results are not returned
+// and [Config.ResultHook] is not run. It returns [ErrCacheDisabled] when
+// [Config.CacheTTL] is not set, and a *[PreloadError] reporting how many
+// addresses were cached when some of them failed. A fatal error, such as
+// [ErrNoProvidersAvailable] or a cancelled context, stops the preload.
+func (c *Client) PreloadCache(ctx context.Context, addresses []string) error {
+	if c.config.CacheTTL <= 0 {
+		return ErrCacheDisabled
+	}
+
+	var succeeded int
+	for start := 0; start < len(addresses); start += largeBatchChunkSize {
+		end := min(start+largeBatchChunkSize, len(addresses))
+		bulkResp, err := c.service.GeocodeBatch(ctx, addresses[start:end], "")
+		if err != nil {
+			return fmt.Errorf("addresses %d-%d: %w", start, end-1, err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		succeeded += bulkResp.Summary.Success
+	}
+
+	if succeeded < len(addresses) {
+		return &PreloadError{Succeeded: succeeded, Failed: len(addresses) - succeeded}
+	}
+	return nil
+}
+
+// GeocodeBatchWithOptions is like [Client.GeocodeBatch] but applies opts to
+// every address and reports why each failed item failed. Items outside
+// [BatchOptions.Bounds] fail with an error wrapping [ErrOutsideBounds].
+func (c *Client) GeocodeBatchWithOptions(ctx context.Context, addresses []string, opts BatchOptions) ([]BatchResult, error) {
+	if len(addresses) > 100 {
+		return nil, fmt.Errorf("too many addresses: maximum 100, got %d", len(addresses))
+	}
+
+	if _, err := utils.NormalizeAddressType(string(opts.AddressType)); err != nil {
+		return nil, err
+	}
+	if opts.Bounds != nil {
+		if err := opts.Bounds.validate(); err != nil {
+			return nil, fmt.Errorf("bounds %w", err)
+		}
+	}
+
+	bulkResp, err := c.service.GeocodeBatchWithOptions(ctx, addresses, service.BatchOptions{
+		AddressType: string(opts.AddressType),
+		Bounds:      toUtilsBounds(opts.Bounds),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := toBatchResults(bulkResp)
+	items := make([]BatchResult, len(results))
+	for i, resp := range bulkResp.Results {
+		switch {
+		case resp.Success:
+			if err := c.runResultHook(ctx, results[i]); err != nil {
+				items[i].Err = err
+				continue
+			}
+			items[i].Result = results[i]
+		case resp.ErrorCode == model.ErrorCodeOutsideBounds:
+			items[i].Err = fmt.Errorf("geocoding failed: %w", ErrOutsideBounds)
+		case resp.ErrorCode == model.ErrorCodeOutsideKorea:
+			items[i].Err = fmt.Errorf("geocoding failed: %w", ErrOutsideKorea)
+		case resp.ErrorCode == model.ErrorCodeInvalidAddress:
+			items[i].Err = fmt.Errorf("geocoding failed: %w", ErrInvalidAddress)
+		default:
+			items[i].Err = fmt.Errorf("geocoding failed: %s", resp.Error)
+		}
+	}
+
+	return items, nil
+}
+
+// GeocodeBatchMap is like [Client.GeocodeBatch] for addresses keyed by the
+// caller's own IDs. The returned map has an entry for every key of
+// addresses, with a nil value when that address failed. Results rejected by
+// [Config.ResultHook] are reported by a *[ResultHookError] returned together
+// with the map, whose indices refer to the keys in sorted order; use
+// [Client.GeocodeBatchMapWithOptions] to get the errors by key.
+func (c *Client) GeocodeBatchMap(ctx context.Context, addresses map[string]string) (map[string]*Result, error) {
+	keys, values := splitBatchMap(addresses)
+	results, err := c.GeocodeBatch(ctx, values)
+	var hookErr *ResultHookError
+	if err != nil && !errors.As(err, &hookErr) {
+		return nil, err
+	}
+
+	byKey := make(map[string]*Result, len(keys))
+	for i, key := range keys {
+		byKey[key] = results[i]
+	}
+	return byKey, err
+}
+
+// GeocodeBatchMapWithOptions is like [Client.GeocodeBatchWithOptions] for
+// addresses keyed by the caller's own IDs, reporting why each failed item
+// failed. The returned map has an entry for every key of addresses.
+func (c *Client) GeocodeBatchMapWithOptions(ctx context.Context, addresses map[string]string, opts BatchOptions) (map[string]BatchResult, error) {
+	keys, values := splitBatchMap(addresses)
+	items, err := c.GeocodeBatchWithOptions(ctx, values, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]BatchResult, len(keys))
+	for i, key := range keys {
+		byKey[key] = items[i]
+	}
+	return byKey, nil
+}
+
+// splitBatchMap returns the keys of addresses in sorted order and the
+// addresses in the same order, so batches are processed deterministically.
+func splitBatchMap(addresses map[string]string) (keys, values []string) {
+	keys = slices.Sorted(maps.Keys(addresses))
+	values = make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = addresses[key]
+	}
+	return keys, values
+}
+
+// ValidateBatch normalizes and checks each address the same way geocoding
+// does, without calling any provider. Use it as a dry run before an
+// expensive batch. Results are in input order.
+func (c *Client) ValidateBatch(ctx context.Context, addresses []string) []AddressValidation {
+	validations := make([]AddressValidation, len(addresses))
+	for i, address := range addresses {
+		normalized := c.service.NormalizeAddress(address)
+		validations[i] = AddressValidation{
+			Address:     address,
+			Normalized:  normalized,
+			Valid:       c.service.ValidateAddress(address) == nil,
+			AddressType: AddressType(utils.DetectAddressType(normalized)),
+			Zipcode:     utils.ExtractZipcode(normalized),
+		}
+	}
+	return validations
+}
+
+// ReverseGeocode converts WGS84 coordinates to a Korean address.
+// Only providers that support reverse geocoding (currently Kakao) are tried.
+// The returned Result carries the input coordinates.
+func (c *Client) ReverseGeocode(ctx context.Context, latitude, longitude float64) (*Result, error) {
+	resp, err := c.service.ReverseGeocode(ctx, model.Coordinate{Latitude: latitude, Longitude: longitude})
 	if err != nil {
 		return nil, err
 	}
 
+	if !resp.Success {
+		if resp.ErrorCode == model.ErrorCodeOutsideKorea {
+			return nil, fmt.Errorf("reverse geocoding failed: %w", ErrOutsideKorea)
+		}
+		return nil, fmt.Errorf("reverse geocoding failed: %s", resp.Error)
+	}
+
+	result := &Result{
+		Latitude:      resp.Coordinate.Latitude,
+		Longitude:     resp.Coordinate.Longitude,
+		Provider:      resp.Provider,
+		AddressDetail: toAddressDetail(resp.AddressDetail),
+	}
+	for _, attempt := range resp.Attempts {
+		result.Attempts = append(result.Attempts, Attempt{
+			Provider:   attempt.Provider,
+			Success:    attempt.Success,
+			Error:      attempt.Error,
+			RequestURL: attempt.RequestURL,
+		})
+	}
+	if err := c.runResultHook(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GeocodeZipcode returns a representative WGS84 coordinate for a 5-digit
+// Korean zipcode (우편번호), e.g. "04524": the average of the addresses the
+// provider finds in that zone. Only providers that can search by zipcode
+// (currently Kakao) are tried. The Result has [MatchLevelRegion] and the
+// zipcode in [AddressDetail.Zipcode], with the zone's 시·도 and 시·군·구
+// when known. It returns [ErrInvalidZipcode] for a malformed zipcode.
+func (c *Client) GeocodeZipcode(ctx context.Context, zipcode string) (*Result, error) {
+	resp, err := c.service.GeocodeZipcode(ctx, zipcode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Success {
+		if resp.ErrorCode == model.ErrorCodeInvalidAddress {
+			return nil, fmt.Errorf("zipcode geocoding failed: %w", ErrInvalidZipcode)
+		}
+		return nil, fmt.Errorf("zipcode geocoding failed: %s", resp.Error)
+	}
+
+	result := &Result{
+		Latitude:      resp.Coordinate.Latitude,
+		Longitude:     resp.Coordinate.Longitude,
+		Provider:      resp.Provider,
+		MatchLevel:    MatchLevel(resp.MatchLevel),
+		Confidence:    resp.Confidence,
+		AddressDetail: toAddressDetail(resp.AddressDetail),
+	}
+	for _, attempt := range resp.Attempts {
+		result.Attempts = append(result.Attempts, Attempt{
+			Provider:   attempt.Provider,
+			Success:    attempt.Success,
+			Error:      attempt.Error,
+			RequestURL: attempt.RequestURL,
+		})
+	}
+	if err := c.runResultHook(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReverseGeocodeBatch converts multiple coordinates concurrently (max 100).
+// At most [Config.ReverseConcurrentLimit] coordinates (falling back to
+// [Config.ConcurrentLimit]) are processed in parallel. It shares
+// [Config.BatchItemTimeout] and the rate limits with GeocodeBatch.
+// Failed items are returned as nil entries. Results rejected by
+// [Config.ResultHook] are reported like in GeocodeBatch.
+func (c *Client) ReverseGeocodeBatch(ctx context.Context, coords []Coordinate) ([]*Result, error) {
+	if len(coords) == 0 {
+		return []*Result{}, nil
+	}
+
+	if len(coords) > 100 {
+		return nil, fmt.Errorf("too many coordinates: maximum 100, got %d", len(coords))
+	}
+
+	internal := make([]model.Coordinate, len(coords))
+	for i, coord := range coords {
+		internal[i] = model.Coordinate{Latitude: coord.Latitude, Longitude: coord.Longitude}
+	}
+
+	bulkResp, err := c.service.ReverseGeocodeBatch(ctx, internal)
+	if err != nil {
+		return nil, err
+	}
+
+	results := toBatchResults(bulkResp)
+	if hookErrs := c.applyResultHook(ctx, results, 0); hookErrs != nil {
+		return results, &ResultHookError{Errors: hookErrs}
+	}
+	return results, nil
+}
+
+// runResultHook passes a successful result to [Config.ResultHook], if set.
+func (c *Client) runResultHook(ctx context.Context, result *Result) error {
+	if c.config.ResultHook == nil {
+		return nil
+	}
+	if err := c.config.ResultHook(ctx, result); err != nil {
+		return fmt.Errorf("result hook: %w", err)
+	}
+	return nil
+}
+
+// applyResultHook runs [Config.ResultHook] on each successful batch result,
+// replacing results the hook rejects with nil entries. It returns the hook's
+// errors keyed by index plus offset, or nil when every result was accepted.
+func (c *Client) applyResultHook(ctx context.Context, results []*Result, offset int) map[int]error {
+	var errs map[int]error
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		if err := c.runResultHook(ctx, result); err != nil {
+			results[i] = nil
+			if errs == nil {
+				errs = make(map[int]error)
+			}
+			errs[offset+i] = err
+		}
+	}
+	return errs
+}
+
+// toBatchResults converts a bulk response to public results, with nil
+// entries for failed items.
+func toBatchResults(bulkResp *model.BulkResponse) []*Result {
 	// 내부 응답을 공개 타입으로 변환
 	results := make([]*Result, 0, len(bulkResp.Results))
 	for _, resp := range bulkResp.Results {
@@ -169,33 +850,319 @@ func (c *Client) GeocodeBatch(ctx context.Context, addresses []string) ([]*Resul
 		}
 
 		result := &Result{
-			Latitude:  resp.Coordinate.Latitude,
-			Longitude: resp.Coordinate.Longitude,
-			Provider:  resp.Provider,
-		}
+			Latitude:   resp.Coordinate.Latitude,
+			Longitude:  resp.Coordinate.Longitude,
+			Provider:   resp.Provider,
+			Source:     resp.Source,
+			MatchLevel: MatchLevel(resp.MatchLevel),
+			Confidence: resp.Confidence,
 
-		if resp.AddressDetail != nil {
-			result.AddressDetail = &AddressDetail{
-				RoadAddress:   resp.AddressDetail.RoadAddress,
-				ParcelAddress: resp.AddressDetail.ParcelAddress,
-				BuildingName:  resp.AddressDetail.BuildingName,
-				Zipcode:       resp.AddressDetail.Zipcode,
-			}
+			LowConfidence:  resp.LowConfidence,
+			MatchedAddress: resp.MatchedAddress,
 		}
 
+		result.AddressDetail = toAddressDetail(resp.AddressDetail)
+
 		results = append(results, result)
 	}
 
-	return results, nil
+	return results
 }
 
-// Close releases any resources held by the client.
-func (c *Client) Close() error {
-	// 현재는 정리할 리소스 없음
-	// 향후 Connection Pool 등이 추가되면 여기서 정리
+// ConvertAddress geocodes address and returns it in the requested form,
+// e.g. the road address (도로명) of a parcel address (지번). It uses the same
+// provider chain and cache as [Client.Geocode]. The target type ignores
+// case and surrounding spaces, so "road" works too.
+//
+// It returns an error wrapping [ErrImpreciseMatch] when the address only
+// matched a street or region, or with low confidence, since the address of
+// such a match is not the input's. It returns an error wrapping
+// [ErrAddressFormUnavailable] when the result has no address of the
+// requested type. The address is taken from the result after
+// [Config.ResultHook] has run.
+func (c *Client) ConvertAddress(ctx context.Context, address string, to AddressType) (string, error) {
+	target, err := utils.NormalizeAddressType(string(to))
+	if err != nil {
+		return "", err
+	}
+	if target == utils.AddressTypeUnknown {
+		return "", fmt.Errorf("%w %q (must be %s or %s)", utils.ErrInvalidAddressType, to, AddressTypeRoad, AddressTypeParcel)
+	}
+
+	result, err := c.geocode(ctx, address, GeocodeOptions{})
+	if err != nil {
+		return "", err
+	}
+	if err := requireExactMatch(result); err != nil {
+		return "", err
+	}
+	if err := c.runResultHook(ctx, result); err != nil {
+		return "", err
+	}
+
+	var converted string
+	if d := result.AddressDetail; d != nil {
+		if target == utils.AddressTypeRoad {
+			converted = d.RoadAddress
+		} else {
+			converted = d.ParcelAddress
+		}
+	}
+	if converted == "" {
+		return "", fmt.Errorf("%w: %s", ErrAddressFormUnavailable, target)
+	}
+
+	return converted, nil
+}
+
+// GeocodeFull geocodes address and returns its road, parcel and English
+// forms and zipcode together with the coordinate, in one call instead of one
+// [Client.ConvertAddress] per form. It uses the same provider chain and cache
+// as [Client.Geocode], but never returns [Config.FallbackCoordinate]: when
+// every provider fails it returns the error. Forms the matched provider does
+// not supply are left empty rather than reported as errors; check MatchLevel
+// and LowConfidence before relying on them. The forms are taken from the
+// result after [Config.ResultHook] has run.
+func (c *Client) GeocodeFull(ctx context.Context, address string) (*FullResult, error) {
+	result, err := c.geocode(ctx, address, GeocodeOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.runResultHook(ctx, result); err != nil {
+		return nil, err
+	}
+
+	full := &FullResult{
+		Coordinate:    Coordinate{Latitude: result.Latitude, Longitude: result.Longitude},
+		Provider:      result.Provider,
+		MatchLevel:    result.MatchLevel,
+		LowConfidence: result.LowConfidence,
+	}
+	if d := result.AddressDetail; d != nil {
+		full.RoadAddress = d.RoadAddress
+		full.ParcelAddress = d.ParcelAddress
+		full.EnglishAddress = d.EnglishAddress
+		full.Zipcode = d.Zipcode
+	}
+
+	return full, nil
+}
+
+// CanonicalKey geocodes address and returns a key that is identical for
+// equivalent spellings of the same place, such as the road and parcel
+// addresses of one building, regardless of spacing.
+//
+// The key is, in order of preference:
+//   - "pnu:" followed by the 19-digit parcel number (법정동코드 + 산 여부 +
+//     본번 + 부번), when the provider returns the legal district code and
+//     parcel numbers;
+//   - "bld:" followed by the building management number (건물관리번호);
+//   - "geo:" followed by an 8-character geohash (about 38 m) of the
+//     coordinates.
+//
+// Keys of different kinds never compare equal, so clustering works best
+// when all addresses are resolved by the same provider.
+//
+// The key is built from the result after [Config.ResultHook] has run. It
+// returns an error wrapping [ErrImpreciseMatch] when the address only
+// matched a street or region, or with low confidence, since such a key
+// would be shared by unrelated addresses.
+func (c *Client) CanonicalKey(ctx context.Context, address string) (string, error) {
+	result, err := c.geocode(ctx, address, GeocodeOptions{})
+	if err != nil {
+		return "", err
+	}
+	if err := requireExactMatch(result); err != nil {
+		return "", err
+	}
+	if err := c.runResultHook(ctx, result); err != nil {
+		return "", err
+	}
+
+	return canonicalKey(result), nil
+}
+
+// canonicalKeyGeohashPrecision is the geohash length used by the
+// coordinate fallback of CanonicalKey.
+const canonicalKeyGeohashPrecision = 8
+
+// canonicalKey builds the CanonicalKey of a successful geocoding result.
+func canonicalKey(result *Result) string {
+	if d := result.AddressDetail; d != nil {
+		if pnu, ok := parcelNumber(d); ok {
+			return "pnu:" + pnu
+		}
+		if d.BuildingManagementNumber != "" {
+			return "bld:" + d.BuildingManagementNumber
+		}
+	}
+	return "geo:" + utils.Geohash(result.Latitude, result.Longitude, canonicalKeyGeohashPrecision)
+}
+
+// requireExactMatch returns an error wrapping [ErrImpreciseMatch] unless
+// result matched the full address ([MatchLevelRooftop]) with enough
+// confidence.
+func requireExactMatch(result *Result) error {
+	if result.MatchLevel != MatchLevelRooftop || result.LowConfidence {
+		return fmt.Errorf("%w: match level %s", ErrImpreciseMatch, result.MatchLevel)
+	}
 	return nil
 }
 
+// parcelNumber builds the 19-digit parcel number (PNU) from the address
+// detail. It reports false when the legal district code is not the full 10
+// digits or the parcel numbers are missing, not numeric or longer than the
+// 4 digits each PNU field holds.
+func parcelNumber(d *AddressDetail) (string, bool) {
+	if !utils.IsAdminCode(d.LegalDongCode) || d.MainNo == "" {
+		return "", false
+	}
+
+	main, ok := parcelNumberField(d.MainNo)
+	if !ok || main == 0 {
+		return "", false
+	}
+	sub := 0
+	if d.SubNo != "" {
+		if sub, ok = parcelNumberField(d.SubNo); !ok {
+			return "", false
+		}
+	}
+
+	mountain := 1
+	if d.IsMountain {
+		mountain = 2
+	}
+	return fmt.Sprintf("%s%d%04d%04d", d.LegalDongCode, mountain, main, sub), true
+}
+
+// parcelNumberField parses a 본번 or 부번, which must fit the 4-digit PNU
+// field.
+func parcelNumberField(s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 9999 {
+		return 0, false
+	}
+	return n, true
+}
+
+// newLimiter creates a token-bucket limiter for the given rate.
+// It returns nil (unlimited) when the rate is zero.
+func newLimiter(rps float64, burst int) service.Limiter {
+	if rps <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// newProviderLimiters creates one limiter per provider name.
+func newProviderLimiters(limits map[string]float64, burst int) map[string]service.Limiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	limiters := make(map[string]service.Limiter, len(limits))
+	for name, rps := range limits {
+		if limiter := newLimiter(rps, burst); limiter != nil {
+			limiters[name] = limiter
+		}
+	}
+	return limiters
+}
+
+// newCache creates the result cache.
+// It returns nil (caching disabled) when the TTL is zero.
+func newCache(ttl time.Duration, size int, clk Clock) cache.Cache {
+	if ttl <= 0 {
+		return nil
+	}
+	memory := cache.NewMemory(ttl, size)
+	memory.SetClock(clk)
+	return memory
+}
+
+// toUtilsBounds converts the public bounding box to the internal type.
+// It returns nil (use the default) when no bounds are configured.
+func toUtilsBounds(b *Bounds) *utils.Bounds {
+	if b == nil {
+		return nil
+	}
+	return &utils.Bounds{
+		MinLatitude:  b.MinLatitude,
+		MaxLatitude:  b.MaxLatitude,
+		MinLongitude: b.MinLongitude,
+		MaxLongitude: b.MaxLongitude,
+	}
+}
+
+// toStrings converts address types to the plain strings providers take.
+func toStrings(types []AddressType) []string {
+	if len(types) == 0 {
+		return nil
+	}
+	converted := make([]string, len(types))
+	for i, t := range types {
+		converted[i] = string(t)
+	}
+	return converted
+}
+
+// toCoordinate converts an internal coordinate to the public type.
+// It returns nil when c is nil.
+func toCoordinate(c *model.Coordinate) *Coordinate {
+	if c == nil {
+		return nil
+	}
+	return &Coordinate{Latitude: c.Latitude, Longitude: c.Longitude}
+}
+
+// toAddressDetail converts the internal address detail to the public type.
+// It returns nil when the provider supplied no detail.
+func toAddressDetail(d *model.AddressDetail) *AddressDetail {
+	if d == nil {
+		return nil
+	}
+	return &AddressDetail{
+		RoadAddress:    d.RoadAddress,
+		ParcelAddress:  d.ParcelAddress,
+		BuildingName:   d.BuildingName,
+		EnglishAddress: d.EnglishAddress,
+		Zipcode:        d.Zipcode,
+		LegalDongCode:  d.LegalDongCode,
+		AdminDongCode:  d.AdminDongCode,
+		Sido:           d.Sido,
+		Sigungu:        d.Sigungu,
+		Dong:           d.Dong,
+		AdminDong:      d.AdminDong,
+		RoadName:       d.RoadName,
+		BuildingNo:     d.BuildingNo,
+		RefinedAddress: d.RefinedAddress,
+		IsMountain:     d.IsMountain,
+		MainNo:         d.MainNo,
+		SubNo:          d.SubNo,
+		BuildingDong:   d.BuildingDong,
+		BuildingUnit:   d.BuildingUnit,
+
+		BuildingManagementNumber: d.BuildingManagementNumber,
+	}
+}
+
+// closeTimeout bounds how long Close waits for in-flight calls and for each
+// component to shut down.
+const closeTimeout = 10 * time.Second
+
+// Close releases the resources held by the client. It first waits for
+// in-flight calls to finish, then closes the providers, the cache and idle
+// HTTP connections, in that order, giving up after 10 seconds. Errors from
+// the components are joined. Calls after the first do nothing.
+func (c *Client) Close() error {
+	if c.lifecycle == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	return c.lifecycle.Shutdown(ctx)
+}
+
 // IsAvailable returns true if at least one geocoding provider is available.
 func (c *Client) IsAvailable(ctx context.Context) bool {
 	for _, p := range c.providers {
@@ -206,6 +1173,61 @@ func (c *Client) IsAvailable(ctx context.Context) bool {
 	return false
 }
 
+// Validate makes one cheap geocoding request per provider to confirm that
+// its API key is accepted, e.g. right after [New] so that a mistyped key is
+// found at startup rather than on the first real request.
+//
+// Providers whose key is rejected are disabled, as if a request had failed
+// authentication, and the client keeps working with the rest. The returned
+// error joins one error per rejected provider, each naming the provider and
+// wrapping [ErrInvalidAPIKey]; it also wraps [ErrNoProvidersAvailable] when
+// every provider was rejected. Network errors and empty results are not
+// treated as invalid keys. Validate returns nil when no key was rejected.
+func (c *Client) Validate(ctx context.Context) error {
+	return c.service.ValidateProviders(ctx)
+}
+
+// hasProvider reports whether a provider with the given name (case-insensitive) is configured.
+func (c *Client) hasProvider(name string) bool {
+	return hasProviderNamed(c.providers, name)
+}
+
+// hasProviderNamed reports whether providers include one named name,
+// ignoring case.
+func hasProviderNamed(providers []provider.GeocodingProvider, name string) bool {
+	for _, p := range providers {
+		if strings.EqualFold(p.Name(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats returns a snapshot of per-provider call counters, keyed by provider
+// name. Providers registered more than once under the same name (e.g. several
+// vWorld keys) are combined. It is safe to call while geocoding is in progress.
+func (c *Client) Stats() map[string]ProviderStats {
+	totals := make(map[string]provider.StatsSnapshot)
+	for _, p := range c.providers {
+		reporter, ok := p.(provider.StatsReporter)
+		if !ok {
+			continue
+		}
+		totals[p.Name()] = totals[p.Name()].Add(reporter.Stats())
+	}
+
+	stats := make(map[string]ProviderStats, len(totals))
+	for name, s := range totals {
+		stats[name] = ProviderStats{
+			Calls:      s.Calls,
+			Successes:  s.Successes,
+			Failures:   s.Failures,
+			AvgLatency: s.AvgLatency(),
+		}
+	}
+	return stats
+}
+
 // GetProviders returns the list of configured provider names.
 func (c *Client) GetProviders() []string {
 	names := make([]string, 0, len(c.providers))
@@ -0,0 +1,112 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/config"
+	"github.com/oursportsnation/k-geocode/internal/grpcserver"
+	"github.com/oursportsnation/k-geocode/internal/service"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
+
+	"github.com/joho/godotenv"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// .env 파일 로드 (있으면)
+	if err := godotenv.Load(); err != nil {
+		// .env 파일이 없어도 계속 진행
+		log.Println("No .env file found")
+	}
+
+	// 환경 설정
+	env := os.Getenv("APP_ENV")
+	if env == "" {
+		env = "dev"
+	}
+
+	// 설정 파일 로드
+	configPath := "configs/config.yaml"
+	cfg, err := config.LoadWithEnv(configPath, env)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Logger 초기화
+	appLogger, err := logger.New(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output)
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer appLogger.Sync()
+
+	// 시작 로그
+	appLogger.Info("Starting Geocoding gRPC Service",
+		zap.String("port", cfg.Server.GRPCPort),
+		zap.String("environment", env),
+		zap.String("log_level", cfg.Logging.Level),
+	)
+
+	// Coordinator 설정 (Provider 초기화 포함)
+	coordinator, err := service.NewCoordinator(cfg, appLogger)
+	if err != nil {
+		appLogger.Fatal("Failed to create coordinator", zap.Error(err))
+	}
+
+	// gRPC 서버 설정
+	lis, err := net.Listen("tcp", ":"+cfg.Server.GRPCPort)
+	if err != nil {
+		appLogger.Fatal("Failed to listen", zap.Error(err))
+	}
+
+	grpcServer := grpc.NewServer()
+	grpcserver.NewServer(coordinator, appLogger.Named("grpc")).Register(grpcServer)
+
+	// Graceful shutdown 설정
+	go func() {
+		// 서비스 시작
+		appLogger.Info("gRPC server started", zap.String("addr", lis.Addr().String()))
+		if err := grpcServer.Serve(lis); err != nil {
+			appLogger.Fatal("Failed to start gRPC server", zap.Error(err))
+		}
+	}()
+
+	// 시그널 대기
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	appLogger.Info("Shutting down gRPC server...")
+
+	// 진행 중인 RPC 완료 후 종료
+	grpcServer.GracefulStop()
+
+	// Provider, HTTP 클라이언트 정리 (5초 제한)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := coordinator.Shutdown(ctx); err != nil {
+		appLogger.Error("Coordinator shutdown failed", zap.Error(err))
+	}
+
+	appLogger.Info("gRPC server exiting")
+}
@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -28,11 +29,14 @@ import (
 	"github.com/oursportsnation/k-geocode/internal/config"
 	"github.com/oursportsnation/k-geocode/internal/handler"
 	"github.com/oursportsnation/k-geocode/internal/middleware"
+	"github.com/oursportsnation/k-geocode/internal/model"
 	"github.com/oursportsnation/k-geocode/internal/service"
 	"github.com/oursportsnation/k-geocode/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
@@ -82,7 +86,7 @@ func main() {
 	}
 
 	// Logger 초기화
-	appLogger, err := logger.New(cfg.Logging.Level, cfg.Logging.Format)
+	appLogger, err := logger.New(cfg.Logging.Level, cfg.Logging.Format, cfg.Logging.Output)
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
@@ -109,8 +113,16 @@ func main() {
 	// Service 설정
 	geocodingService := coordinator.GetGeocodingService()
 
+	// API 키 확인 (선택 사항, 거부된 Provider는 비활성화하고 모두 거부되면 종료)
+	if cfg.Server.ValidateKeysOnStart {
+		validateKeys(geocodingService, appLogger)
+	}
+
 	// Router 설정
-	router := setupRouter(cfg, geocodingService, coordinator, appLogger)
+	router, err := setupRouter(cfg, geocodingService, coordinator, appLogger)
+	if err != nil {
+		appLogger.Fatal("Failed to set up router", zap.Error(err))
+	}
 
 	// 서버 설정
 	srv := &http.Server{
@@ -148,49 +160,115 @@ func main() {
 		appLogger.Fatal("Server forced to shutdown", zap.Error(err))
 	}
 
+	// 진행 중인 요청이 끝난 뒤 Provider, HTTP 클라이언트 정리 (남은 제한 시간 안에서)
+	if err := coordinator.Shutdown(ctx); err != nil {
+		appLogger.Error("Coordinator shutdown failed", zap.Error(err))
+	}
+
 	appLogger.Info("Server exiting")
 }
 
-
 // setupRouter Router 설정
-func setupRouter(cfg *config.Config, geocodingService *service.GeocodingService, coordinator *service.Coordinator, logger *zap.Logger) *gin.Engine {
+func setupRouter(cfg *config.Config, geocodingService *service.GeocodingService, coordinator *service.Coordinator, logger *zap.Logger) (*gin.Engine, error) {
 	router := gin.New()
 
+	// X-Forwarded-For는 설정된 프록시에서 온 요청만 신뢰 (비어 있으면 원격 주소 사용)
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted_proxies: %w", err)
+	}
+
 	// 미들웨어 설정
-	router.Use(middleware.RequestID())                    // Request ID (먼저 설정)
-	router.Use(middleware.Logger(logger))                 // 로깅
-	router.Use(middleware.Recovery(logger))               // 패닉 리커버리
-	router.Use(middleware.CORS())                         // CORS
+	router.Use(middleware.RequestID())                           // Request ID (먼저 설정)
+	router.Use(middleware.Logger(logger))                        // 로깅
+	router.Use(middleware.Recovery(logger))                      // 패닉 리커버리
+	router.Use(middleware.CORS())                                // CORS
+	router.Use(middleware.Metrics(prometheus.DefaultRegisterer)) // 라우트별 요청 수, 처리 시간
+
+	// 클라이언트 IP 허용 목록 (설정 시에만, 헬스체크 제외)
+	if len(cfg.Server.AllowedCIDRs) > 0 {
+		allowlist, err := middleware.IPAllowlist(cfg.Server.AllowedCIDRs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed_cidrs: %w", err)
+		}
+		router.Use(allowlist)
+	}
 
 	// 핸들러 생성
-	geocodingHandler := handler.NewGeocodingHandler(geocodingService, logger)
+	geocodingHandler := handler.NewGeocodingHandlerWithTimeout(geocodingService, logger, cfg.API.RequestTimeout)
 	healthHandler := handler.NewHealthHandler(coordinator, logger)
+	normalizeHandler := handler.NewNormalizeHandlerWithNormalizer(logger, coordinator.GetNormalizer())
+	providerHandler := handler.NewProviderHandler(coordinator.GetProviders(), logger)
+	playgroundHandler, err := handler.NewPlaygroundHandler(cfg.Server.PlaygroundTileURL, cfg.Server.PlaygroundTileAttribution)
+	if err != nil {
+		return nil, fmt.Errorf("playground page: %w", err)
+	}
+
+	// 수동 테스트용 플레이그라운드 페이지
+	router.GET("/", playgroundHandler.Page)
 
 	// Swagger 문서
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Prometheus 메트릭
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
 	// 헬스체크 라우트
 	router.GET("/ping", healthHandler.Ping)
 	router.GET("/health", healthHandler.Health)
+	router.GET("/health/providers", healthHandler.Providers)
 	router.GET("/ready", healthHandler.Ready)
 
+	// 요청 본문 크기 제한 (설정 검증 시 이미 파싱 확인됨)
+	maxBodyBytes, _ := cfg.Server.MaxRequestBodyBytes()
+
 	// API v1 라우트 그룹
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.BodyLimit(maxBodyBytes))
+	{
+		// 지오코딩 API (서버 전체 동시 요청 수 제한은 Provider를 호출하는 지오코딩 라우트에만 적용)
+		geocode := v1.Group("/geocode")
+		if cfg.API.MaxInFlight > 0 {
+			geocode.Use(middleware.MaxInFlight(cfg.API.MaxInFlight, cfg.API.MaxInFlightWait))
+		}
+		geocode.POST("", geocodingHandler.Geocode)
+		geocode.POST("/bulk", geocodingHandler.GeocodeBulk)
+		geocode.POST("/bulk/stream", geocodingHandler.GeocodeBulkStream)
+		geocode.POST("/ndjson", geocodingHandler.GeocodeNDJSON)
+
+		// 주소 정규화 API (지오코딩 Provider 호출 없음, 도로명주소 API는 설정 시 호출)
+		v1.POST("/normalize", normalizeHandler.Normalize)
+	}
+
+	// Provider 관리 API (X-API-Key 인증)
+	admin := router.Group("/api/v1/providers")
+	admin.Use(middleware.APIKeyAuth(cfg.API.AdminAPIKeys))
 	{
-		// 지오코딩 API
-		v1.POST("/geocode", geocodingHandler.Geocode)
-		v1.POST("/geocode/bulk", geocodingHandler.GeocodeBulk)
+		admin.GET("", providerHandler.List)
+		admin.POST("/:name/enable", providerHandler.Enable)
 	}
 
 	// 404 핸들러
 	router.NoRoute(func(c *gin.Context) {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error":      "not found",
-			"request_id": middleware.GetRequestID(c),
-		})
+		c.JSON(http.StatusNotFound, model.NewErrorResponse(model.ErrorCodeNotFound, "not found", middleware.GetRequestID(c)))
 	})
 
-	return router
+	return router, nil
+}
+
+// validateKeys 시작 시 Provider API 키 확인
+func validateKeys(geocodingService *service.GeocodingService, logger *zap.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := geocodingService.ValidateProviders(ctx)
+	if errors.Is(err, service.ErrNoProvidersAvailable) {
+		logger.Fatal("Every provider API key was rejected", zap.Error(err))
+	}
+	if err != nil {
+		logger.Warn("Some provider API keys were rejected", zap.Error(err))
+		return
+	}
+	logger.Info("Provider API keys validated")
 }
 
 // printStartupBanner 서버 시작 배너 출력
@@ -201,6 +279,7 @@ func printStartupBanner(port string) {
 	fmt.Println("=============================================================")
 	fmt.Println()
 	fmt.Printf("  Server:      http://localhost:%s\n", port)
+	fmt.Printf("  Playground:  http://localhost:%s/\n", port)
 	fmt.Printf("  Swagger UI:  http://localhost:%s/swagger/index.html\n", port)
 	fmt.Printf("  Health:      http://localhost:%s/health\n", port)
 	fmt.Println()
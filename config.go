@@ -16,7 +16,11 @@ package geocoding
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/utils"
 )
 
 // Config holds the configuration for the geocoding client.
@@ -31,56 +35,517 @@ type Config struct {
 	// Obtain from https://developers.kakao.com
 	KakaoAPIKey string
 
-	// Timeout is the HTTP request timeout. Default: 5 seconds.
+	// JusoAPIKey enables the government road name address API (도로명주소
+	// API, juso.go.kr) as a normalizer: before an address missing from the
+	// cache is geocoded, it is replaced by its official road address, and the
+	// building management number, zipcode and English address that API
+	// returns fill in fields the geocoding provider left empty. When
+	// normalization fails the input address is geocoded as given. It counts
+	// as one extra request per uncached lookup and is not a provider on its
+	// own, since the API returns no coordinates. Obtain the key
+	// (승인키) from https://business.juso.go.kr. Default: "" (disabled).
+	JusoAPIKey string
+
+	// VWorldBaseURL overrides the vWorld API root (scheme, host and an
+	// optional path prefix), e.g. to use a mock server, mirror or proxy.
+	// Default: "https://api.vworld.kr". See also [WithBaseURL].
+	VWorldBaseURL string
+
+	// VWorldAddressTypes restricts which address types vWorld tries when
+	// none is given. vWorld searches road and parcel addresses separately,
+	// so an address that fails one type costs a second call with the other;
+	// set []AddressType{AddressTypeRoad} for road-only data to save quota.
+	// A call asking for a type not listed fails on vWorld and falls back to
+	// the next provider. Default: nil (both types).
+	VWorldAddressTypes []AddressType
+
+	// KakaoBaseURL overrides the Kakao Local API root for every Kakao
+	// endpoint. Default: "https://dapi.kakao.com". See also [WithBaseURL].
+	KakaoBaseURL string
+
+	// KakaoKeywordFallback retries Kakao's keyword (place) search when its
+	// address search finds nothing, so place names such as "잠실종합운동장"
+	// resolve. Such results have Source set to "keyword". Default: false.
+	KakaoKeywordFallback bool
+
+	// KakaoAnalyzeType selects Kakao's address matching mode. Use
+	// [KakaoAnalyzeExact] for datasets where a similar-but-wrong address is
+	// worse than none. Default: [KakaoAnalyzeSimilar].
+	KakaoAnalyzeType KakaoAnalyzeType
+
+	// KakaoExactFallback retries with [KakaoAnalyzeSimilar] when an exact
+	// Kakao search finds nothing. Default: false.
+	KakaoExactFallback bool
+
+	// NominatimBaseURL enables an OpenStreetMap Nominatim provider, usually
+	// a self-hosted instance, tried after every other provider. It needs no
+	// API key and is disabled when empty. Results carry no parcel address
+	// and a lower [Result.Confidence].
+	NominatimBaseURL string
+
+	// NominatimUserAgent identifies your application to Nominatim, as its
+	// usage policy requires, e.g. "my-app/1.0 (ops@example.com)". Required
+	// when NominatimBaseURL is set.
+	NominatimUserAgent string
+
+	// LocalDatasetPath loads a preprocessed address→coordinate dataset (a CSV
+	// file with address, latitude and longitude columns, plus optional
+	// road_address, parcel_address, english_address, zipcode and
+	// building_name columns) and tries it before every other provider. Only
+	// addresses that match an entry after normalization resolve locally; the
+	// rest fall through to the API providers. Disabled when empty.
+	LocalDatasetPath string
+
+	// Timeout bounds a single geocoding or reverse geocoding call as a
+	// whole, including every provider tried in the fallback chain. In a
+	// batch it applies to each item. Default: 5 seconds.
 	Timeout time.Duration
 
-	// MaxRetries is the number of retry attempts. Default: 2.
-	// Reserved for future use.
+	// ProviderTimeout bounds each provider attempt. A provider that does
+	// not answer in time is recorded as timed out and the next provider is
+	// tried with whatever remains of Timeout. Default: 0 (an attempt may
+	// use all of Timeout).
+	ProviderTimeout time.Duration
+
+	// MinAttemptBudget is the least time that must remain before Timeout
+	// (or the caller's context deadline, if earlier) for the next provider
+	// in the fallback chain to be tried. With less left, the provider is not
+	// called and is recorded in [Result.Attempts] as "skipped: insufficient
+	// time budget". The first provider is always tried. Default: 0 (always
+	// try).
+	MinAttemptBudget time.Duration
+
+	// MaxRetries is the number of times a provider request is retried, with
+	// exponential backoff, after a connection-level error such as a dropped
+	// keep-alive connection. HTTP error responses, timeouts, DNS failures and
+	// TLS errors are not retried. Zero disables retries, so a Config built
+	// without [DefaultConfig] does not retry. Default: 2.
 	MaxRetries int
 
+	// UserAgent is sent as the User-Agent header of every provider request,
+	// e.g. when an API gateway requires one. Nominatim keeps
+	// NominatimUserAgent. Default: empty (Go's default User-Agent).
+	UserAgent string
+
+	// DefaultHeaders are added to every provider request, e.g. a tracing
+	// header required by an API gateway. They never replace a header the
+	// provider sets itself, such as Kakao's Authorization. [New] copies the
+	// map, so changing it afterwards has no effect. Default: none.
+	DefaultHeaders map[string]string
+
 	// LogLevel sets the logging verbosity. Default: "info".
 	// Valid values: "debug", "info", "warn", "error".
 	LogLevel string
 
-	// ConcurrentLimit is the maximum concurrent requests for batch operations. Default: 10.
+	// ConcurrentLimit is the maximum concurrent requests for batch operations.
+	// It also bounds reverse batches unless ReverseConcurrentLimit is set.
+	// Default: 10.
 	ConcurrentLimit int
+
+	// ReverseConcurrentLimit is the maximum concurrent requests for reverse
+	// batch operations, including "lat,lng" entries mixed into a forward
+	// batch, so reverse-capable providers with tighter quotas can be
+	// throttled separately. Default: 0 (use ConcurrentLimit).
+	ReverseConcurrentLimit int
+
+	// BatchItemTimeout bounds the time spent on each item of a batch,
+	// including fallbacks. An item that times out is reported as failed
+	// without affecting the rest of the batch. Default: 0 (no per-item limit).
+	BatchItemTimeout time.Duration
+
+	// SequentialBatchThreshold processes batches with fewer addresses than
+	// this one at a time, without spawning goroutines. Results and ordering
+	// are identical to concurrent processing. Default: 0 (always concurrent).
+	SequentialBatchThreshold int
+
+	// FallbackAfter starts the next provider in the chain when the current
+	// one has not answered within this delay, without cancelling the slower
+	// request; the first successful result wins. Default: 0 (wait for each
+	// provider to fail before trying the next one).
+	FallbackAfter time.Duration
+
+	// Strategy selects how providers are called. [StrategyFallback] tries
+	// them one after another; [StrategyParallel] calls all of them at once
+	// and returns the fastest success, cancelling the rest, at the cost of
+	// extra quota usage. FallbackAfter is ignored with StrategyParallel.
+	// [StrategyAdaptive] tries them one after another, most successful first.
+	// [StrategyBalanced] spreads load across multiple keys of one provider.
+	// Default: StrategyFallback.
+	Strategy Strategy
+
+	// AdaptiveWindow is the number of recent calls per provider used to
+	// compute its success rate with [StrategyAdaptive]. Default: 100.
+	AdaptiveWindow int
+
+	// ProviderDailyLimits sets each provider's daily request quota, keyed by
+	// provider name (e.g., "vWorld", "Kakao"). With [StrategyBalanced], each
+	// key of that provider is weighted by how much of this quota it has left
+	// today. Default: nil (vWorld 40,000 and Kakao 100,000 requests per key;
+	// other providers are weighted equally).
+	ProviderDailyLimits map[string]int
+
+	// StopOnNotFound stops at the first provider answering "address not
+	// found" instead of handing the address to the next provider, to save
+	// quota on clearly bad addresses. System errors, timeouts and rate limits
+	// still fall back, as do addresses a provider does not cover (e.g. ones
+	// missing from [Config.LocalDatasetPath]). [Config.RegionFallback] still
+	// applies to the stopped lookup. Default: false (fall back).
+	StopOnNotFound bool
+
+	// RegionFallback retries an address that no provider could geocode with
+	// progressively coarser forms: without the building or lot number, then
+	// without the road or 동/리, then without the 구. The most specific match
+	// is returned with [Result.MatchLevel] set to [MatchLevelStreet] or
+	// [MatchLevelRegion]. Use it when an approximate coordinate is better than
+	// none. Default: false.
+	RegionFallback bool
+
+	// RegionProviders routes addresses to a preferred provider by their
+	// leading 시·도, e.g. {"서울": "Kakao", "강원": "vWorld"}. Keys are 시·도
+	// names or their common abbreviations; values are provider names. A
+	// matching address tries the preferred provider first and the others in
+	// the default order. Default: nil (always the default order).
+	RegionProviders map[string]string
+
+	// BuildingNameFallback retries an address that no provider found without
+	// the non-numeric words after its building or lot number, which are
+	// usually a building name ("세종대로 110 서울특별시청" → "세종대로 110").
+	// On success the removed words are reported in [AddressDetail.BuildingName]
+	// unless the provider returned a building name. It runs before
+	// RegionFallback. Default: false.
+	BuildingNameFallback bool
+
+	// FallbackCoordinate is returned, with [Result.IsFallback] set, by
+	// [Client.Geocode], [Client.GeocodeWithType] and [Client.GeocodeWithOptions]
+	// when every provider failed to geocode the address, instead of an error.
+	// Use it for map displays that should never be blank. Invalid addresses,
+	// addresses outside Korea, lookups with a [GeocodeOptions.CRS] other than
+	// WGS84, lookups cut short by the context or [GeocodeOptions.Timeout],
+	// batch lookups and derived lookups such as [Client.ConvertAddress] still
+	// fail. The fallback result is passed to [Config.ResultHook] like any
+	// other. Default: nil (return the error).
+	FallbackCoordinate *Coordinate
+
+	// FallbackToRegionCenter returns the city hall (시청) or provincial
+	// office (도청) of the address's leading 시·도 the same way, e.g. Busan
+	// City Hall for "부산 해운대구 없는로 1". Addresses without a recognizable
+	// 시·도 use FallbackCoordinate if set, and fail otherwise. Default: false.
+	FallbackToRegionCenter bool
+
+	// Preprocessors are applied in order to every address after the built-in
+	// normalization and before validation, for source-specific cleanup such as
+	// [StripParentheses] or [ExpandSidoAbbreviations]. Default: none.
+	Preprocessors []AddressPreprocessor
+
+	// MaxAddressLength is the longest normalized address, in characters, that
+	// is sent to a provider. Longer addresses, and addresses with control
+	// characters or a character repeated more than 10 times in a row, are
+	// rejected as invalid without calling any provider. It may be raised
+	// above the default; the HTTP server applies its own limit of 200
+	// characters to request addresses regardless. Default: 200.
+	MaxAddressLength int
+
+	// MinConfidence treats a match whose [Result.Confidence] is below this
+	// value as a soft failure and falls back to the next provider, hoping
+	// for a better match. When no provider reaches it, the most confident
+	// match is returned with [Result.LowConfidence] set. Valid values: 0–1.
+	// Default: 0 (every match is accepted).
+	MinConfidence float64
+
+	// AdminCodeLength is the number of digits kept in LegalDongCode and
+	// AdminDongCode. Default: 10 (full code).
+	// Valid values: 10 (읍면동+리), 8 (읍면동), 5 (시군구). A provider code
+	// that is not 10 digits is left out rather than truncated.
+	AdminCodeLength int
+
+	// CoordinatePrecision is the number of decimal places kept in returned
+	// coordinates. Default: 6 (about 0.1 m). Valid values: 1–9; use 9 for
+	// effectively full precision. Zero means unset and applies the default,
+	// so rounding to whole degrees cannot be configured.
+	CoordinatePrecision int
+
+	// RateLimit is the maximum number of provider requests per second across
+	// all providers. Default: 0 (unlimited).
+	// The limit is shared by single and batch geocoding, so Geocode calls made
+	// from many goroutines are paced together. When the limit is reached,
+	// Geocode blocks waiting for a token until the context deadline. Use
+	// [Config.ProviderRateLimits] to limit individual providers; a request
+	// must pass both limits.
+	RateLimit float64
+
+	// RateBurst is the maximum burst size for RateLimit and ProviderRateLimits.
+	// Default: 1 when a rate limit is set.
+	RateBurst int
+
+	// ProviderRateLimits sets per-provider request rates (requests per second),
+	// keyed by provider name (e.g., "vWorld", "Kakao"). They apply in addition
+	// to RateLimit.
+	ProviderRateLimits map[string]float64
+
+	// RateLimitBackoff lists how long a provider is disabled after each
+	// consecutive rate-limit (HTTP 429) response, e.g. 1m, 5m, 15m. Once the
+	// list is exhausted the last value is reused, and a longer Retry-After
+	// from the provider still wins. Default: nil (disable for Retry-After, or
+	// 10 minutes without it, every time). A Retry-After of 0 or a past date
+	// disables the provider for only one second.
+	RateLimitBackoff []time.Duration
+
+	// RateLimitBackoffReset is how long a provider must keep answering
+	// without being rate limited before RateLimitBackoff starts over from the
+	// first value. Default: 30 minutes.
+	RateLimitBackoffReset time.Duration
+
+	// EnforceKoreanBounds rejects provider results outside KoreanBounds and
+	// falls back to the next provider. Default: false (such results are only
+	// logged). When no provider returns a coordinate inside the bounds,
+	// Geocode returns an error wrapping [ErrOutsideKorea].
+	EnforceKoreanBounds bool
+
+	// KoreanBounds is the bounding box used to decide whether a coordinate is
+	// in Korea. Default: nil (latitude 33–43, longitude 124–132).
+	// Widen it to cover more of the EEZ.
+	KoreanBounds *Bounds
+
+	// CacheTTL enables an in-memory cache of successful single-address
+	// results for this duration. Entries are keyed by the normalized address,
+	// the requested address type and the coordinate precision, so a ROAD
+	// result is never returned for a PARCEL request. Default: 0 (caching
+	// disabled).
+	CacheTTL time.Duration
+
+	// CacheSize is the maximum number of cached results. Default: 10000 when
+	// CacheTTL is set.
+	CacheSize int
+
+	// Debug includes each provider's outgoing request URL in
+	// [Attempt.RequestURL], with API keys redacted, so provider issues can be
+	// reproduced with curl. Default: false.
+	Debug bool
+
+	// Clock supplies the current time for time-based decisions: provider
+	// cooldowns after rate limiting, daily quota counters (reset at midnight
+	// KST) and cache expiry. Default: nil (system clock). See also [WithClock].
+	Clock Clock
+
+	// ResultHook is called with every successful result before it is
+	// returned: forward, reverse and zipcode geocoding, single and batch, and
+	// the lookups behind [Client.ConvertAddress], [Client.GeocodeFull] and
+	// [Client.CanonicalKey]. Only [Client.PreloadCache] skips it. It may
+	// modify the result, e.g. to attach data from your own tables, or veto it
+	// by returning an error, which fails that result; batch calls report the
+	// rejected items in a *[ResultHookError]. Default: nil (no hook).
+	ResultHook ResultHook
 }
 
 // DefaultConfig returns a Config with sensible default values.
 func DefaultConfig() Config {
 	return Config{
-		Timeout:         5 * time.Second,
-		MaxRetries:      2,
-		LogLevel:        "info",
-		ConcurrentLimit: 10,
+		Timeout:             5 * time.Second,
+		MaxRetries:          2,
+		LogLevel:            "info",
+		ConcurrentLimit:     10,
+		AdminCodeLength:     10,
+		CoordinatePrecision: 6,
 	}
 }
 
 // Validate checks that the configuration is valid.
-// It returns an error if required fields are missing or values are out of range.
+// It returns a [*ConfigError] listing every required field that is missing
+// and every value that is out of range, not just the first one.
 func (c *Config) Validate() error {
+	var errs []error
+
 	// 최소 하나의 API 키는 필수
 	if c.VWorldAPIKey == "" && c.KakaoAPIKey == "" {
-		return fmt.Errorf("at least one API key (VWorldAPIKey or KakaoAPIKey) is required")
+		errs = append(errs, fmt.Errorf("at least one API key (VWorldAPIKey or KakaoAPIKey) is required"))
+	}
+
+	// Base URL 검증
+	if c.VWorldBaseURL != "" {
+		if _, err := provider.NormalizeBaseURL(c.VWorldBaseURL); err != nil {
+			errs = append(errs, fmt.Errorf("vWorldBaseURL: %w", err))
+		}
+	}
+
+	if c.KakaoBaseURL != "" {
+		if _, err := provider.NormalizeBaseURL(c.KakaoBaseURL); err != nil {
+			errs = append(errs, fmt.Errorf("kakaoBaseURL: %w", err))
+		}
+	}
+
+	if c.NominatimBaseURL != "" {
+		if _, err := provider.NormalizeBaseURL(c.NominatimBaseURL); err != nil {
+			errs = append(errs, fmt.Errorf("nominatimBaseURL: %w", err))
+		}
+		if strings.TrimSpace(c.NominatimUserAgent) == "" {
+			errs = append(errs, fmt.Errorf("nominatimUserAgent is required when nominatimBaseURL is set"))
+		}
+	}
+
+	// Kakao analyze_type 검증
+	if _, err := provider.ParseKakaoAnalyzeType(string(c.KakaoAnalyzeType)); err != nil {
+		errs = append(errs, fmt.Errorf("kakaoAnalyzeType: %w", err))
+	}
+
+	if _, err := provider.ParseAddressTypes(toStrings(c.VWorldAddressTypes)); err != nil {
+		errs = append(errs, fmt.Errorf("vWorldAddressTypes: %w", err))
 	}
 
 	// Timeout 검증
 	if c.Timeout < 0 {
-		return fmt.Errorf("timeout cannot be negative")
+		errs = append(errs, fmt.Errorf("timeout cannot be negative"))
+	}
+	if c.ProviderTimeout < 0 {
+		errs = append(errs, fmt.Errorf("providerTimeout cannot be negative"))
+	}
+	if c.MinAttemptBudget < 0 {
+		errs = append(errs, fmt.Errorf("minAttemptBudget cannot be negative"))
+	}
+
+	for sido := range c.RegionProviders {
+		if utils.LeadingSido(sido) == "" || strings.Contains(strings.TrimSpace(sido), " ") {
+			errs = append(errs, fmt.Errorf("regionProviders: unknown 시·도 %q", sido))
+		}
 	}
 
 	// MaxRetries 검증
 	if c.MaxRetries < 0 {
-		return fmt.Errorf("maxRetries cannot be negative")
+		errs = append(errs, fmt.Errorf("maxRetries cannot be negative"))
+	}
+
+	// DefaultHeaders 검증
+	for name, value := range c.DefaultHeaders {
+		if !validHeaderName(name) {
+			errs = append(errs, fmt.Errorf("defaultHeaders: invalid header name %q", name))
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			errs = append(errs, fmt.Errorf("defaultHeaders: value of %q must not contain line breaks", name))
+		}
 	}
 
 	// ConcurrentLimit 검증
 	if c.ConcurrentLimit < 1 {
-		return fmt.Errorf("concurrentLimit must be at least 1")
+		errs = append(errs, fmt.Errorf("concurrentLimit must be at least 1"))
 	}
 
 	if c.ConcurrentLimit > 100 {
-		return fmt.Errorf("concurrentLimit cannot exceed 100")
+		errs = append(errs, fmt.Errorf("concurrentLimit cannot exceed 100"))
+	}
+
+	// ReverseConcurrentLimit 검증 (0이면 ConcurrentLimit 사용)
+	if c.ReverseConcurrentLimit < 0 {
+		errs = append(errs, fmt.Errorf("reverseConcurrentLimit cannot be negative"))
+	}
+
+	if c.ReverseConcurrentLimit > 100 {
+		errs = append(errs, fmt.Errorf("reverseConcurrentLimit cannot exceed 100"))
+	}
+
+	// BatchItemTimeout 검증
+	if c.BatchItemTimeout < 0 {
+		errs = append(errs, fmt.Errorf("batchItemTimeout cannot be negative"))
+	}
+
+	// SequentialBatchThreshold 검증
+	if c.SequentialBatchThreshold < 0 {
+		errs = append(errs, fmt.Errorf("sequentialBatchThreshold cannot be negative"))
+	}
+
+	// FallbackAfter 검증
+	if c.FallbackAfter < 0 {
+		errs = append(errs, fmt.Errorf("fallbackAfter cannot be negative"))
+	}
+
+	// Strategy 검증
+	switch c.Strategy {
+	case "", StrategyFallback, StrategyParallel, StrategyAdaptive, StrategyBalanced:
+	default:
+		errs = append(errs, fmt.Errorf("invalid strategy: %q (must be %q, %q, %q or %q)", c.Strategy, StrategyFallback, StrategyParallel, StrategyAdaptive, StrategyBalanced))
+	}
+
+	// AdaptiveWindow 검증
+	if c.AdaptiveWindow < 0 {
+		errs = append(errs, fmt.Errorf("adaptiveWindow cannot be negative"))
+	}
+
+	for name, limit := range c.ProviderDailyLimits {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("providerDailyLimits[%s] cannot be negative", name))
+		}
+	}
+
+	// Preprocessors 검증
+	for i, preprocess := range c.Preprocessors {
+		if preprocess == nil {
+			errs = append(errs, fmt.Errorf("preprocessors[%d] is nil", i))
+		}
+	}
+
+	// MaxAddressLength 검증
+	if c.MaxAddressLength < 0 {
+		errs = append(errs, fmt.Errorf("maxAddressLength cannot be negative"))
+	}
+
+	// MinConfidence 검증
+	if c.MinConfidence < 0 || c.MinConfidence > 1 {
+		errs = append(errs, fmt.Errorf("minConfidence must be between 0 and 1"))
+	}
+
+	// Cache 검증
+	if c.CacheTTL < 0 {
+		errs = append(errs, fmt.Errorf("cacheTTL cannot be negative"))
+	}
+
+	if c.CacheSize < 0 {
+		errs = append(errs, fmt.Errorf("cacheSize cannot be negative"))
+	}
+
+	// AdminCodeLength 검증 (0은 기본값 적용)
+	switch c.AdminCodeLength {
+	case 0, 5, 8, 10:
+	default:
+		errs = append(errs, fmt.Errorf("invalid adminCodeLength: %d (must be one of: 10, 8, 5)", c.AdminCodeLength))
+	}
+
+	// CoordinatePrecision 검증 (0은 미설정으로 보고 기본값 적용, 정수 단위 반올림은 지원하지 않음)
+	if c.CoordinatePrecision < 0 || c.CoordinatePrecision > 9 {
+		errs = append(errs, fmt.Errorf("invalid coordinatePrecision: %d (must be between 1 and 9, or 0 for the default)", c.CoordinatePrecision))
+	}
+
+	// RateLimit 검증
+	if c.RateLimit < 0 {
+		errs = append(errs, fmt.Errorf("rateLimit cannot be negative"))
+	}
+
+	if c.RateBurst < 0 {
+		errs = append(errs, fmt.Errorf("rateBurst cannot be negative"))
+	}
+
+	for name, limit := range c.ProviderRateLimits {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("providerRateLimits[%s] cannot be negative", name))
+		}
+	}
+
+	for i, d := range c.RateLimitBackoff {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("rateLimitBackoff[%d] must be positive", i))
+		}
+	}
+
+	if c.RateLimitBackoffReset < 0 {
+		errs = append(errs, fmt.Errorf("rateLimitBackoffReset cannot be negative"))
+	}
+
+	// KoreanBounds 검증
+	if b := c.KoreanBounds; b != nil {
+		if err := b.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("koreanBounds %w", err))
+		}
+	}
+
+	if p := c.FallbackCoordinate; p != nil && !utils.ValidateCoordinate(p.Latitude, p.Longitude) {
+		errs = append(errs, fmt.Errorf("fallbackCoordinate out of range: %s", utils.FormatCoordinate(p.Latitude, p.Longitude)))
 	}
 
 	// LogLevel 검증
@@ -91,9 +556,12 @@ func (c *Config) Validate() error {
 		"error": true,
 	}
 	if c.LogLevel != "" && !validLevels[c.LogLevel] {
-		return fmt.Errorf("invalid log level: %s (must be one of: debug, info, warn, error)", c.LogLevel)
+		errs = append(errs, fmt.Errorf("invalid log level: %s (must be one of: debug, info, warn, error)", c.LogLevel))
 	}
 
+	if len(errs) > 0 {
+		return &ConfigError{Errors: errs}
+	}
 	return nil
 }
 
@@ -103,10 +571,6 @@ func (c *Config) SetDefaults() {
 		c.Timeout = 5 * time.Second
 	}
 
-	if c.MaxRetries == 0 {
-		c.MaxRetries = 2
-	}
-
 	if c.LogLevel == "" {
 		c.LogLevel = "info"
 	}
@@ -114,4 +578,36 @@ func (c *Config) SetDefaults() {
 	if c.ConcurrentLimit == 0 {
 		c.ConcurrentLimit = 10
 	}
+
+	if c.AdminCodeLength == 0 {
+		c.AdminCodeLength = 10
+	}
+
+	if c.CoordinatePrecision == 0 {
+		c.CoordinatePrecision = 6
+	}
+
+	if c.RateBurst == 0 && (c.RateLimit > 0 || len(c.ProviderRateLimits) > 0) {
+		c.RateBurst = 1
+	}
+
+	if c.CacheSize == 0 && c.CacheTTL > 0 {
+		c.CacheSize = 10000
+	}
+}
+
+// validHeaderName reports whether name is a valid HTTP header field name (RFC 9110 token).
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,32 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	"context"
+
+	"github.com/oursportsnation/k-geocode/pkg/logger"
+)
+
+// WithRequestID returns a copy of ctx carrying a correlation ID. Every log
+// line the client emits while serving a call made with that context, including
+// the per-provider lines, carries it as the "request_id" field, so library logs
+// can be matched with the caller's own request logs.
+//
+//	ctx = geocoding.WithRequestID(ctx, r.Header.Get("X-Request-ID"))
+//	result, err := client.Geocode(ctx, address)
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return logger.WithRequestID(ctx, requestID)
+}
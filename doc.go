@@ -70,4 +70,34 @@
 //	result, err := client.GeocodeWithType(ctx, address, geocoding.AddressTypeRoad)
 //
 // If no type is specified, both types are tried automatically.
+//
+// # Per-Call Options
+//
+// Use [Client.GeocodeWithOptions] to restrict providers, set a deadline, or
+// bypass the cache for a single call:
+//
+//	result, err := client.GeocodeWithOptions(ctx, address, geocoding.GeocodeOptions{
+//	    Providers: []string{"Kakao"},
+//	    Timeout:   2 * time.Second,
+//	    SkipCache: true,
+//	})
+//
+// [Client.GeocodeBatchWithOptions] does the same for batches. For example, to
+// keep only results inside Seoul:
+//
+//	items, err := client.GeocodeBatchWithOptions(ctx, addresses, geocoding.BatchOptions{
+//	    Bounds: &geocoding.Bounds{MinLatitude: 37.41, MaxLatitude: 37.72, MinLongitude: 126.73, MaxLongitude: 127.27},
+//	})
+//	for _, item := range items {
+//	    if errors.Is(item.Err, geocoding.ErrOutsideBounds) {
+//	        // outside Seoul
+//	    }
+//	}
+//
+// # Log Correlation
+//
+// Pass a correlation ID with [WithRequestID] to tag every log line emitted
+// during a call, including per-provider lines, with a "request_id" field:
+//
+//	result, err := client.Geocode(geocoding.WithRequestID(ctx, requestID), address)
 package geocoding
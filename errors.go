@@ -0,0 +1,122 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/service"
+)
+
+// ErrNoProvidersAvailable is returned when every configured provider is
+// disabled (e.g., all API keys expired or rate-limited). It is returned before
+// any provider is called, so callers can distinguish "system down" from an
+// address that was genuinely not found.
+var ErrNoProvidersAvailable = service.ErrNoProvidersAvailable
+
+// ErrOutsideKorea is returned when [Config.EnforceKoreanBounds] is set and no
+// provider returned a coordinate inside [Config.KoreanBounds].
+var ErrOutsideKorea = service.ErrOutsideKorea
+
+// ErrOutsideBounds is reported for batch items whose result lies outside
+// [BatchOptions.Bounds].
+var ErrOutsideBounds = service.ErrOutsideBounds
+
+// ErrInvalidAddress is returned when an address is rejected before any
+// provider is called: it is too short or longer than
+// [Config.MaxAddressLength], has no Korean characters, or contains control
+// characters or excessive repetition.
+var ErrInvalidAddress = provider.ErrInvalidAddress
+
+// ErrInvalidAPIKey is wrapped by the error returned from [Client.Validate]
+// for each provider whose API key was rejected.
+var ErrInvalidAPIKey = service.ErrInvalidAPIKey
+
+// ErrAddressFormUnavailable is returned by [Client.ConvertAddress] when the
+// provider's result does not include the requested address form.
+var ErrAddressFormUnavailable = errors.New("address form not available")
+
+// ErrImpreciseMatch is returned by [Client.ConvertAddress] and
+// [Client.CanonicalKey] when the address matched only at [MatchLevelStreet]
+// or coarser, or with [Result.LowConfidence] set, so the match does not
+// identify the input's building or parcel.
+var ErrImpreciseMatch = errors.New("match is not precise enough")
+
+// ErrCacheDisabled is returned by [Client.PreloadCache] when no cache is
+// configured ([Config.CacheTTL] is zero).
+var ErrCacheDisabled = errors.New("cache is not configured")
+
+// ErrInvalidZipcode is returned by [Client.GeocodeZipcode] when the zipcode
+// is not a 5-digit Korean postal code (국가기초구역번호, 01000–63999).
+var ErrInvalidZipcode = errors.New("invalid zipcode")
+
+// PreloadError is returned by [Client.PreloadCache] when some addresses could
+// not be geocoded. The results of the other addresses were cached.
+type PreloadError struct {
+	Succeeded int // addresses whose results were cached
+	Failed    int // addresses that could not be geocoded
+}
+
+func (e *PreloadError) Error() string {
+	return fmt.Sprintf("preload cache: %d of %d addresses failed", e.Failed, e.Succeeded+e.Failed)
+}
+
+// ResultHookError is returned together with the results by
+// [Client.GeocodeBatch], [Client.GeocodeLarge] and
+// [Client.ReverseGeocodeBatch] when [Config.ResultHook] rejected some
+// results. Those entries are nil in the results; Errors maps their index to
+// the hook's error. The other results are valid. errors.Is and errors.As look
+// through all of the hook's errors.
+type ResultHookError struct {
+	Errors map[int]error
+}
+
+func (e *ResultHookError) Error() string {
+	return fmt.Sprintf("result hook rejected %d results", len(e.Errors))
+}
+
+func (e *ResultHookError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, i := range slices.Sorted(maps.Keys(e.Errors)) {
+		errs = append(errs, e.Errors[i])
+	}
+	return errs
+}
+
+// ConfigError is returned by [Config.Validate] (and wrapped by [New]) when
+// the configuration has one or more problems. Errors holds every problem,
+// in the order the fields are checked; the message lists them one per line.
+// errors.Is and errors.As look through all of them.
+type ConfigError struct {
+	Errors []error
+}
+
+func (e *ConfigError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns every validation error.
+func (e *ConfigError) Unwrap() []error {
+	return e.Errors
+}
@@ -17,13 +17,29 @@ package geocoding
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/oursportsnation/k-geocode/internal/cache"
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/service"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -33,6 +49,8 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Equal(t, 2, cfg.MaxRetries)
 	assert.Equal(t, "info", cfg.LogLevel)
 	assert.Equal(t, 10, cfg.ConcurrentLimit)
+	assert.Equal(t, 10, cfg.AdminCodeLength)
+	assert.Equal(t, 6, cfg.CoordinatePrecision)
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -83,6 +101,36 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "timeout cannot be negative",
 		},
+		{
+			name: "negative provider timeout",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ProviderTimeout: -1 * time.Second,
+				ConcurrentLimit: 10,
+			},
+			wantErr: true,
+			errMsg:  "providerTimeout cannot be negative",
+		},
+		{
+			name: "negative min attempt budget",
+			config: Config{
+				VWorldAPIKey:     "test-key",
+				MinAttemptBudget: -1 * time.Second,
+				ConcurrentLimit:  10,
+			},
+			wantErr: true,
+			errMsg:  "minAttemptBudget cannot be negative",
+		},
+		{
+			name: "unknown region in region providers",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				RegionProviders: map[string]string{"서울시청": "Kakao"},
+			},
+			wantErr: true,
+			errMsg:  "regionProviders: unknown 시·도 \"서울시청\"",
+		},
 		{
 			name: "negative max retries",
 			config: Config{
@@ -93,6 +141,26 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "maxRetries cannot be negative",
 		},
+		{
+			name: "invalid default header name",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				DefaultHeaders:  map[string]string{"X Trace": "1"},
+			},
+			wantErr: true,
+			errMsg:  "defaultHeaders: invalid header name",
+		},
+		{
+			name: "non-positive rate limit backoff",
+			config: Config{
+				VWorldAPIKey:     "test-key",
+				ConcurrentLimit:  10,
+				RateLimitBackoff: []time.Duration{time.Minute, 0},
+			},
+			wantErr: true,
+			errMsg:  "rateLimitBackoff[1] must be positive",
+		},
 		{
 			name: "concurrent limit too low",
 			config: Config{
@@ -111,6 +179,26 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "concurrentLimit cannot exceed 100",
 		},
+		{
+			name: "reverse concurrent limit negative",
+			config: Config{
+				VWorldAPIKey:           "test-key",
+				ConcurrentLimit:        10,
+				ReverseConcurrentLimit: -1,
+			},
+			wantErr: true,
+			errMsg:  "reverseConcurrentLimit cannot be negative",
+		},
+		{
+			name: "reverse concurrent limit too high",
+			config: Config{
+				VWorldAPIKey:           "test-key",
+				ConcurrentLimit:        10,
+				ReverseConcurrentLimit: 101,
+			},
+			wantErr: true,
+			errMsg:  "reverseConcurrentLimit cannot exceed 100",
+		},
 		{
 			name: "invalid log level",
 			config: Config{
@@ -121,6 +209,272 @@ func TestConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid log level",
 		},
+		{
+			name: "invalid admin code length",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				AdminCodeLength: 7,
+			},
+			wantErr: true,
+			errMsg:  "invalid adminCodeLength",
+		},
+		{
+			name: "valid admin code length",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				AdminCodeLength: 5,
+			},
+			wantErr: false,
+		},
+		{
+			name: "coordinate precision too large",
+			config: Config{
+				VWorldAPIKey:        "test-key",
+				ConcurrentLimit:     10,
+				CoordinatePrecision: 10,
+			},
+			wantErr: true,
+			errMsg:  "invalid coordinatePrecision",
+		},
+		{
+			name: "negative coordinate precision",
+			config: Config{
+				VWorldAPIKey:        "test-key",
+				ConcurrentLimit:     10,
+				CoordinatePrecision: -1,
+			},
+			wantErr: true,
+			errMsg:  "invalid coordinatePrecision",
+		},
+		{
+			name: "valid coordinate precision",
+			config: Config{
+				VWorldAPIKey:        "test-key",
+				ConcurrentLimit:     10,
+				CoordinatePrecision: 9,
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative rate limit",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				RateLimit:       -1,
+			},
+			wantErr: true,
+			errMsg:  "rateLimit cannot be negative",
+		},
+		{
+			name: "negative provider rate limit",
+			config: Config{
+				VWorldAPIKey:       "test-key",
+				ConcurrentLimit:    10,
+				ProviderRateLimits: map[string]float64{"vWorld": -5},
+			},
+			wantErr: true,
+			errMsg:  "providerRateLimits[vWorld] cannot be negative",
+		},
+		{
+			name: "negative sequential batch threshold",
+			config: Config{
+				VWorldAPIKey:             "test-key",
+				ConcurrentLimit:          10,
+				SequentialBatchThreshold: -1,
+			},
+			wantErr: true,
+			errMsg:  "sequentialBatchThreshold cannot be negative",
+		},
+		{
+			name: "negative fallback after",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				FallbackAfter:   -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "fallbackAfter cannot be negative",
+		},
+		{
+			name: "negative cache ttl",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				CacheTTL:        -time.Minute,
+			},
+			wantErr: true,
+			errMsg:  "cacheTTL cannot be negative",
+		},
+		{
+			name: "negative batch item timeout",
+			config: Config{
+				VWorldAPIKey:     "test-key",
+				ConcurrentLimit:  10,
+				BatchItemTimeout: -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "batchItemTimeout cannot be negative",
+		},
+		{
+			name: "valid custom korean bounds",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				KoreanBounds:    &Bounds{MinLatitude: 32.0, MaxLatitude: 44.0, MinLongitude: 123.0, MaxLongitude: 133.0},
+			},
+			wantErr: false,
+		},
+		{
+			name: "korean bounds min not less than max",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				KoreanBounds:    &Bounds{MinLatitude: 43.0, MaxLatitude: 33.0, MinLongitude: 124.0, MaxLongitude: 132.0},
+			},
+			wantErr: true,
+			errMsg:  "koreanBounds minimum must be less than maximum",
+		},
+		{
+			name: "korean bounds outside WGS84",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				KoreanBounds:    &Bounds{MinLatitude: 33.0, MaxLatitude: 95.0, MinLongitude: 124.0, MaxLongitude: 132.0},
+			},
+			wantErr: true,
+			errMsg:  "koreanBounds must be within WGS84 range",
+		},
+		{
+			name: "fallback coordinate out of range",
+			config: Config{
+				VWorldAPIKey:       "test-key",
+				ConcurrentLimit:    10,
+				FallbackCoordinate: &Coordinate{Latitude: 137.5, Longitude: 127.0},
+			},
+			wantErr: true,
+			errMsg:  "fallbackCoordinate out of range",
+		},
+		{
+			name: "valid parallel strategy",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				Strategy:        StrategyParallel,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid adaptive strategy",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				Strategy:        StrategyAdaptive,
+				AdaptiveWindow:  50,
+			},
+			wantErr: false,
+		},
+		{
+			name: "negative adaptive window",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				Strategy:        StrategyAdaptive,
+				AdaptiveWindow:  -1,
+			},
+			wantErr: true,
+			errMsg:  "adaptiveWindow cannot be negative",
+		},
+		{
+			name: "negative max address length",
+			config: Config{
+				VWorldAPIKey:     "test-key",
+				ConcurrentLimit:  10,
+				MaxAddressLength: -1,
+			},
+			wantErr: true,
+			errMsg:  "maxAddressLength cannot be negative",
+		},
+		{
+			name: "max address length above default",
+			config: Config{
+				VWorldAPIKey:     "test-key",
+				ConcurrentLimit:  10,
+				MaxAddressLength: 500,
+			},
+			wantErr: false,
+		},
+		{
+			name: "min confidence above 1",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				MinConfidence:   1.5,
+			},
+			wantErr: true,
+			errMsg:  "minConfidence must be between 0 and 1",
+		},
+		{
+			name: "invalid kakao analyze type",
+			config: Config{
+				KakaoAPIKey:      "test-key",
+				ConcurrentLimit:  10,
+				KakaoAnalyzeType: "fuzzy",
+			},
+			wantErr: true,
+			errMsg:  "kakaoAnalyzeType",
+		},
+		{
+			name: "invalid strategy",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				Strategy:        "fastest",
+			},
+			wantErr: true,
+			errMsg:  "invalid strategy",
+		},
+		{
+			name: "nil preprocessor",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				ConcurrentLimit: 10,
+				Preprocessors:   []AddressPreprocessor{StripParentheses, nil},
+			},
+			wantErr: true,
+			errMsg:  "preprocessors[1] is nil",
+		},
+		{
+			name: "nominatim without user agent",
+			config: Config{
+				KakaoAPIKey:      "test-key",
+				NominatimBaseURL: "http://nominatim.internal:8080",
+				ConcurrentLimit:  10,
+			},
+			wantErr: true,
+			errMsg:  "nominatimUserAgent is required",
+		},
+		{
+			name: "invalid base URL scheme",
+			config: Config{
+				KakaoAPIKey:     "test-key",
+				KakaoBaseURL:    "ftp://dapi.kakao.com",
+				ConcurrentLimit: 10,
+			},
+			wantErr: true,
+			errMsg:  "kakaoBaseURL",
+		},
+		{
+			name: "base URL without host",
+			config: Config{
+				VWorldAPIKey:    "test-key",
+				VWorldBaseURL:   "http://",
+				ConcurrentLimit: 10,
+			},
+			wantErr: true,
+			errMsg:  "vWorldBaseURL",
+		},
 		{
 			name: "valid log levels",
 			config: Config{
@@ -145,6 +499,31 @@ func TestConfig_Validate(t *testing.T) {
 	}
 }
 
+func TestConfig_Validate_ReportsAllProblems(t *testing.T) {
+	cfg := Config{
+		VWorldAPIKey:    "test-key",
+		Timeout:         -1 * time.Second,
+		ConcurrentLimit: 101,
+		LogLevel:        "verbose",
+	}
+
+	err := cfg.Validate()
+
+	var cfgErr *ConfigError
+	require.ErrorAs(t, err, &cfgErr)
+	require.Len(t, cfgErr.Errors, 3)
+	assert.EqualError(t, cfgErr.Errors[0], "timeout cannot be negative")
+	assert.EqualError(t, cfgErr.Errors[1], "concurrentLimit cannot exceed 100")
+	assert.EqualError(t, cfgErr.Errors[2], "invalid log level: verbose (must be one of: debug, info, warn, error)")
+	assert.Equal(t, "timeout cannot be negative\n"+
+		"concurrentLimit cannot exceed 100\n"+
+		"invalid log level: verbose (must be one of: debug, info, warn, error)", err.Error())
+
+	_, err = New(cfg)
+	require.ErrorAs(t, err, &cfgErr, "New가 감싼 에러에서도 모든 문제를 꺼낼 수 있음")
+	assert.Len(t, cfgErr.Errors, 3)
+}
+
 func TestConfig_SetDefaults(t *testing.T) {
 	cfg := Config{
 		VWorldAPIKey: "test-key",
@@ -153,9 +532,10 @@ func TestConfig_SetDefaults(t *testing.T) {
 	cfg.SetDefaults()
 
 	assert.Equal(t, 5*time.Second, cfg.Timeout)
-	assert.Equal(t, 2, cfg.MaxRetries)
+	assert.Zero(t, cfg.MaxRetries, "0은 재시도 없음이므로 기본값으로 바꾸지 않음")
 	assert.Equal(t, "info", cfg.LogLevel)
 	assert.Equal(t, 10, cfg.ConcurrentLimit)
+	assert.Equal(t, 6, cfg.CoordinatePrecision)
 }
 
 func TestConfig_SetDefaults_PreservesExisting(t *testing.T) {
@@ -175,6 +555,26 @@ func TestConfig_SetDefaults_PreservesExisting(t *testing.T) {
 	assert.Equal(t, 20, cfg.ConcurrentLimit)
 }
 
+func TestConfig_SetDefaults_RateBurst(t *testing.T) {
+	cfg := Config{VWorldAPIKey: "test-key", RateLimit: 5}
+	cfg.SetDefaults()
+	assert.Equal(t, 1, cfg.RateBurst)
+
+	cfg = Config{VWorldAPIKey: "test-key"}
+	cfg.SetDefaults()
+	assert.Equal(t, 0, cfg.RateBurst)
+}
+
+func TestNewLimiters(t *testing.T) {
+	assert.Nil(t, newLimiter(0, 1))
+	assert.NotNil(t, newLimiter(10, 1))
+
+	limiters := newProviderLimiters(map[string]float64{"vWorld": 10, "Kakao": 0}, 1)
+	assert.Contains(t, limiters, "vWorld")
+	assert.NotContains(t, limiters, "Kakao")
+	assert.Nil(t, newProviderLimiters(nil, 1))
+}
+
 func TestAddressType_Constants(t *testing.T) {
 	assert.Equal(t, AddressType("ROAD"), AddressTypeRoad)
 	assert.Equal(t, AddressType("PARCEL"), AddressTypeParcel)
@@ -233,59 +633,22 @@ func TestResult_Struct(t *testing.T) {
 	assert.True(t, result.Attempts[0].Success)
 }
 
-// Mock vWorld API response
-type vWorldResponse struct {
-	Response struct {
-		Status string `json:"status"`
-		Result struct {
-			Items []struct {
-				Point struct {
-					X string `json:"x"`
-					Y string `json:"y"`
-				} `json:"point"`
-				Address struct {
-					Road   string `json:"road"`
-					Parcel string `json:"parcel"`
-				} `json:"address"`
-			} `json:"items"`
-		} `json:"result"`
-	} `json:"response"`
-}
-
+// createMockVWorldServer vWorld API 응답을 흉내내는 테스트 서버 (WithBaseURL과 함께 사용)
 func createMockVWorldServer(success bool) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
-		if success {
-			resp := vWorldResponse{}
-			resp.Response.Status = "OK"
-			resp.Response.Result.Items = []struct {
-				Point struct {
-					X string `json:"x"`
-					Y string `json:"y"`
-				} `json:"point"`
-				Address struct {
-					Road   string `json:"road"`
-					Parcel string `json:"parcel"`
-				} `json:"address"`
-			}{
-				{
-					Point: struct {
-						X string `json:"x"`
-						Y string `json:"y"`
-					}{X: "126.978000", Y: "37.566500"},
-					Address: struct {
-						Road   string `json:"road"`
-						Parcel string `json:"parcel"`
-					}{Road: "서울특별시 중구 세종대로 110", Parcel: "서울특별시 중구 태평로1가 31"},
-				},
-			}
-			json.NewEncoder(w).Encode(resp)
-		} else {
-			resp := vWorldResponse{}
-			resp.Response.Status = "NOT_FOUND"
-			json.NewEncoder(w).Encode(resp)
+		if !success {
+			w.Write([]byte(`{"response": {"status": "NOT_FOUND"}}`))
+			return
 		}
+		w.Write([]byte(`{
+			"response": {
+				"status": "OK",
+				"result": {"crs": "EPSG:4326", "point": {"x": "126.978000", "y": "37.566500"}},
+				"refined": {"text": "서울특별시 중구 세종대로 110"}
+			}
+		}`))
 	}))
 }
 
@@ -299,6 +662,7 @@ func TestClient_Close(t *testing.T) {
 
 	err = client.Close()
 	assert.NoError(t, err)
+	assert.NoError(t, client.Close(), "두 번째 호출은 아무것도 하지 않음")
 }
 
 func TestClient_GetProviders(t *testing.T) {
@@ -440,3 +804,2025 @@ func TestClient_GeocodeBatch_TooMany(t *testing.T) {
 	assert.Nil(t, results)
 	assert.Contains(t, err.Error(), "too many addresses")
 }
+
+func TestClient_Geocode_NoProvidersAvailable(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "vworld-key"
+	cfg.KakaoAPIKey = "kakao-key"
+
+	client, err := New(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	for _, p := range client.providers {
+		p.Disable("API key expired")
+	}
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, result)
+	assert.False(t, client.IsAvailable(context.Background()))
+
+	results, err := client.GeocodeBatch(context.Background(), []string{"서울특별시 중구 세종대로 110"})
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, results)
+}
+
+// foreignProvider 항상 한국 영역 밖 좌표(도쿄)를 반환하는 Provider
+type foreignProvider struct{}
+
+func (foreignProvider) Name() string                         { return "Foreign" }
+func (foreignProvider) IsAvailable(ctx context.Context) bool { return true }
+func (foreignProvider) Disable(reason string)                {}
+func (foreignProvider) Enable()                              {}
+func (foreignProvider) IsDisabled() bool                     { return false }
+func (foreignProvider) GetDisableReason() string             { return "" }
+func (foreignProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 35.689487, Longitude: 139.691706},
+	}, nil
+}
+
+func TestClient_Geocode_EnforceKoreanBounds(t *testing.T) {
+	providers := []provider.GeocodingProvider{foreignProvider{}}
+
+	t.Run("enforced", func(t *testing.T) {
+		client := &Client{
+			service: service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{
+				EnforceKoreanBounds: true,
+			}),
+			providers: providers,
+		}
+
+		result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.ErrorIs(t, err, ErrOutsideKorea)
+		assert.Nil(t, result)
+	})
+
+	t.Run("not enforced", func(t *testing.T) {
+		client := &Client{
+			service:   service.NewGeocodingService(providers, zap.NewNop()),
+			providers: providers,
+		}
+
+		result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.InDelta(t, 35.689487, result.Latitude, 0.000001)
+	})
+}
+
+// countingProvider 호출 횟수를 기록하고 고정 좌표를 반환하는 Provider
+type countingProvider struct {
+	name  string
+	calls int
+}
+
+func (p *countingProvider) Name() string                         { return p.name }
+func (p *countingProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *countingProvider) Disable(reason string)                {}
+func (p *countingProvider) Enable()                              {}
+func (p *countingProvider) IsDisabled() bool                     { return false }
+func (p *countingProvider) GetDisableReason() string             { return "" }
+func (p *countingProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.calls++
+	return &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+	}, nil
+}
+
+func TestClient_GeocodeWithOptions_Providers(t *testing.T) {
+	vworld := &countingProvider{name: "vWorld"}
+	kakao := &countingProvider{name: "Kakao"}
+	providers := []provider.GeocodingProvider{vworld, kakao}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+
+	result, err := client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{
+		Providers: []string{"kakao"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Equal(t, 0, vworld.calls)
+	assert.Equal(t, 1, kakao.calls)
+	require.Len(t, result.Attempts, 1)
+
+	_, err = client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{
+		Providers: []string{"Naver"},
+	})
+	assert.EqualError(t, err, "unknown provider: Naver")
+}
+
+func TestClient_GeocodeWithOptions_SkipCache(t *testing.T) {
+	vworld := &countingProvider{name: "vWorld"}
+	kakao := &countingProvider{name: "Kakao"}
+	providers := []provider.GeocodingProvider{vworld, kakao}
+	client := &Client{
+		service: service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{
+			Cache: cache.NewMemory(time.Minute, 0),
+		}),
+		providers: providers,
+	}
+	ctx := context.Background()
+	address := "서울특별시 중구 세종대로 110"
+
+	_, err := client.Geocode(ctx, address)
+	require.NoError(t, err)
+	_, err = client.Geocode(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, 1, vworld.calls, "두 번째 호출은 캐시에서 응답")
+
+	_, err = client.GeocodeWithOptions(ctx, address, GeocodeOptions{SkipCache: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, vworld.calls, "SkipCache는 Provider를 다시 호출")
+
+	// vWorld 결과가 캐시되어 있어도 Kakao로 제한하면 Kakao를 호출
+	result, err := client.GeocodeWithOptions(ctx, address, GeocodeOptions{Providers: []string{"Kakao"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Equal(t, 1, kakao.calls)
+}
+
+func TestClient_PreloadCache(t *testing.T) {
+	var calls atomic.Int32
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if strings.Contains(r.URL.Query().Get("address"), "없는길") {
+			w.Write([]byte(`{"response": {"status": "NOT_FOUND"}}`))
+			return
+		}
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "126.978000", "y": "37.566500"}}}}`))
+	}))
+	defer vworld.Close()
+
+	newClient := func(t *testing.T, cacheTTL time.Duration) *Client {
+		cfg := DefaultConfig()
+		cfg.VWorldAPIKey = "test-key"
+		cfg.CacheTTL = cacheTTL
+		client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+		require.NoError(t, err)
+		return client
+	}
+	ctx := context.Background()
+	addresses := []string{"서울특별시 중구 세종대로 110", "서울특별시 종로구 사직로 161", "부산광역시 해운대구 해운대해변로 264"}
+
+	t.Run("preloaded addresses are cache hits", func(t *testing.T) {
+		calls.Store(0)
+		client := newClient(t, time.Minute)
+
+		require.NoError(t, client.PreloadCache(ctx, addresses))
+		preloadCalls := calls.Load()
+		assert.Equal(t, int32(len(addresses)), preloadCalls)
+
+		for _, address := range addresses {
+			result, err := client.Geocode(ctx, address)
+			require.NoError(t, err)
+			assert.Equal(t, 37.5665, result.Latitude)
+		}
+		assert.Equal(t, preloadCalls, calls.Load(), "캐시에서 응답하므로 Provider를 다시 호출하지 않음")
+	})
+
+	t.Run("partial failure reports counts", func(t *testing.T) {
+		client := newClient(t, time.Minute)
+
+		err := client.PreloadCache(ctx, append(addresses, "서울특별시 중구 없는길 1"))
+
+		var preloadErr *PreloadError
+		require.ErrorAs(t, err, &preloadErr)
+		assert.Equal(t, len(addresses), preloadErr.Succeeded)
+		assert.Equal(t, 1, preloadErr.Failed)
+	})
+
+	t.Run("no cache configured", func(t *testing.T) {
+		client := newClient(t, 0)
+
+		assert.ErrorIs(t, client.PreloadCache(ctx, addresses), ErrCacheDisabled)
+	})
+}
+
+func TestClient_GeocodeWithOptions_CRS(t *testing.T) {
+	var gotCRS string
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCRS = r.URL.Query().Get("crs")
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:5186", "point": {"x": "198056.45", "y": "551885.74"}}}}`))
+	}))
+	defer vworld.Close()
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Kakao는 좌표계를 지정할 수 없으므로 호출하지 않음")
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.KakaoAPIKey = "test-key"
+	cfg.CacheTTL = time.Minute
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+	address := "서울특별시 중구 세종대로 110"
+
+	result, err := client.GeocodeWithOptions(ctx, address, GeocodeOptions{CRS: "epsg:5186"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "epsg:5186", gotCRS)
+	assert.Equal(t, "vWorld", result.Provider)
+	assert.Equal(t, "EPSG:5186", result.CRS)
+	assert.Equal(t, 551885.74, result.Latitude)
+	assert.Equal(t, 198056.45, result.Longitude)
+
+	t.Run("unsupported CRS", func(t *testing.T) {
+		_, err := client.GeocodeWithOptions(ctx, address, GeocodeOptions{CRS: "EPSG:9999"})
+		assert.ErrorContains(t, err, "unsupported CRS")
+	})
+
+	t.Run("provider without CRS support", func(t *testing.T) {
+		_, err := client.GeocodeWithOptions(ctx, address, GeocodeOptions{CRS: "EPSG:5179", Providers: []string{"Kakao"}})
+		assert.ErrorContains(t, err, "only supported by vWorld")
+	})
+}
+
+func TestClient_GeocodeWithOptions_Timeout(t *testing.T) {
+	providers := []provider.GeocodingProvider{&blockingProvider{}}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+
+	start := time.Now()
+	_, err := client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{
+		Timeout: 50 * time.Millisecond,
+	})
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+// blockingProvider 컨텍스트가 취소될 때까지 응답하지 않는 Provider
+type blockingProvider struct{}
+
+func (blockingProvider) Name() string                         { return "Blocking" }
+func (blockingProvider) IsAvailable(ctx context.Context) bool { return true }
+func (blockingProvider) Disable(reason string)                {}
+func (blockingProvider) Enable()                              {}
+func (blockingProvider) IsDisabled() bool                     { return false }
+func (blockingProvider) GetDisableReason() string             { return "" }
+func (blockingProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	<-ctx.Done()
+	return nil, provider.NewClassifiedError(provider.ErrorTypeTimeout, "request cancelled", ctx.Err())
+}
+
+func TestToAddressDetail_ParcelNumbers(t *testing.T) {
+	detail := toAddressDetail(&model.AddressDetail{
+		ParcelAddress: "강원특별자치도 평창군 대관령면 횡계리 산 1-5",
+		IsMountain:    true,
+		MainNo:        "1",
+		SubNo:         "5",
+	})
+
+	require.NotNil(t, detail)
+	assert.True(t, detail.IsMountain)
+	assert.Equal(t, "1", detail.MainNo)
+	assert.Equal(t, "5", detail.SubNo)
+}
+
+func TestClient_ReverseGeocodeBatch_TooMany(t *testing.T) {
+	client, err := New(Config{KakaoAPIKey: "test-key", ConcurrentLimit: 10})
+	require.NoError(t, err)
+
+	_, err = client.ReverseGeocodeBatch(context.Background(), make([]Coordinate, 101))
+
+	assert.EqualError(t, err, "too many coordinates: maximum 100, got 101")
+}
+
+func TestClient_ReverseGeocode_NoReverseProvider(t *testing.T) {
+	providers := []provider.GeocodingProvider{&countingProvider{name: "vWorld"}}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+
+	_, err := client.ReverseGeocode(context.Background(), 37.566535, 126.977969)
+
+	assert.ErrorIs(t, err, ErrNoProvidersAvailable)
+}
+
+// addressBookProvider 주소별로 미리 정한 결과를 반환하는 Provider
+type addressBookProvider struct {
+	results map[string]model.ProviderResult
+}
+
+func (p *addressBookProvider) Name() string                         { return "Kakao" }
+func (p *addressBookProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *addressBookProvider) Disable(reason string)                {}
+func (p *addressBookProvider) Enable()                              {}
+func (p *addressBookProvider) IsDisabled() bool                     { return false }
+func (p *addressBookProvider) GetDisableReason() string             { return "" }
+func (p *addressBookProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	result, ok := p.results[address]
+	if !ok {
+		return &model.ProviderResult{Success: false}, nil
+	}
+	return &result, nil
+}
+
+func TestClient_CanonicalKey(t *testing.T) {
+	cityHall := model.AddressDetail{
+		RoadAddress:   "서울특별시 중구 세종대로 110",
+		ParcelAddress: "서울특별시 중구 태평로1가 31",
+		LegalDongCode: "1114010300",
+		MainNo:        "31",
+	}
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		// 도로명과 지번 표기는 출입구/필지 중심 차이로 좌표가 조금 다를 수 있음
+		"서울특별시 중구 세종대로 110": {Success: true, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}, AddressDetail: cityHall},
+		"서울특별시 중구 태평로1가 31": {Success: true, Coordinate: model.Coordinate{Latitude: 37.566295, Longitude: 126.977945}, AddressDetail: cityHall},
+		"강원특별자치도 평창군 대관령면 횡계리 산 1-5": {
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 37.677453, Longitude: 128.716794},
+			AddressDetail: model.AddressDetail{LegalDongCode: "5176037021", IsMountain: true, MainNo: "1", SubNo: "5"},
+		},
+		"부산광역시 해운대구 해운대해변로 264": {Success: true, Coordinate: model.Coordinate{Latitude: 35.158698, Longitude: 129.160384}},
+	}}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+	ctx := context.Background()
+
+	roadKey, err := client.CanonicalKey(ctx, "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+	parcelKey, err := client.CanonicalKey(ctx, "서울특별시 중구 태평로1가 31")
+	require.NoError(t, err)
+	spacedKey, err := client.CanonicalKey(ctx, "  서울특별시   중구 세종대로  110 ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "pnu:1114010300100310000", roadKey)
+	assert.Equal(t, roadKey, parcelKey)
+	assert.Equal(t, roadKey, spacedKey)
+
+	mountainKey, err := client.CanonicalKey(ctx, "강원특별자치도 평창군 대관령면 횡계리 산 1-5")
+	require.NoError(t, err)
+	assert.Equal(t, "pnu:5176037021200010005", mountainKey)
+
+	// 행정구역 코드가 없으면 Geohash로 대체
+	geoKey, err := client.CanonicalKey(ctx, "부산광역시 해운대구 해운대해변로 264")
+	require.NoError(t, err)
+	assert.Regexp(t, `^geo:[0-9b-hjkmnp-z]{8}$`, geoKey)
+
+	_, err = client.CanonicalKey(ctx, "제주특별자치도 없는로 999")
+	assert.Error(t, err)
+
+	t.Run("low confidence match", func(t *testing.T) {
+		low := &addressBookProvider{results: map[string]model.ProviderResult{
+			"서울특별시 중구 세종대로 110": {Success: true, Confidence: 0.2, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}, AddressDetail: cityHall},
+		}}
+		providers := []provider.GeocodingProvider{low}
+		client := &Client{
+			service:   service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{MinConfidence: 0.5}),
+			providers: providers,
+		}
+
+		_, err := client.CanonicalKey(ctx, "서울특별시 중구 세종대로 110")
+
+		assert.ErrorIs(t, err, ErrImpreciseMatch)
+	})
+
+	t.Run("result hook veto", func(t *testing.T) {
+		vetoed := errors.New("vetoed")
+		client := &Client{
+			service:   client.service,
+			providers: client.providers,
+			config:    Config{ResultHook: func(ctx context.Context, result *Result) error { return vetoed }},
+		}
+
+		_, err := client.CanonicalKey(ctx, "서울특별시 중구 세종대로 110")
+
+		assert.ErrorIs(t, err, vetoed)
+	})
+}
+
+func TestParcelNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		detail AddressDetail
+		want   string
+		wantOK bool
+	}{
+		{"regular parcel", AddressDetail{LegalDongCode: "1114010300", MainNo: "31"}, "1114010300100310000", true},
+		{"with sub number", AddressDetail{LegalDongCode: "1168010100", MainNo: "737", SubNo: "12"}, "1168010100107370012", true},
+		{"mountain parcel", AddressDetail{LegalDongCode: "5176037021", IsMountain: true, MainNo: "1", SubNo: "5"}, "5176037021200010005", true},
+		{"truncated code", AddressDetail{LegalDongCode: "11140103", MainNo: "31"}, "", false},
+		{"missing main number", AddressDetail{LegalDongCode: "1114010300"}, "", false},
+		{"non-numeric number", AddressDetail{LegalDongCode: "1114010300", MainNo: "31가"}, "", false},
+		{"non-numeric code", AddressDetail{LegalDongCode: "11140103AB", MainNo: "31"}, "", false},
+		{"main number too long", AddressDetail{LegalDongCode: "1114010300", MainNo: "12345"}, "", false},
+		{"negative sub number", AddressDetail{LegalDongCode: "1114010300", MainNo: "31", SubNo: "-1"}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parcelNumber(&tt.detail)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_ConvertAddress(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 태평로1가 31": {
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969},
+			AddressDetail: model.AddressDetail{
+				RoadAddress:   "서울특별시 중구 세종대로 110",
+				ParcelAddress: "서울특별시 중구 태평로1가 31",
+			},
+		},
+		"강원특별자치도 평창군 대관령면 횡계리 산 1-5": {
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 37.677453, Longitude: 128.716794},
+			AddressDetail: model.AddressDetail{ParcelAddress: "강원특별자치도 평창군 대관령면 횡계리 산 1-5"},
+		},
+	}}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+	ctx := context.Background()
+
+	t.Run("parcel to road", func(t *testing.T) {
+		road, err := client.ConvertAddress(ctx, "서울특별시 중구 태평로1가 31", AddressTypeRoad)
+
+		require.NoError(t, err)
+		assert.Equal(t, "서울특별시 중구 세종대로 110", road)
+	})
+
+	t.Run("road form unavailable", func(t *testing.T) {
+		_, err := client.ConvertAddress(ctx, "강원특별자치도 평창군 대관령면 횡계리 산 1-5", AddressTypeRoad)
+
+		assert.ErrorIs(t, err, ErrAddressFormUnavailable)
+	})
+
+	t.Run("lowercase target type", func(t *testing.T) {
+		road, err := client.ConvertAddress(ctx, "서울특별시 중구 태평로1가 31", AddressType(" road "))
+
+		require.NoError(t, err)
+		assert.Equal(t, "서울특별시 중구 세종대로 110", road)
+	})
+
+	t.Run("invalid target type", func(t *testing.T) {
+		for _, to := range []AddressType{"ZIP", ""} {
+			_, err := client.ConvertAddress(ctx, "서울특별시 중구 태평로1가 31", to)
+
+			assert.ErrorContains(t, err, "invalid address type", "%q", to)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := client.ConvertAddress(ctx, "제주특별자치도 없는로 999", AddressTypeRoad)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_GeocodeFull(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 세종대로 110": {
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969},
+			AddressDetail: model.AddressDetail{
+				RoadAddress:    "서울특별시 중구 세종대로 110",
+				ParcelAddress:  "서울특별시 중구 태평로1가 31",
+				EnglishAddress: "110 Sejong-daero, Jung-gu, Seoul",
+				Zipcode:        "04524",
+				BuildingName:   "서울특별시청",
+			},
+		},
+		"강원특별자치도 평창군 대관령면 횡계리 산 1-5": {
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 37.677453, Longitude: 128.716794},
+			AddressDetail: model.AddressDetail{ParcelAddress: "강원특별자치도 평창군 대관령면 횡계리 산 1-5"},
+		},
+	}}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+	ctx := context.Background()
+
+	t.Run("all forms", func(t *testing.T) {
+		full, err := client.GeocodeFull(ctx, "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, &FullResult{
+			Coordinate:     Coordinate{Latitude: 37.566535, Longitude: 126.977969},
+			Provider:       p.Name(),
+			MatchLevel:     MatchLevelRooftop,
+			RoadAddress:    "서울특별시 중구 세종대로 110",
+			ParcelAddress:  "서울특별시 중구 태평로1가 31",
+			EnglishAddress: "110 Sejong-daero, Jung-gu, Seoul",
+			Zipcode:        "04524",
+		}, full)
+	})
+
+	t.Run("missing forms left empty", func(t *testing.T) {
+		full, err := client.GeocodeFull(ctx, "강원특별자치도 평창군 대관령면 횡계리 산 1-5")
+
+		require.NoError(t, err)
+		assert.Equal(t, "강원특별자치도 평창군 대관령면 횡계리 산 1-5", full.ParcelAddress)
+		assert.Empty(t, full.RoadAddress)
+		assert.Empty(t, full.EnglishAddress)
+		assert.Empty(t, full.Zipcode)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := client.GeocodeFull(ctx, "제주특별자치도 없는로 999")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("no fallback coordinate", func(t *testing.T) {
+		client := &Client{
+			service:   service.NewGeocodingService(providers, zap.NewNop()),
+			providers: providers,
+			config:    Config{FallbackCoordinate: &Coordinate{Latitude: 37.5, Longitude: 127.0}},
+		}
+
+		full, err := client.GeocodeFull(ctx, "제주특별자치도 없는로 999")
+
+		assert.ErrorContains(t, err, "geocoding failed")
+		assert.Nil(t, full)
+	})
+}
+
+func TestClient_GeocodeBatchWithOptions_Bounds(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 세종대로 110":     {Success: true, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}},
+		"서울특별시 강남구 테헤란로 152":    {Success: true, Coordinate: model.Coordinate{Latitude: 37.500622, Longitude: 127.036456}},
+		"부산광역시 해운대구 해운대해변로 264": {Success: true, Coordinate: model.Coordinate{Latitude: 35.158698, Longitude: 129.160384}},
+	}}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+	seoul := &Bounds{MinLatitude: 37.413294, MaxLatitude: 37.715133, MinLongitude: 126.734086, MaxLongitude: 127.269311}
+
+	t.Run("drops results outside bounds", func(t *testing.T) {
+		items, err := client.GeocodeBatchWithOptions(context.Background(), []string{
+			"서울특별시 중구 세종대로 110",
+			"부산광역시 해운대구 해운대해변로 264",
+			"서울특별시 강남구 테헤란로 152",
+			"제주특별자치도 없는로 999",
+		}, BatchOptions{Bounds: seoul})
+
+		require.NoError(t, err)
+		require.Len(t, items, 4)
+
+		require.NoError(t, items[0].Err)
+		assert.InDelta(t, 37.566535, items[0].Result.Latitude, 1e-6)
+
+		assert.Nil(t, items[1].Result)
+		assert.ErrorIs(t, items[1].Err, ErrOutsideBounds)
+
+		require.NoError(t, items[2].Err)
+		assert.NotNil(t, items[2].Result)
+
+		assert.Nil(t, items[3].Result)
+		assert.Error(t, items[3].Err)
+		assert.NotErrorIs(t, items[3].Err, ErrOutsideBounds)
+	})
+
+	t.Run("no bounds keeps every result", func(t *testing.T) {
+		items, err := client.GeocodeBatchWithOptions(context.Background(), []string{
+			"부산광역시 해운대구 해운대해변로 264",
+		}, BatchOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.NoError(t, items[0].Err)
+	})
+
+	t.Run("invalid bounds", func(t *testing.T) {
+		_, err := client.GeocodeBatchWithOptions(context.Background(), []string{"서울특별시 중구 세종대로 110"}, BatchOptions{
+			Bounds: &Bounds{MinLatitude: 38, MaxLatitude: 37, MinLongitude: 126, MaxLongitude: 127},
+		})
+
+		assert.ErrorContains(t, err, "bounds minimum must be less than maximum")
+	})
+}
+
+// statsProvider 호출 통계를 기록하는 Provider ("없음"으로 끝나는 주소는 실패)
+type statsProvider struct {
+	name  string
+	stats provider.Stats
+}
+
+func (p *statsProvider) Name() string                         { return p.name }
+func (p *statsProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *statsProvider) Disable(reason string)                {}
+func (p *statsProvider) Enable()                              {}
+func (p *statsProvider) IsDisabled() bool                     { return false }
+func (p *statsProvider) GetDisableReason() string             { return "" }
+func (p *statsProvider) Stats() provider.StatsSnapshot        { return p.stats.Snapshot() }
+func (p *statsProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	start := time.Now()
+	success := !strings.HasSuffix(address, "없음")
+	defer func() { p.stats.Record(success, time.Since(start)) }()
+	if !success {
+		return &model.ProviderResult{Success: false, Error: provider.ErrAddressNotFound}, nil
+	}
+	return &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+	}, nil
+}
+
+func TestClient_Stats(t *testing.T) {
+	// 같은 이름의 Provider(vWorld 다중 키)는 합산
+	first := &statsProvider{name: "vWorld"}
+	second := &statsProvider{name: "vWorld"}
+	kakao := &statsProvider{name: "Kakao"}
+	providers := []provider.GeocodingProvider{first, second, kakao, foreignProvider{}}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+
+	const workers, perWorker = 10, 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				address := fmt.Sprintf("서울특별시 중구 세종대로 %d", i*perWorker+j)
+				if j%2 == 1 {
+					address += " 없음"
+				}
+				client.Geocode(context.Background(), address)
+				client.Stats()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	stats := client.Stats()
+	require.Len(t, stats, 2)
+	assert.NotContains(t, stats, "Foreign")
+
+	var calls, successes, failures int64
+	for _, s := range stats {
+		assert.Equal(t, s.Calls, s.Successes+s.Failures)
+		calls += s.Calls
+		successes += s.Successes
+		failures += s.Failures
+	}
+	// 성공 건은 첫 Provider에서 끝나고, 실패 건은 통계 없는 마지막 Provider까지 폴백
+	assert.Equal(t, int64(workers*perWorker/2), successes)
+	assert.Equal(t, int64(workers*perWorker/2*3), failures)
+	assert.Equal(t, successes+failures, calls)
+	assert.Equal(t, int64(workers*perWorker*3/2), stats["vWorld"].Calls)
+	assert.Equal(t, int64(workers*perWorker/2), stats["Kakao"].Calls)
+}
+
+func TestClient_ValidateBatch(t *testing.T) {
+	p := &countingProvider{name: "vWorld"}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingService(providers, zap.NewNop()),
+		providers: providers,
+	}
+
+	validations := client.ValidateBatch(context.Background(), []string{
+		"  서울특별시  중구　세종대로 110 ",
+		"서울특별시 강남구 역삼동 737 06236",
+		"123 Main Street",
+		"   ",
+		"",
+	})
+
+	require.Len(t, validations, 5)
+
+	assert.Equal(t, AddressValidation{
+		Address:     "  서울특별시  중구　세종대로 110 ",
+		Normalized:  "서울특별시 중구 세종대로 110",
+		Valid:       true,
+		AddressType: AddressTypeRoad,
+	}, validations[0])
+
+	assert.True(t, validations[1].Valid)
+	assert.Equal(t, AddressTypeParcel, validations[1].AddressType)
+	assert.Equal(t, "06236", validations[1].Zipcode)
+
+	assert.False(t, validations[2].Valid, "no Hangul")
+	assert.Equal(t, "123 Main Street", validations[2].Normalized)
+
+	for _, v := range validations[3:] {
+		assert.False(t, v.Valid)
+		assert.Empty(t, v.Normalized)
+		assert.Empty(t, v.AddressType)
+	}
+
+	assert.Zero(t, p.calls, "validation must not call providers")
+	assert.Empty(t, client.ValidateBatch(context.Background(), nil))
+}
+
+func TestClient_Geocode_InvalidAddress(t *testing.T) {
+	p := &countingProvider{name: "vWorld"}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service: service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{
+			MaxAddressLength: 20,
+		}),
+		providers: providers,
+	}
+
+	for _, address := range []string{
+		"서울특별시 중구 세종대로 110 서울특별시청 본관", // 최대 길이 초과
+		"서울특별시 중구\x1b세종대로 110",
+		"서울특별시 " + strings.Repeat("ㅋ", 11),
+	} {
+		_, err := client.Geocode(context.Background(), address)
+		assert.ErrorIs(t, err, ErrInvalidAddress, address)
+	}
+
+	batch, err := client.GeocodeBatchWithOptions(context.Background(), []string{
+		"서울특별시 중구 세종대로 110",
+		strings.Repeat("서울특별시 ", 100),
+	}, BatchOptions{})
+	require.NoError(t, err)
+	assert.NoError(t, batch[0].Err)
+	assert.ErrorIs(t, batch[1].Err, ErrInvalidAddress)
+
+	validations := client.ValidateBatch(context.Background(), []string{strings.Repeat("서울특별시 ", 100)})
+	assert.False(t, validations[0].Valid)
+	assert.Equal(t, 1, p.calls, "only the valid batch address reaches the provider")
+}
+
+func TestNew_WithBaseURL(t *testing.T) {
+	t.Run("vWorld requests go to the overridden URL", func(t *testing.T) {
+		server := createMockVWorldServer(true)
+		defer server.Close()
+
+		cfg := DefaultConfig()
+		cfg.VWorldAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("vworld", server.URL))
+		require.NoError(t, err)
+
+		result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, "vWorld", result.Provider)
+		assert.InDelta(t, 37.5665, result.Latitude, 1e-6)
+	})
+
+	t.Run("Kakao keeps the path prefix", func(t *testing.T) {
+		var paths []string
+		var mu sync.Mutex
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			paths = append(paths, r.URL.Path)
+			mu.Unlock()
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD","x":"126.977969","y":"37.566535"}]}`))
+		}))
+		defer server.Close()
+
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("Kakao", server.URL+"/proxy/kakao/"))
+		require.NoError(t, err)
+
+		_, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/proxy/kakao/v2/local/search/address.json"}, paths)
+	})
+
+	t.Run("config field", func(t *testing.T) {
+		server := createMockVWorldServer(true)
+		defer server.Close()
+
+		cfg := DefaultConfig()
+		cfg.VWorldAPIKey = "test-key"
+		cfg.VWorldBaseURL = server.URL
+		client, err := New(cfg)
+		require.NoError(t, err)
+
+		result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.InDelta(t, 126.978, result.Longitude, 1e-6)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.VWorldAPIKey = "test-key"
+
+		_, err := New(cfg, WithBaseURL("Naver", "http://localhost"))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown provider")
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.VWorldAPIKey = "test-key"
+
+		_, err := New(cfg, WithBaseURL("vWorld", "localhost:8080"))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "vWorldBaseURL")
+	})
+}
+
+func TestClient_Geocode_KakaoAnalyzeType(t *testing.T) {
+	var mu sync.Mutex
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		analyzeType := r.URL.Query().Get("analyze_type")
+		mu.Lock()
+		requested = append(requested, analyzeType)
+		mu.Unlock()
+		if analyzeType == "exact" {
+			w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+			return
+		}
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.KakaoBaseURL = server.URL
+	cfg.KakaoAnalyzeType = KakaoAnalyzeExact
+	client, err := New(cfg)
+	require.NoError(t, err)
+
+	// 설정 기본값 exact: 결과 없음
+	_, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	assert.Error(t, err)
+
+	// 호출별 similar
+	result, err := client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{
+		KakaoAnalyzeType: KakaoAnalyzeSimilar,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Equal(t, []string{"exact", "similar"}, requested)
+
+	_, err = client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{
+		KakaoAnalyzeType: "fuzzy",
+	})
+	assert.Error(t, err)
+
+	t.Run("exact fallback", func(t *testing.T) {
+		requested = nil
+		cfg.KakaoExactFallback = true
+		client, err := New(cfg)
+		require.NoError(t, err)
+
+		_, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"exact", "similar"}, requested)
+	})
+}
+
+func TestClient_Geocode_Bias(t *testing.T) {
+	var mu sync.Mutex
+	var keyword url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/keyword.json") {
+			w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+			return
+		}
+		mu.Lock()
+		keyword = r.URL.Query()
+		mu.Unlock()
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"place_name":"시청역","x":"129.059175","y":"35.179554"}]}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.KakaoBaseURL = server.URL
+	cfg.KakaoKeywordFallback = true
+	cfg.CacheTTL = time.Minute
+	client, err := New(cfg)
+	require.NoError(t, err)
+
+	busan := &Coordinate{Latitude: 35.1796, Longitude: 129.0756}
+	result, err := client.GeocodeWithOptions(context.Background(), "시청역", GeocodeOptions{Bias: busan, BiasRadius: 5000})
+	require.NoError(t, err)
+	assert.InDelta(t, 35.179554, result.Latitude, 1e-6)
+	assert.Equal(t, "129.0756", keyword.Get("x"))
+	assert.Equal(t, "35.1796", keyword.Get("y"))
+	assert.Equal(t, "5000", keyword.Get("radius"))
+
+	// 힌트가 다르면 캐시를 공유하지 않음
+	keyword = nil
+	_, err = client.GeocodeWithOptions(context.Background(), "시청역", GeocodeOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, keyword, "힌트 없는 호출은 힌트 있는 호출의 캐시를 쓰지 않음")
+	assert.Empty(t, keyword.Get("x"))
+
+	_, err = client.GeocodeWithOptions(context.Background(), "시청역", GeocodeOptions{Bias: busan, BiasRadius: 20001})
+	assert.Error(t, err)
+	_, err = client.GeocodeWithOptions(context.Background(), "시청역", GeocodeOptions{Bias: &Coordinate{Latitude: 120, Longitude: 129}})
+	assert.Error(t, err)
+}
+
+func TestClient_Geocode_IncludeRaw(t *testing.T) {
+	const body = `{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535","echo":"test-key"}]}`
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.KakaoBaseURL = server.URL
+	cfg.CacheTTL = time.Minute
+	client, err := New(cfg)
+	require.NoError(t, err)
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+	assert.Nil(t, result.Raw, "기본값은 원본 응답 없음")
+	encoded, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), `"raw"`)
+
+	// 캐시된 주소도 Provider를 다시 호출해 원본 응답을 담음
+	result, err = client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{IncludeRaw: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+	assert.JSONEq(t, strings.ReplaceAll(body, "test-key", "REDACTED"), string(result.Raw))
+
+	// 원본 응답은 캐시에 남지 않음
+	result, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Nil(t, result.Raw)
+}
+
+func TestClient_GeocodeZipcode(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"meta":{"total_count":2},"documents":[
+			{"address_name":"서울 중구 세종대로 110","x":"126.978","y":"37.566","road_address":{"address_name":"서울 중구 세종대로 110","region_1depth_name":"서울","region_2depth_name":"중구","zone_no":"04524"}},
+			{"address_name":"서울 중구 세종대로 124","x":"126.980","y":"37.568","road_address":{"address_name":"서울 중구 세종대로 124","region_1depth_name":"서울","region_2depth_name":"중구","zone_no":"04524"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.KakaoBaseURL = server.URL
+	client, err := New(cfg)
+	require.NoError(t, err)
+
+	result, err := client.GeocodeZipcode(context.Background(), "04524")
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Equal(t, MatchLevelRegion, result.MatchLevel)
+	assert.InDelta(t, 37.567, result.Latitude, 1e-6)
+	assert.InDelta(t, 126.979, result.Longitude, 1e-6)
+	require.NotNil(t, result.AddressDetail)
+	assert.Equal(t, "04524", result.AddressDetail.Zipcode)
+
+	for _, zipcode := range []string{"", "4524", "045244", "00524", "서울 04524", "04-524"} {
+		_, err := client.GeocodeZipcode(context.Background(), zipcode)
+		assert.ErrorIs(t, err, ErrInvalidZipcode, zipcode)
+	}
+	assert.Equal(t, int32(1), requests.Load(), "잘못된 우편번호는 Provider를 호출하지 않음")
+}
+
+func TestClient_Geocode_NominatimFallback(t *testing.T) {
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer kakao.Close()
+
+	var userAgent string
+	nominatim := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`[{"place_id":1,"lat":"37.5662952","lon":"126.9779451","name":"서울특별시청","address":{"house_number":"110","road":"세종대로","borough":"중구","city":"서울특별시","postcode":"04524","country_code":"kr"}}]`))
+	}))
+	defer nominatim.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.NominatimUserAgent = "k-geocode-test/1.0"
+	client, err := New(cfg, WithBaseURL("Kakao", kakao.URL), WithBaseURL("nominatim", nominatim.URL))
+	require.NoError(t, err)
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Nominatim", result.Provider)
+	assert.Equal(t, "k-geocode-test/1.0", userAgent)
+	assert.InDelta(t, 37.5662952, result.Latitude, 1e-6)
+}
+
+func TestClient_Geocode_StopOnNotFound(t *testing.T) {
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+	}))
+	defer kakao.Close()
+
+	nominatimCalls := 0
+	nominatim := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nominatimCalls++
+		w.Write([]byte(`[{"place_id":1,"lat":"37.5662952","lon":"126.9779451","address":{"house_number":"110","road":"세종대로","city":"서울특별시","country_code":"kr"}}]`))
+	}))
+	defer nominatim.Close()
+
+	for _, stop := range []bool{false, true} {
+		t.Run(fmt.Sprintf("stop=%v", stop), func(t *testing.T) {
+			nominatimCalls = 0
+			cfg := DefaultConfig()
+			cfg.KakaoAPIKey = "test-key"
+			cfg.NominatimUserAgent = "k-geocode-test/1.0"
+			cfg.StopOnNotFound = stop
+			client, err := New(cfg, WithBaseURL("Kakao", kakao.URL), WithBaseURL("nominatim", nominatim.URL))
+			require.NoError(t, err)
+
+			result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+			if !stop {
+				require.NoError(t, err)
+				assert.Equal(t, "Nominatim", result.Provider)
+				assert.Equal(t, 1, nominatimCalls)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, 0, nominatimCalls)
+		})
+	}
+}
+
+func TestClient_Validate(t *testing.T) {
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer vworld.Close()
+
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "typo-key"
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+
+	err = client.Validate(context.Background())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	assert.NotErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Contains(t, err.Error(), "vWorld")
+	assert.NotContains(t, err.Error(), "Kakao")
+
+	// 키가 거부된 vWorld는 비활성화되고 Kakao로 계속 동작
+	assert.True(t, client.IsAvailable(context.Background()))
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	require.Len(t, result.Attempts, 2)
+	assert.Equal(t, "provider not available", result.Attempts[0].Error)
+}
+
+func TestClient_GeocodeWithOptions_BothCoordinates(t *testing.T) {
+	points := map[string]string{
+		"ROAD":   `{"x": "126.977969", "y": "37.566535"}`,
+		"PARCEL": `{"x": "126.977890", "y": "37.566480"}`,
+	}
+	var mu sync.Mutex
+	queries := map[string]string{}
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addrType := r.URL.Query().Get("type")
+		address := r.URL.Query().Get("address")
+		mu.Lock()
+		queries[addrType] = address
+		mu.Unlock()
+		point, ok := points[addrType]
+		if !ok {
+			w.Write([]byte(`{"response": {"status": "NOT_FOUND"}}`))
+			return
+		}
+		refined := ""
+		if addrType == "ROAD" {
+			refined = `"refined": {"text": "서울특별시 중구 태평로1가 31"}, `
+		}
+		w.Write([]byte(`{"response": {"status": "OK", "input": {"type": "` + addrType + `", "address": "` + address + `"}, ` + refined + `"result": {"crs": "EPSG:4326", "point": ` + point + `}}}`))
+	}))
+	defer vworld.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+	require.NoError(t, err)
+
+	result, err := client.GeocodeWithOptions(context.Background(), "서울특별시 중구 세종대로 110", GeocodeOptions{BothCoordinates: true})
+
+	require.NoError(t, err)
+	// 지번 조회는 입력 주소가 아니라 도로명 결과의 지번 주소로 한다
+	assert.Equal(t, map[string]string{"ROAD": "서울특별시 중구 세종대로 110", "PARCEL": "서울특별시 중구 태평로1가 31"}, queries)
+	require.NotNil(t, result.RoadCoordinate)
+	require.NotNil(t, result.ParcelCoordinate)
+	assert.InDelta(t, 37.566535, result.RoadCoordinate.Latitude, 1e-6)
+	assert.InDelta(t, 126.977969, result.RoadCoordinate.Longitude, 1e-6)
+	assert.InDelta(t, 37.566480, result.ParcelCoordinate.Latitude, 1e-6)
+	assert.InDelta(t, 126.977890, result.ParcelCoordinate.Longitude, 1e-6)
+	assert.InDelta(t, 37.566535, result.Latitude, 1e-6, "primary coordinate is the road match")
+	assert.Len(t, result.Attempts, 2)
+
+	// 지번으로만 찾은 경우 도로명 좌표는 비어 있고 대표 좌표는 지번 결과
+	delete(points, "ROAD")
+	result, err = client.GeocodeWithOptions(context.Background(), "서울특별시 중구 태평로1가 31", GeocodeOptions{BothCoordinates: true})
+
+	require.NoError(t, err)
+	assert.Nil(t, result.RoadCoordinate)
+	require.NotNil(t, result.ParcelCoordinate)
+	assert.InDelta(t, 37.566480, result.Latitude, 1e-6)
+}
+
+func TestClient_Geocode_VWorldPartialMatchFallsBack(t *testing.T) {
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "", "y": ""}}}}`))
+	}))
+	defer vworld.Close()
+
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	require.Len(t, result.Attempts, 2)
+	assert.Equal(t, "vWorld", result.Attempts[0].Provider)
+	assert.Contains(t, result.Attempts[0].Error, "partial match")
+}
+
+func TestClient_Geocode_VWorldHTMLResponseFallsBack(t *testing.T) {
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body><h1>503 Service Temporarily Unavailable</h1></body></html>"))
+	}))
+	defer vworld.Close()
+
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	require.Len(t, result.Attempts, 2)
+	assert.Equal(t, "vWorld", result.Attempts[0].Provider)
+	assert.Contains(t, result.Attempts[0].Error, `Content-Type "text/html"`)
+}
+
+// numberedProvider 주소 끝의 번호를 경도로 돌려주고 최대 동시 호출 수를 기록하는 Provider
+type numberedProvider struct {
+	mu        sync.Mutex
+	inFlight  int
+	maxFlight int
+}
+
+func (p *numberedProvider) Name() string                         { return "vWorld" }
+func (p *numberedProvider) IsAvailable(ctx context.Context) bool { return true }
+func (p *numberedProvider) Disable(reason string)                {}
+func (p *numberedProvider) Enable()                              {}
+func (p *numberedProvider) IsDisabled() bool                     { return false }
+func (p *numberedProvider) GetDisableReason() string             { return "" }
+func (p *numberedProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.mu.Lock()
+	p.inFlight++
+	p.maxFlight = max(p.maxFlight, p.inFlight)
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.inFlight--
+		p.mu.Unlock()
+	}()
+	time.Sleep(time.Millisecond)
+
+	fields := strings.Fields(address)
+	n, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil || n%50 == 7 {
+		return &model.ProviderResult{Success: false}, nil
+	}
+	return &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5, Longitude: 126 + float64(n)/1000},
+	}, nil
+}
+
+func TestClient_GeocodeLarge(t *testing.T) {
+	p := &numberedProvider{}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{ConcurrentLimit: 4}),
+		providers: providers,
+	}
+
+	addresses := make([]string, 250)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("서울특별시 중구 세종대로 %d", i)
+	}
+
+	results, err := client.GeocodeLarge(context.Background(), addresses)
+
+	require.NoError(t, err)
+	require.Len(t, results, 250)
+	for i, result := range results {
+		if i%50 == 7 {
+			assert.Nil(t, result, "address %d should fail", i)
+			continue
+		}
+		require.NotNil(t, result, "address %d", i)
+		assert.InDelta(t, 126+float64(i)/1000, result.Longitude, 1e-6, "address %d out of order", i)
+	}
+	assert.LessOrEqual(t, p.maxFlight, 4)
+}
+
+func TestClient_GeocodeLarge_FatalError(t *testing.T) {
+	client := &Client{service: service.NewGeocodingService([]provider.GeocodingProvider{&foreignProvider{}}, zap.NewNop())}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GeocodeLarge(ctx, make([]string, 150))
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestClient_Geocode_RegionFallback(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 세종대로 110": {Success: true, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}},
+		"서울특별시 중구":          {Success: true, Coordinate: model.Coordinate{Latitude: 37.563843, Longitude: 126.997602}},
+	}}
+	providers := []provider.GeocodingProvider{p}
+	client := &Client{
+		service:   service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{RegionFallback: true}),
+		providers: providers,
+	}
+
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+	assert.Equal(t, MatchLevelRooftop, result.MatchLevel)
+
+	result, err = client.Geocode(context.Background(), "서울특별시 중구 을지로 99999")
+	require.NoError(t, err)
+	assert.Equal(t, MatchLevelRegion, result.MatchLevel)
+	assert.InDelta(t, 37.563843, result.Latitude, 1e-6)
+}
+
+func TestClient_Geocode_FallbackCoordinate(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 세종대로 110": {Success: true, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}},
+	}}
+	newClient := func(cfg Config) *Client {
+		providers := []provider.GeocodingProvider{p}
+		return &Client{
+			service:   service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{}),
+			providers: providers,
+			config:    cfg,
+		}
+	}
+	seoul := &Coordinate{Latitude: 37.5, Longitude: 127.0}
+
+	t.Run("not used when a provider matches", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul, FallbackToRegionCenter: true})
+
+		result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.False(t, result.IsFallback)
+		assert.InDelta(t, 37.566535, result.Latitude, 1e-6)
+	})
+
+	t.Run("fixed coordinate on total failure", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul})
+
+		result, err := client.Geocode(context.Background(), "부산광역시 해운대구 없는로 1")
+
+		require.NoError(t, err)
+		assert.True(t, result.IsFallback)
+		assert.Equal(t, 37.5, result.Latitude)
+		assert.Equal(t, 127.0, result.Longitude)
+		assert.Empty(t, result.Provider)
+		assert.Empty(t, result.MatchLevel)
+	})
+
+	t.Run("region center on total failure", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul, FallbackToRegionCenter: true})
+
+		result, err := client.Geocode(context.Background(), "부산 해운대구 없는로 1")
+		require.NoError(t, err)
+		assert.True(t, result.IsFallback)
+		assert.Equal(t, MatchLevelRegion, result.MatchLevel)
+		assert.InDelta(t, 35.1798, result.Latitude, 0.01)
+		assert.InDelta(t, 129.0750, result.Longitude, 0.01)
+
+		// 시·도를 알 수 없으면 고정 좌표
+		result, err = client.Geocode(context.Background(), "해운대구 없는로 1")
+		require.NoError(t, err)
+		assert.True(t, result.IsFallback)
+		assert.Equal(t, 37.5, result.Latitude)
+	})
+
+	t.Run("not used for invalid addresses", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul})
+
+		_, err := client.Geocode(context.Background(), "1")
+
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+
+	t.Run("error without fallback", func(t *testing.T) {
+		client := newClient(Config{FallbackToRegionCenter: true})
+
+		_, err := client.Geocode(context.Background(), "해운대구 없는로 1")
+
+		assert.ErrorContains(t, err, "geocoding failed")
+	})
+
+	t.Run("not used when the context is cancelled", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, err := client.Geocode(ctx, "부산광역시 해운대구 없는로 1")
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, result)
+	})
+
+	t.Run("not used by derived lookups", func(t *testing.T) {
+		client := newClient(Config{FallbackCoordinate: seoul})
+
+		_, err := client.ConvertAddress(context.Background(), "부산광역시 해운대구 없는로 1", AddressTypeRoad)
+
+		assert.ErrorContains(t, err, "geocoding failed")
+		assert.NotErrorIs(t, err, ErrAddressFormUnavailable)
+	})
+
+	t.Run("passed to the result hook", func(t *testing.T) {
+		var hooked *Result
+		client := newClient(Config{FallbackCoordinate: seoul, ResultHook: func(ctx context.Context, r *Result) error {
+			hooked = r
+			return nil
+		}})
+
+		result, err := client.Geocode(context.Background(), "부산광역시 해운대구 없는로 1")
+
+		require.NoError(t, err)
+		assert.Same(t, result, hooked)
+		assert.True(t, hooked.IsFallback)
+	})
+}
+
+func TestClient_Geocode_Preprocessors(t *testing.T) {
+	p := &addressBookProvider{results: map[string]model.ProviderResult{
+		"서울특별시 중구 세종대로 110": {Success: true, Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}},
+	}}
+	newClient := func(preprocessors ...AddressPreprocessor) *Client {
+		providers := []provider.GeocodingProvider{p}
+		return &Client{
+			service:   service.NewGeocodingServiceWithOptions(providers, zap.NewNop(), service.Options{Preprocessors: toPreprocessors(preprocessors)}),
+			providers: providers,
+		}
+	}
+	const raw = "서울시 중구 세종대로 110 (태평로1가)"
+
+	t.Run("without preprocessors", func(t *testing.T) {
+		_, err := newClient().Geocode(context.Background(), raw)
+		assert.Error(t, err)
+	})
+
+	t.Run("built-ins chained", func(t *testing.T) {
+		client := newClient(StripParentheses, ExpandSidoAbbreviations)
+
+		result, err := client.Geocode(context.Background(), raw)
+		require.NoError(t, err)
+		assert.InDelta(t, 37.566535, result.Latitude, 1e-6)
+
+		validations := client.ValidateBatch(context.Background(), []string{raw})
+		assert.Equal(t, "서울특별시 중구 세종대로 110", validations[0].Normalized)
+	})
+
+	t.Run("applied in order", func(t *testing.T) {
+		var seen []string
+		record := func(name string) AddressPreprocessor {
+			return func(address string) string {
+				seen = append(seen, name+":"+address)
+				return address
+			}
+		}
+		dropBuildingName := func(address string) string {
+			return strings.TrimSuffix(address, " 서울시청")
+		}
+		client := newClient(record("first"), StripParentheses, dropBuildingName, record("last"), ExpandSidoAbbreviations)
+
+		_, err := client.Geocode(context.Background(), "서울시 중구 세종대로 110 (태평로1가) 서울시청")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"first:서울시 중구 세종대로 110 (태평로1가) 서울시청",
+			"last:서울시 중구 세종대로 110",
+		}, seen)
+	})
+}
+
+func TestClient_WithClock_RateLimitCooldown(t *testing.T) {
+	calls := 0
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	fake := clock.NewFake(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("Kakao", kakao.URL), WithClock(fake))
+	require.NoError(t, err)
+	ctx := context.Background()
+	address := "서울특별시 중구 세종대로 110"
+
+	_, err = client.Geocode(ctx, address)
+	require.Error(t, err)
+	assert.False(t, client.IsAvailable(ctx))
+
+	// Retry-After가 지나기 전에는 Provider를 호출하지 않음
+	fake.Advance(59 * time.Second)
+	_, err = client.Geocode(ctx, address)
+	assert.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Equal(t, 1, calls)
+
+	fake.Advance(time.Second)
+	result, err := client.Geocode(ctx, address)
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_UserAgentAndDefaultHeaders(t *testing.T) {
+	var got http.Header
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	cfg.UserAgent = "my-service/1.0"
+	cfg.DefaultHeaders = map[string]string{
+		"X-Trace-Id":    "trace-123",
+		"Authorization": "Bearer gateway-token",
+	}
+	client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+	// New 이후 맵을 바꿔도 요청에는 반영되지 않음
+	cfg.DefaultHeaders["X-Trace-Id"] = "changed"
+
+	_, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-service/1.0", got.Get("User-Agent"))
+	assert.Equal(t, "trace-123", got.Get("X-Trace-Id"))
+	// Provider 인증 헤더는 덮어쓰지 않음
+	assert.Equal(t, "KakaoAK test-key", got.Get("Authorization"))
+}
+
+func TestClient_ResultHook(t *testing.T) {
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/coord2address.json") {
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"road_address":{"address_name":"서울특별시 중구 세종대로 110"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	errVeto := errors.New("vetoed")
+	newClient := func(hook ResultHook) *Client {
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		cfg.ResultHook = hook
+		client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+		require.NoError(t, err)
+		return client
+	}
+	ctx := context.Background()
+	addresses := []string{"서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 111"}
+
+	t.Run("mutates result", func(t *testing.T) {
+		client := newClient(func(ctx context.Context, result *Result) error {
+			result.Source = "enriched"
+			return nil
+		})
+
+		result, err := client.Geocode(ctx, addresses[0])
+		require.NoError(t, err)
+		assert.Equal(t, "enriched", result.Source)
+
+		results, err := client.GeocodeBatch(ctx, addresses)
+		require.NoError(t, err)
+		for _, result := range results {
+			require.NotNil(t, result)
+			assert.Equal(t, "enriched", result.Source)
+		}
+	})
+
+	t.Run("error fails result", func(t *testing.T) {
+		client := newClient(func(ctx context.Context, result *Result) error {
+			return errVeto
+		})
+
+		_, err := client.Geocode(ctx, addresses[0])
+		assert.ErrorIs(t, err, errVeto)
+
+		results, err := client.GeocodeBatch(ctx, addresses)
+		var hookErr *ResultHookError
+		require.ErrorAs(t, err, &hookErr)
+		assert.ErrorIs(t, err, errVeto)
+		assert.Len(t, hookErr.Errors, 2)
+		assert.Equal(t, []*Result{nil, nil}, results)
+
+		large, err := client.GeocodeLarge(ctx, addresses)
+		require.ErrorAs(t, err, &hookErr)
+		assert.Contains(t, hookErr.Errors, 1)
+		assert.Equal(t, []*Result{nil, nil}, large)
+
+		byKey, err := client.GeocodeBatchMap(ctx, map[string]string{"a": addresses[0]})
+		assert.ErrorIs(t, err, errVeto)
+		assert.Equal(t, map[string]*Result{"a": nil}, byKey)
+
+		_, err = client.ConvertAddress(ctx, addresses[0], AddressTypeRoad)
+		assert.ErrorIs(t, err, errVeto)
+
+		_, err = client.GeocodeFull(ctx, addresses[0])
+		assert.ErrorIs(t, err, errVeto)
+
+		_, err = client.ReverseGeocode(ctx, 37.566535, 126.977969)
+		assert.ErrorIs(t, err, errVeto)
+
+		reversed, err := client.ReverseGeocodeBatch(ctx, []Coordinate{{Latitude: 37.566535, Longitude: 126.977969}})
+		assert.ErrorIs(t, err, errVeto)
+		assert.Equal(t, []*Result{nil}, reversed)
+
+		items, err := client.GeocodeBatchWithOptions(ctx, addresses, BatchOptions{})
+		require.NoError(t, err)
+		for _, item := range items {
+			assert.Nil(t, item.Result)
+			assert.ErrorIs(t, item.Err, errVeto)
+		}
+	})
+}
+
+func TestClient_MatchedAddress(t *testing.T) {
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result, err := client.Geocode(ctx, "서울 중구 세종대로110")
+	require.NoError(t, err)
+	assert.Equal(t, "서울 중구 세종대로 110", result.MatchedAddress)
+
+	results, err := client.GeocodeBatch(ctx, []string{"서울 중구 세종대로110"})
+	require.NoError(t, err)
+	require.NotNil(t, results[0])
+	assert.Equal(t, "서울 중구 세종대로 110", results[0].MatchedAddress)
+}
+
+func TestAddressSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, AddressSimilarity("서울 중구 세종대로110", "서울특별시 중구 세종대로 110"))
+	assert.Equal(t, 0.6, AddressSimilarity("서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 112"))
+	assert.Zero(t, AddressSimilarity("부산광역시 해운대구 우동 1234", "서울특별시 중구 세종대로 110"))
+}
+
+func TestClient_VWorldAddressTypes(t *testing.T) {
+	var mu sync.Mutex
+	var types []string
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		types = append(types, r.URL.Query().Get("type"))
+		mu.Unlock()
+		w.Write([]byte(`{"response": {"status": "NOT_FOUND"}}`))
+	}))
+	defer vworld.Close()
+	requested := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		got := types
+		types = nil
+		return got
+	}
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.VWorldAddressTypes = []AddressType{AddressTypeRoad}
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+	address := "서울특별시 강남구 역삼동 737"
+
+	_, err = client.Geocode(ctx, address)
+	require.Error(t, err)
+	assert.Equal(t, []string{"ROAD"}, requested())
+
+	_, err = client.GeocodeWithOptions(ctx, address, GeocodeOptions{AddressTypes: []AddressType{AddressTypeParcel}})
+	require.Error(t, err)
+	assert.Equal(t, []string{"PARCEL"}, requested())
+
+	_, err = client.GeocodeWithOptions(ctx, address, GeocodeOptions{AddressTypes: []AddressType{"BUILDING"}})
+	assert.ErrorContains(t, err, "invalid address type")
+	assert.Empty(t, requested())
+
+	cfg.VWorldAddressTypes = []AddressType{"BUILDING"}
+	assert.ErrorContains(t, cfg.Validate(), "vWorldAddressTypes")
+}
+
+func TestClient_ProviderTimeout_FailsOverWithinTimeout(t *testing.T) {
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer vworld.Close()
+
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.KakaoAPIKey = "test-key"
+	cfg.Timeout = time.Second
+	cfg.ProviderTimeout = 100 * time.Millisecond
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+
+	start := time.Now()
+	result, err := client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Kakao", result.Provider)
+	assert.Less(t, time.Since(start), cfg.Timeout)
+}
+
+func TestClient_Timeout_BoundsFallbackChain(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	})
+	vworld := httptest.NewServer(slow)
+	defer vworld.Close()
+	kakao := httptest.NewServer(slow)
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.KakaoAPIKey = "test-key"
+	cfg.Timeout = 300 * time.Millisecond
+	cfg.ProviderTimeout = 200 * time.Millisecond
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL), WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestClient_GeocodeSnapped(t *testing.T) {
+	const (
+		parcel = "서울특별시 강남구 역삼동 737"
+		road   = "서울 강남구 테헤란로 152"
+	)
+	newKakao := func(t *testing.T, roadAddressInSearch bool) (*httptest.Server, *[]string) {
+		var calls []string
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v2/local/search/address.json", func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query().Get("query")
+			calls = append(calls, "search "+query)
+			switch query {
+			case parcel:
+				roadAddress := ""
+				if roadAddressInSearch {
+					roadAddress = `,"road_address":{"address_name":"` + road + `"}`
+				}
+				w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"` + parcel + `","address_type":"REGION_ADDR","x":"127.036400","y":"37.500100"` + roadAddress + `}]}`))
+			case road:
+				w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"` + road + `","address_type":"ROAD_ADDR","x":"127.036508","y":"37.500622"}]}`))
+			default:
+				w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+			}
+		})
+		mux.HandleFunc("/v2/local/geo/coord2address.json", func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "reverse")
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"road_address":{"address_name":"` + road + `"},"address":{"address_name":"` + parcel + `"}}]}`))
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		return server, &calls
+	}
+
+	t.Run("reverse geocodes when the match has no road address", func(t *testing.T) {
+		kakao, calls := newKakao(t, false)
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+		require.NoError(t, err)
+
+		result, err := client.GeocodeSnapped(context.Background(), parcel)
+
+		require.NoError(t, err)
+		assert.True(t, result.Snapped)
+		assert.InDelta(t, 37.500622, result.Latitude, 1e-6)
+		assert.InDelta(t, 127.036508, result.Longitude, 1e-6)
+		require.NotNil(t, result.AddressDetail)
+		assert.Equal(t, road, result.AddressDetail.RoadAddress)
+		assert.Equal(t, parcel, result.MatchedAddress)
+		assert.Equal(t, []string{"search " + parcel, "reverse", "search " + road}, *calls)
+		assert.Len(t, result.Attempts, 3)
+	})
+
+	t.Run("uses the road address of the match", func(t *testing.T) {
+		kakao, calls := newKakao(t, true)
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+		require.NoError(t, err)
+
+		result, err := client.GeocodeSnapped(context.Background(), parcel)
+
+		require.NoError(t, err)
+		assert.True(t, result.Snapped)
+		assert.InDelta(t, 37.500622, result.Latitude, 1e-6)
+		assert.Equal(t, []string{"search " + parcel, "search " + road}, *calls)
+	})
+
+	t.Run("returns the unsnapped result when the road address does not resolve", func(t *testing.T) {
+		kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, "coord2address.json") {
+				w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address":{"address_name":"` + parcel + `"}}]}`))
+				return
+			}
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"` + parcel + `","address_type":"REGION_ADDR","x":"127.036400","y":"37.500100"}]}`))
+		}))
+		defer kakao.Close()
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+		require.NoError(t, err)
+
+		result, err := client.GeocodeSnapped(context.Background(), parcel)
+
+		require.NoError(t, err)
+		assert.False(t, result.Snapped)
+		assert.InDelta(t, 37.500100, result.Latitude, 1e-6)
+	})
+
+	t.Run("does not mark an unchanged coordinate as snapped", func(t *testing.T) {
+		kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"` + parcel + `","address_type":"REGION_ADDR","x":"127.036400","y":"37.500100","road_address":{"address_name":"` + road + `"}}]}`))
+		}))
+		defer kakao.Close()
+		cfg := DefaultConfig()
+		cfg.KakaoAPIKey = "test-key"
+		client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+		require.NoError(t, err)
+
+		result, err := client.GeocodeSnapped(context.Background(), parcel)
+
+		require.NoError(t, err)
+		assert.False(t, result.Snapped)
+		assert.InDelta(t, 37.500100, result.Latitude, 1e-6)
+	})
+}
+
+func TestClient_GeocodeBatchMap(t *testing.T) {
+	kakao := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("query") {
+		case "서울특별시 중구 세종대로 110":
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+		case "서울특별시 강남구 테헤란로 152":
+			w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 강남구 테헤란로 152","address_type":"ROAD_ADDR","x":"127.036508","y":"37.500622"}]}`))
+		default:
+			w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+		}
+	}))
+	defer kakao.Close()
+
+	cfg := DefaultConfig()
+	cfg.KakaoAPIKey = "test-key"
+	client, err := New(cfg, WithBaseURL("Kakao", kakao.URL))
+	require.NoError(t, err)
+	addresses := map[string]string{
+		"order-17": "서울특별시 강남구 테헤란로 152",
+		"order-3":  "서울특별시 중구 세종대로 110",
+		"order-9":  "서울특별시 중구 없는로 1",
+	}
+
+	t.Run("results keyed by caller IDs", func(t *testing.T) {
+		results, err := client.GeocodeBatchMap(context.Background(), addresses)
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.NotNil(t, results["order-3"])
+		assert.InDelta(t, 37.566535, results["order-3"].Latitude, 1e-6)
+		require.NotNil(t, results["order-17"])
+		assert.InDelta(t, 37.500622, results["order-17"].Latitude, 1e-6)
+		failed, ok := results["order-9"]
+		assert.True(t, ok, "failed key is present")
+		assert.Nil(t, failed)
+	})
+
+	t.Run("detailed variant reports errors", func(t *testing.T) {
+		results, err := client.GeocodeBatchMapWithOptions(context.Background(), addresses, BatchOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.NoError(t, results["order-3"].Err)
+		assert.InDelta(t, 126.977969, results["order-3"].Result.Longitude, 1e-6)
+		assert.Nil(t, results["order-9"].Result)
+		assert.Error(t, results["order-9"].Err)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		results, err := client.GeocodeBatchMap(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("too many addresses", func(t *testing.T) {
+		many := make(map[string]string, 101)
+		for i := range 101 {
+			many[strconv.Itoa(i)] = "서울특별시 중구 세종대로 110"
+		}
+
+		_, err := client.GeocodeBatchMap(context.Background(), many)
+
+		assert.EqualError(t, err, "too many addresses: maximum 100, got 101")
+	})
+}
+
+func TestNew_RegionProvidersUnknownProvider(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.RegionProviders = map[string]string{"서울": "Kakao"}
+
+	_, err := New(cfg)
+
+	assert.EqualError(t, err, "regionProviders[서울]: unknown provider: Kakao")
+}
+
+func TestClient_Geocode_LocalDataset(t *testing.T) {
+	var calls atomic.Int32
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "129.075600", "y": "35.179800"}}}}`))
+	}))
+	defer vworld.Close()
+
+	dataset := filepath.Join(t.TempDir(), "addresses.csv")
+	require.NoError(t, os.WriteFile(dataset, []byte("address,latitude,longitude\n서울특별시 중구 세종대로 110,37.5665,126.978\n"), 0o600))
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "test-key"
+	cfg.LocalDatasetPath = dataset
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result, err := client.Geocode(ctx, "서울 중구 세종대로 110")
+	require.NoError(t, err)
+	assert.Equal(t, "Local", result.Provider)
+	assert.Equal(t, 37.5665, result.Latitude)
+	assert.Zero(t, calls.Load(), "데이터셋에 있는 주소는 외부 API를 호출하지 않음")
+
+	result, err = client.Geocode(ctx, "부산광역시 연제구 중앙대로 1001")
+	require.NoError(t, err)
+	assert.Equal(t, "vWorld", result.Provider, "데이터셋에 없으면 다음 Provider로 넘어감")
+	assert.Equal(t, int32(1), calls.Load())
+
+	cfg.LocalDatasetPath = filepath.Join(t.TempDir(), "missing.csv")
+	_, err = New(cfg)
+	assert.ErrorContains(t, err, "Local provider: local dataset")
+}
+
+func TestClient_Geocode_StrategyBalanced(t *testing.T) {
+	var mu sync.Mutex
+	callsByKey := make(map[string]int)
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		callsByKey[r.URL.Query().Get("key")]++
+		mu.Unlock()
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "126.978000", "y": "37.566500"}}}}`))
+	}))
+	defer vworld.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "key-a,key-b,key-c"
+	cfg.Strategy = StrategyBalanced
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+	require.NoError(t, err)
+
+	const requests = 300
+	for i := 0; i < requests; i++ {
+		_, err := client.Geocode(context.Background(), fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1))
+		require.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, callsByKey, 3)
+	for key, calls := range callsByKey {
+		assert.InDelta(t, requests/3, calls, requests/3*0.4, "키 %s에 고르게 분산", key)
+	}
+}
+
+func TestClient_Geocode_StrategyBalanced_ProviderDailyLimits(t *testing.T) {
+	var mu sync.Mutex
+	callsByKey := make(map[string]int)
+	vworld := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		callsByKey[r.URL.Query().Get("key")]++
+		mu.Unlock()
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "126.978000", "y": "37.566500"}}}}`))
+	}))
+	defer vworld.Close()
+
+	cfg := DefaultConfig()
+	cfg.VWorldAPIKey = "key-a,key-b,key-c"
+	cfg.Strategy = StrategyBalanced
+	cfg.ProviderDailyLimits = map[string]int{"vWorld": 10}
+	client, err := New(cfg, WithBaseURL("vWorld", vworld.URL))
+	require.NoError(t, err)
+
+	// 설정한 할당량을 다 쓴 키는 가중치가 0이 되어 나머지 키가 먼저 시도됨
+	for i := 0; i < 30; i++ {
+		_, err := client.Geocode(context.Background(), fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1))
+		require.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"key-a": 10, "key-b": 10, "key-c": 10}, callsByKey)
+}
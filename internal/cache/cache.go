@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+)
+
+// Cache 지오코딩 결과 캐시
+// 캐시는 선택 사항이므로 구현체는 저장소 장애(예: Redis 연결 실패)를 에러로 알리지 않는다
+// 조회 실패는 캐시 미스로, 저장 실패는 무시로 처리해 캐시 장애가 지오코딩 요청을 실패시키지 않게 한다
+type Cache interface {
+	// Get 캐시된 응답 조회 (없거나 만료되었으면 false)
+	Get(ctx context.Context, key string) (*model.GeocodingResponse, bool)
+
+	// Set 응답 저장
+	Set(ctx context.Context, key string, resp *model.GeocodingResponse)
+}
+
+// Key 주소, 주소 타입, 좌표 정밀도로 캐시 키 생성
+// 주소는 정규화된 값을 전달해야 같은 주소가 같은 키를 갖는다
+// 같은 주소라도 ROAD와 PARCEL 검색 결과가 다를 수 있고 정밀도에 따라 좌표가 달라지므로 모두 키에 포함한다
+// variants는 결과를 바꾸는 그 밖의 요청별 옵션 (예: Kakao analyze_type, WGS84가 아닌 출력 좌표계)이며 빈 값은 무시한다
+func Key(address, addressType string, precision int, variants ...string) string {
+	key := fmt.Sprintf("%s|%d|%s", strings.ToUpper(addressType), precision, address)
+	for _, variant := range variants {
+		if variant != "" {
+			key += "|" + variant
+		}
+	}
+	return key
+}
+
+// Memory TTL 기반 인메모리 캐시
+type Memory struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	items      map[string]memoryEntry
+	clock      clock.Clock
+}
+
+type memoryEntry struct {
+	resp      *model.GeocodingResponse
+	expiresAt time.Time
+}
+
+// NewMemory 인메모리 캐시 생성자
+// maxEntries가 0 이하이면 항목 수를 제한하지 않는다
+func NewMemory(ttl time.Duration, maxEntries int) *Memory {
+	return &Memory{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		items:      make(map[string]memoryEntry),
+		clock:      clock.Real{},
+	}
+}
+
+// Get 캐시된 응답 조회
+func (m *Memory) Get(ctx context.Context, key string) (*model.GeocodingResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	if !m.clock.Now().Before(entry.expiresAt) {
+		delete(m.items, key)
+		return nil, false
+	}
+	return entry.resp, true
+}
+
+// Set 응답 저장 (가득 찬 경우 만료 항목을 먼저 정리하고, 그래도 가득 차면 임의 항목 하나를 제거)
+func (m *Memory) Set(ctx context.Context, key string, resp *model.GeocodingResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock.Now()
+	if _, exists := m.items[key]; !exists && m.maxEntries > 0 && len(m.items) >= m.maxEntries {
+		for k, entry := range m.items {
+			if !now.Before(entry.expiresAt) {
+				delete(m.items, k)
+			}
+		}
+		for k := range m.items {
+			if len(m.items) < m.maxEntries {
+				break
+			}
+			delete(m.items, k)
+		}
+	}
+
+	m.items[key] = memoryEntry{resp: resp, expiresAt: now.Add(m.ttl)}
+}
+
+// SetClock 만료 판단에 쓸 시계 설정 (nil이면 시스템 시계, 사용 전에 호출)
+func (m *Memory) SetClock(c clock.Clock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clock = clock.OrReal(c)
+}
+
+// Len 저장된 항목 수 (만료 항목 포함)
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.items)
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "ROAD|6|서울특별시 중구 세종대로 110", Key("서울특별시 중구 세종대로 110", "road", 6))
+	assert.NotEqual(t, Key("서울역", "ROAD", 6), Key("서울역", "PARCEL", 6))
+	assert.NotEqual(t, Key("서울역", "", 6), Key("서울역", "ROAD", 6))
+	assert.NotEqual(t, Key("서울역", "ROAD", 6), Key("서울역", "ROAD", 4))
+	assert.NotEqual(t, Key("서울역", "ROAD", 6), Key("서울역", "ROAD", 6, "exact"))
+	assert.Equal(t, Key("서울역", "ROAD", 6), Key("서울역", "ROAD", 6, ""))
+}
+
+func TestMemory_GetSet(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemory(time.Minute, 0)
+	resp := &model.GeocodingResponse{Success: true, Provider: "vWorld"}
+
+	_, ok := c.Get(ctx, "a")
+	assert.False(t, ok)
+
+	c.Set(ctx, "a", resp)
+	got, ok := c.Get(ctx, "a")
+	require.True(t, ok)
+	assert.Same(t, resp, got)
+}
+
+func TestMemory_Expiry(t *testing.T) {
+	ctx := context.Background()
+	fake := clock.NewFake(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	c := NewMemory(time.Minute, 0)
+	c.SetClock(fake)
+
+	c.Set(ctx, "a", &model.GeocodingResponse{Success: true})
+
+	fake.Advance(59 * time.Second)
+	_, ok := c.Get(ctx, "a")
+	assert.True(t, ok)
+
+	fake.Advance(time.Second)
+	_, ok = c.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestMemory_MaxEntries(t *testing.T) {
+	ctx := context.Background()
+	c := NewMemory(time.Minute, 3)
+
+	for i := 0; i < 10; i++ {
+		c.Set(ctx, fmt.Sprintf("key-%d", i), &model.GeocodingResponse{Success: true})
+	}
+
+	assert.Equal(t, 3, c.Len())
+	_, ok := c.Get(ctx, "key-9")
+	assert.True(t, ok, "가장 최근 항목은 항상 저장됨")
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultRedisPingTimeout ctx에 기한이 없을 때 PingRedis의 연결·응답 제한 시간
+const defaultRedisPingTimeout = time.Second
+
+// PingRedis Redis 서버에 PING을 보내 연결 상태 확인 (헬스 체크용)
+// password가 있으면 먼저 AUTH, db가 0이 아니면 SELECT 후 PING 한다
+// ctx에 기한이 없으면 defaultRedisPingTimeout을 적용한다
+func PingRedis(ctx context.Context, addr, password string, db int) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRedisPingTimeout)
+		defer cancel()
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("redis connect: %w", err)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("redis connect: %w", err)
+	}
+
+	r := bufio.NewReader(conn)
+	if password != "" {
+		if _, err := redisCommand(conn, r, "AUTH", password); err != nil {
+			return fmt.Errorf("redis auth: %w", err)
+		}
+	}
+	if db != 0 {
+		if _, err := redisCommand(conn, r, "SELECT", strconv.Itoa(db)); err != nil {
+			return fmt.Errorf("redis select: %w", err)
+		}
+	}
+
+	reply, err := redisCommand(conn, r, "PING")
+	if err != nil {
+		return fmt.Errorf("redis ping: %w", err)
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("redis ping: unexpected reply %q", reply)
+	}
+	return nil
+}
+
+// redisCommand RESP 배열로 명령을 보내고 한 줄 응답(+OK, +PONG 등)을 반환
+// 에러 응답(-ERR ...)은 에러로 변환한다
+func redisCommand(conn net.Conn, r *bufio.Reader, args ...string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := conn.Write([]byte(b.String())); err != nil {
+		return "", err
+	}
+
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	switch {
+	case strings.HasPrefix(line, "+"):
+		return line[1:], nil
+	case strings.HasPrefix(line, "-"):
+		return "", errors.New(line[1:])
+	default:
+		return "", fmt.Errorf("unexpected reply %q", line)
+	}
+}
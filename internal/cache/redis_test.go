@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startFakeRedis PING, AUTH, SELECT만 처리하는 RESP 서버 (password가 있으면 AUTH 필요)
+func startFakeRedis(t *testing.T, password string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakeRedis(conn, password)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func serveFakeRedis(conn net.Conn, password string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := password == ""
+	for {
+		args, err := readRESPArray(r)
+		if err != nil {
+			return
+		}
+		var reply string
+		switch strings.ToUpper(args[0]) {
+		case "AUTH":
+			if len(args) == 2 && args[1] == password {
+				authed = true
+				reply = "+OK"
+			} else {
+				reply = "-WRONGPASS invalid username-password pair"
+			}
+		case "SELECT", "PING":
+			switch {
+			case !authed:
+				reply = "-NOAUTH Authentication required."
+			case args[0] == "PING":
+				reply = "+PONG"
+			default:
+				reply = "+OK"
+			}
+		default:
+			reply = "-ERR unknown command"
+		}
+		io.WriteString(conn, reply+"\r\n")
+	}
+}
+
+func readRESPArray(r *bufio.Reader) ([]string, error) {
+	readLine := func() (string, error) {
+		line, err := r.ReadString('\n')
+		return strings.TrimRight(line, "\r\n"), err
+	}
+	header, err := readLine()
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(header, "*"))
+	if err != nil || n < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := readLine(); err != nil { // $길이
+			return nil, err
+		}
+		if args[i], err = readLine(); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+func TestPingRedis(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reachable", func(t *testing.T) {
+		addr := startFakeRedis(t, "")
+		assert.NoError(t, PingRedis(ctx, addr, "", 2))
+	})
+
+	t.Run("auth", func(t *testing.T) {
+		addr := startFakeRedis(t, "secret")
+		assert.NoError(t, PingRedis(ctx, addr, "secret", 0))
+
+		err := PingRedis(ctx, addr, "wrong", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "redis auth: WRONGPASS")
+
+		err = PingRedis(ctx, addr, "", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "NOAUTH")
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		// 바로 닫은 포트는 연결이 거부된다
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		ln.Close()
+
+		err = PingRedis(ctx, addr, "", 0)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "redis connect")
+	})
+}
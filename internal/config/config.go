@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 	
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	
 	"gopkg.in/yaml.v3"
 )
 
@@ -14,6 +16,7 @@ type Config struct {
 	Server    ServerConfig    `yaml:"server"`
 	Providers ProvidersConfig `yaml:"providers"`
 	Redis     RedisConfig     `yaml:"redis"`
+	Cache     CacheConfig     `yaml:"cache"`
 	Logging   LoggingConfig   `yaml:"logging"`
 	API       APIConfig       `yaml:"api"`
 }
@@ -21,15 +24,51 @@ type Config struct {
 // ServerConfig represents server configuration
 type ServerConfig struct {
 	Port               string        `yaml:"port"`
+	GRPCPort           string        `yaml:"grpc_port"`
 	ReadTimeout        time.Duration `yaml:"read_timeout"`
 	WriteTimeout       time.Duration `yaml:"write_timeout"`
 	MaxRequestBodySize string        `yaml:"max_request_body_size"`
+
+	// ValidateKeysOnStart makes one geocoding request per provider at startup to check its API key.
+	// Providers whose key is rejected are disabled; startup fails only when every key is rejected
+	ValidateKeysOnStart bool `yaml:"validate_keys_on_start"`
+
+	// AllowedCIDRs restricts the API to clients in these CIDRs (IPv4 or IPv6, single IPs allowed).
+	// Health check endpoints stay open. Empty allows every client
+	AllowedCIDRs []string `yaml:"allowed_cidrs"`
+
+	// TrustedProxies are the proxies (CIDRs or IPs) whose X-Forwarded-For header is used as the client IP.
+	// Empty trusts no proxy, so the client IP is always the connection's remote address
+	TrustedProxies []string `yaml:"trusted_proxies"`
+
+	// PlaygroundTileURL is the map tile URL template ({z}, {x}, {y}) for the playground page at /.
+	// Point it at an internal tile server when the public one is not reachable
+	PlaygroundTileURL string `yaml:"playground_tile_url"`
+
+	// PlaygroundTileAttribution is the attribution shown under the playground map, as required by the tile server
+	PlaygroundTileAttribution string `yaml:"playground_tile_attribution"`
+}
+
+// defaultPlaygroundTileURL OpenStreetMap 타일 (사용 정책상 저작권 표시 필요)
+const defaultPlaygroundTileURL = "https://tile.openstreetmap.org/{z}/{x}/{y}.png"
+
+// MaxRequestBodyBytes returns MaxRequestBodySize in bytes
+func (s ServerConfig) MaxRequestBodyBytes() (int64, error) {
+	return utils.ParseByteSize(s.MaxRequestBodySize)
 }
 
 // ProvidersConfig represents providers configuration
 type ProvidersConfig struct {
 	VWorld ProviderConfig `yaml:"vworld"`
 	Kakao  ProviderConfig `yaml:"kakao"`
+
+	// Nominatim is an opt-in OpenStreetMap fallback, tried after every other provider.
+	// It needs no API key but requires BaseURL (usually a self-hosted instance).
+	Nominatim ProviderConfig `yaml:"nominatim"`
+
+	// Juso is the opt-in government road name address API (도로명주소 API). It returns no coordinates,
+	// so it normalizes addresses before geocoding and backs /api/v1/normalize instead of joining the chain
+	Juso ProviderConfig `yaml:"juso"`
 }
 
 // ProviderConfig represents individual provider configuration
@@ -39,6 +78,25 @@ type ProviderConfig struct {
 	DailyLimit     int                   `yaml:"daily_limit"`
 	Timeout        time.Duration         `yaml:"timeout"`
 	CircuitBreaker CircuitBreakerConfig  `yaml:"circuit_breaker"`
+
+	// BaseURL overrides the provider API root, e.g. for a mock server or proxy (default: official endpoint)
+	BaseURL string `yaml:"base_url"`
+
+	// KeywordFallback retries keyword (place name) search when address search finds nothing (Kakao only)
+	KeywordFallback bool `yaml:"keyword_fallback"`
+
+	// UserAgent identifies this application to the provider (Nominatim only, required by its usage policy)
+	UserAgent string `yaml:"user_agent"`
+
+	// AnalyzeType selects address matching: "similar" (default) or "exact" (Kakao only)
+	AnalyzeType string `yaml:"analyze_type"`
+
+	// ExactFallback retries with "similar" when an exact search finds nothing (Kakao only)
+	ExactFallback bool `yaml:"exact_fallback"`
+
+	// AddressTypes restricts the address types tried when a request gives none, e.g. [ROAD] for road-only data
+	// so a failed road search is not retried as a parcel search (vWorld only, default: both)
+	AddressTypes []string `yaml:"address_types"`
 }
 
 // CircuitBreakerConfig represents circuit breaker configuration
@@ -50,12 +108,26 @@ type CircuitBreakerConfig struct {
 
 // RedisConfig represents Redis configuration
 type RedisConfig struct {
+	// Enabled turns on the Redis connectivity check: /health and /ready report a PING as a component.
+	// It does not cache results in Redis; the result cache is in memory (see CacheConfig)
+	Enabled bool `yaml:"enabled"`
+
 	Addr     string        `yaml:"addr"`
 	Password string        `yaml:"password"`
 	DB       int           `yaml:"db"`
 	Timeout  time.Duration `yaml:"timeout"`
 }
 
+// CacheConfig represents the in-memory result cache configuration
+type CacheConfig struct {
+	// TTL is how long a successful single-address result is cached. Zero disables the cache,
+	// and with it the Cache-Control: no-cache / ?refresh=true handling of the geocode endpoints
+	TTL time.Duration `yaml:"ttl"`
+
+	// MaxEntries is the maximum number of cached results (default: 10000 when TTL is set)
+	MaxEntries int `yaml:"max_entries"`
+}
+
 // LoggingConfig represents logging configuration
 type LoggingConfig struct {
 	Level  string `yaml:"level"`
@@ -67,6 +139,15 @@ type LoggingConfig struct {
 type APIConfig struct {
 	MaxBatchSize    int           `yaml:"max_batch_size"`
 	RequestTimeout  time.Duration `yaml:"request_timeout"`
+	// AdminAPIKeys are the keys accepted in the X-API-Key header by admin
+	// endpoints (/api/v1/providers). Admin endpoints reject every request
+	// when no key is configured.
+	AdminAPIKeys []string `yaml:"admin_api_keys"`
+
+	// MaxInFlight caps the geocoding requests (/api/v1/geocode*) handled at once across the server.
+	// Requests over the cap wait up to MaxInFlightWait for a slot, then get 503. 0 means no cap
+	MaxInFlight     int           `yaml:"max_in_flight"`
+	MaxInFlightWait time.Duration `yaml:"max_in_flight_wait"`
 }
 
 // Load loads configuration from file
@@ -123,6 +204,9 @@ func setDefaults(cfg *Config) {
 	if cfg.Server.Port == "" {
 		cfg.Server.Port = "8080"
 	}
+	if cfg.Server.GRPCPort == "" {
+		cfg.Server.GRPCPort = "9090"
+	}
 	if cfg.Server.ReadTimeout == 0 {
 		cfg.Server.ReadTimeout = 15 * time.Second
 	}
@@ -132,6 +216,12 @@ func setDefaults(cfg *Config) {
 	if cfg.Server.MaxRequestBodySize == "" {
 		cfg.Server.MaxRequestBodySize = "1MB"
 	}
+	if cfg.Server.PlaygroundTileURL == "" {
+		cfg.Server.PlaygroundTileURL = defaultPlaygroundTileURL
+	}
+	if cfg.Server.PlaygroundTileAttribution == "" && cfg.Server.PlaygroundTileURL == defaultPlaygroundTileURL {
+		cfg.Server.PlaygroundTileAttribution = "© OpenStreetMap contributors"
+	}
 	
 	// Provider defaults
 	if cfg.Providers.VWorld.Timeout == 0 {
@@ -168,6 +258,11 @@ func setDefaults(cfg *Config) {
 		cfg.Redis.Timeout = 5 * time.Second
 	}
 	
+	// Cache defaults
+	if cfg.Cache.TTL > 0 && cfg.Cache.MaxEntries == 0 {
+		cfg.Cache.MaxEntries = 10000
+	}
+	
 	// Logging defaults
 	if cfg.Logging.Level == "" {
 		cfg.Logging.Level = "info"
@@ -195,6 +290,11 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("server port is required")
 	}
 	
+	// 요청 본문 크기 검증
+	if _, err := cfg.Server.MaxRequestBodyBytes(); err != nil {
+		return fmt.Errorf("invalid max_request_body_size: %w", err)
+	}
+	
 	// Provider 검증
 	if cfg.Providers.VWorld.Enabled && cfg.Providers.VWorld.APIKey == "" {
 		return fmt.Errorf("vWorld API key is required when enabled")
@@ -202,6 +302,30 @@ func validate(cfg *Config) error {
 	if cfg.Providers.Kakao.Enabled && cfg.Providers.Kakao.APIKey == "" {
 		return fmt.Errorf("Kakao API key is required when enabled")
 	}
+	if cfg.Providers.Juso.Enabled && cfg.Providers.Juso.APIKey == "" {
+		return fmt.Errorf("Juso API key is required when enabled")
+	}
+	for _, t := range cfg.Providers.VWorld.AddressTypes {
+		switch strings.ToUpper(t) {
+		case "ROAD", "PARCEL":
+		default:
+			return fmt.Errorf("invalid vWorld address_types entry: %q (must be \"ROAD\" or \"PARCEL\")", t)
+		}
+	}
+	switch strings.ToLower(cfg.Providers.Kakao.AnalyzeType) {
+	case "", "similar", "exact":
+	default:
+		return fmt.Errorf("invalid Kakao analyze_type: %q (must be \"similar\" or \"exact\")", cfg.Providers.Kakao.AnalyzeType)
+	}
+	if cfg.Providers.Nominatim.Enabled {
+		if cfg.Providers.Nominatim.BaseURL == "" {
+			return fmt.Errorf("Nominatim base URL is required when enabled")
+		}
+		// Nominatim usage policy requires an identifying User-Agent
+		if strings.TrimSpace(cfg.Providers.Nominatim.UserAgent) == "" {
+			return fmt.Errorf("Nominatim user agent is required when enabled")
+		}
+	}
 	
 	// 최소 하나의 Provider는 활성화되어야 함
 	if !cfg.Providers.VWorld.Enabled && !cfg.Providers.Kakao.Enabled {
@@ -213,10 +337,24 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("redis address is required")
 	}
 	
+	// 캐시 검증
+	if cfg.Cache.TTL < 0 {
+		return fmt.Errorf("cache ttl cannot be negative")
+	}
+	if cfg.Cache.MaxEntries < 0 {
+		return fmt.Errorf("cache max_entries cannot be negative")
+	}
+	
 	// API 검증
 	if cfg.API.MaxBatchSize < 1 || cfg.API.MaxBatchSize > 1000 {
 		return fmt.Errorf("max_batch_size must be between 1 and 1000")
 	}
+	if cfg.API.MaxInFlight < 0 {
+		return fmt.Errorf("max_in_flight cannot be negative")
+	}
+	if cfg.API.MaxInFlightWait < 0 {
+		return fmt.Errorf("max_in_flight_wait cannot be negative")
+	}
 	
 	return nil
 }
@@ -268,11 +406,17 @@ func mergeConfig(base, override *Config) {
 	if override.Server.Port != "" {
 		base.Server.Port = override.Server.Port
 	}
+	if override.Server.GRPCPort != "" {
+		base.Server.GRPCPort = override.Server.GRPCPort
+	}
 	if override.Logging.Level != "" {
 		base.Logging.Level = override.Logging.Level
 	}
 	if override.Logging.Format != "" {
 		base.Logging.Format = override.Logging.Format
 	}
+	if override.Logging.Output != "" {
+		base.Logging.Output = override.Logging.Output
+	}
 	// 필요한 다른 필드들도 추가
 }
\ No newline at end of file
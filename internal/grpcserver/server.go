@@ -0,0 +1,186 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/service"
+	geocodingv1 "github.com/oursportsnation/k-geocode/proto/geocoding/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize 배치 요청 최대 주소 수 (HTTP API와 동일)
+const maxBatchSize = 100
+
+// Server gRPC 지오코딩 서비스 구현
+type Server struct {
+	geocodingv1.UnimplementedGeocodingServiceServer
+
+	coordinator service.CoordinatorInterface
+	logger      *zap.Logger
+}
+
+// NewServer gRPC 서버 생성자
+func NewServer(coordinator service.CoordinatorInterface, logger *zap.Logger) *Server {
+	return &Server{
+		coordinator: coordinator,
+		logger:      logger,
+	}
+}
+
+// Register gRPC 서버에 지오코딩 서비스 등록
+func (s *Server) Register(gs *grpc.Server) {
+	geocodingv1.RegisterGeocodingServiceServer(gs, s)
+}
+
+// Geocode 단건 지오코딩
+func (s *Server) Geocode(ctx context.Context, req *geocodingv1.GeocodeRequest) (*geocodingv1.GeocodeResponse, error) {
+	resp, err := s.coordinator.GetGeocodingService().Geocode(ctx, req.GetAddress(), req.GetAddressType())
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	return toProtoResponse(resp), nil
+}
+
+// GeocodeBatch 대량 지오코딩 (완료되는 순서대로 스트리밍)
+func (s *Server) GeocodeBatch(req *geocodingv1.GeocodeBatchRequest, stream grpc.ServerStreamingServer[geocodingv1.BatchItem]) error {
+	addresses := req.GetAddresses()
+	if len(addresses) > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "maximum %d addresses allowed", maxBatchSize)
+	}
+
+	err := s.coordinator.GetGeocodingService().GeocodeBatchStream(stream.Context(), addresses, service.BatchOptions{},
+		func(index int, resp *model.GeocodingResponse) error {
+			return stream.Send(&geocodingv1.BatchItem{
+				Index:    int32(index),
+				Address:  addresses[index],
+				Response: toProtoResponse(resp),
+			})
+		})
+	if err != nil {
+		return s.toStatusError(err)
+	}
+	return nil
+}
+
+// ReverseGeocode 역지오코딩
+func (s *Server) ReverseGeocode(ctx context.Context, req *geocodingv1.ReverseGeocodeRequest) (*geocodingv1.ReverseGeocodeResponse, error) {
+	resp, err := s.coordinator.GetGeocodingService().ReverseGeocode(ctx, model.Coordinate{
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+	})
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	pb := toProtoResponse(resp)
+	return &geocodingv1.ReverseGeocodeResponse{
+		Success: pb.GetSuccess(),
+		Result:  pb.GetResult(),
+		Error:   pb.GetError(),
+	}, nil
+}
+
+// HealthCheck Provider 가용성 확인
+func (s *Server) HealthCheck(ctx context.Context, req *geocodingv1.HealthCheckRequest) (*geocodingv1.HealthCheckResponse, error) {
+	health := s.coordinator.HealthCheck(ctx)
+
+	resp := &geocodingv1.HealthCheckResponse{
+		Healthy:   health.Healthy,
+		Providers: make([]*geocodingv1.ProviderStatus, 0, len(health.Providers)),
+	}
+	for _, p := range health.Providers {
+		resp.Providers = append(resp.Providers, &geocodingv1.ProviderStatus{
+			Name:      p.Name,
+			Available: p.Available,
+		})
+	}
+	return resp, nil
+}
+
+// toStatusError 서비스 에러를 gRPC 상태 코드로 변환
+func (s *Server) toStatusError(err error) error {
+	if errors.Is(err, service.ErrNoProvidersAvailable) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if st, ok := status.FromError(err); ok {
+		// 스트림 전송 실패 등 이미 gRPC 상태를 가진 에러
+		return st.Err()
+	}
+	s.logger.Error("Geocoding service error", zap.Error(err))
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// toProtoResponse 지오코딩 응답을 protobuf 메시지로 변환
+func toProtoResponse(resp *model.GeocodingResponse) *geocodingv1.GeocodeResponse {
+	result := &geocodingv1.Result{
+		Provider:       resp.Provider,
+		MatchLevel:     resp.MatchLevel,
+		LowConfidence:  resp.LowConfidence,
+		Confidence:     resp.Confidence,
+		MatchedAddress: resp.MatchedAddress,
+	}
+	if resp.Coordinate != nil {
+		result.Latitude = resp.Coordinate.Latitude
+		result.Longitude = resp.Coordinate.Longitude
+	}
+	if d := resp.AddressDetail; d != nil {
+		result.AddressDetail = &geocodingv1.AddressDetail{
+			RoadAddress:   d.RoadAddress,
+			ParcelAddress: d.ParcelAddress,
+			BuildingName:  d.BuildingName,
+			Zipcode:       d.Zipcode,
+			LegalDongCode: d.LegalDongCode,
+			AdminDongCode: d.AdminDongCode,
+			IsMountain:    d.IsMountain,
+			MainNo:        d.MainNo,
+			SubNo:         d.SubNo,
+
+			EnglishAddress:           d.EnglishAddress,
+			Sido:                     d.Sido,
+			Sigungu:                  d.Sigungu,
+			Dong:                     d.Dong,
+			AdminDong:                d.AdminDong,
+			RoadName:                 d.RoadName,
+			BuildingNo:               d.BuildingNo,
+			RefinedAddress:           d.RefinedAddress,
+			BuildingDong:             d.BuildingDong,
+			BuildingUnit:             d.BuildingUnit,
+			BuildingManagementNumber: d.BuildingManagementNumber,
+		}
+	}
+	for _, a := range resp.Attempts {
+		result.Attempts = append(result.Attempts, &geocodingv1.Attempt{
+			Provider:   a.Provider,
+			Success:    a.Success,
+			Error:      a.Error,
+			RequestUrl: a.RequestURL,
+		})
+	}
+
+	return &geocodingv1.GeocodeResponse{
+		Success:         resp.Success,
+		Result:          result,
+		Error:           resp.Error,
+		CoordinateValid: resp.CoordinateValid,
+	}
+}
@@ -0,0 +1,232 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/service"
+	geocodingv1 "github.com/oursportsnation/k-geocode/proto/geocoding/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// mockProvider 주소별 좌표를 반환하는 Mock Provider
+type mockProvider struct {
+	coordinates map[string]model.Coordinate
+	available   bool
+}
+
+func (m *mockProvider) Name() string { return "MockProvider" }
+
+func (m *mockProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	coord, ok := m.coordinates[address]
+	if !ok {
+		return &model.ProviderResult{Success: false}, nil
+	}
+	return &model.ProviderResult{
+		Success:       true,
+		Coordinate:    coord,
+		AddressDetail: model.AddressDetail{RoadAddress: address},
+	}, nil
+}
+
+func (m *mockProvider) ReverseGeocode(ctx context.Context, coord model.Coordinate) (*model.ProviderResult, error) {
+	for address, c := range m.coordinates {
+		if c == coord {
+			return &model.ProviderResult{
+				Success:       true,
+				Coordinate:    coord,
+				AddressDetail: model.AddressDetail{RoadAddress: address},
+			}, nil
+		}
+	}
+	return &model.ProviderResult{Success: false}, nil
+}
+
+func (m *mockProvider) IsAvailable(ctx context.Context) bool { return m.available }
+func (m *mockProvider) Disable(reason string)                { m.available = false }
+func (m *mockProvider) Enable()                              { m.available = true }
+func (m *mockProvider) IsDisabled() bool                     { return !m.available }
+func (m *mockProvider) GetDisableReason() string             { return "" }
+
+// mockCoordinator implements service.CoordinatorInterface for testing
+type mockCoordinator struct {
+	svc      *service.GeocodingService
+	provider *mockProvider
+}
+
+func (m *mockCoordinator) HealthCheck(ctx context.Context) service.HealthStatus {
+	available := m.provider.IsAvailable(ctx)
+	return service.HealthStatus{
+		Healthy:   available,
+		Providers: []service.ProviderStatus{{Name: m.provider.Name(), Available: available}},
+	}
+}
+
+func (m *mockCoordinator) GetGeocodingService() *service.GeocodingService {
+	return m.svc
+}
+
+// newTestClient bufconn 리스너 위에서 서버를 띄우고 클라이언트를 반환
+func newTestClient(t *testing.T, p *mockProvider) geocodingv1.GeocodingServiceClient {
+	t.Helper()
+
+	coord := &mockCoordinator{
+		svc:      service.NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop()),
+		provider: p,
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	NewServer(coord, zap.NewNop()).Register(gs)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return geocodingv1.NewGeocodingServiceClient(conn)
+}
+
+func TestServer_Geocode(t *testing.T) {
+	client := newTestClient(t, &mockProvider{
+		available: true,
+		coordinates: map[string]model.Coordinate{
+			"서울특별시 중구 세종대로 110": {Latitude: 37.566535, Longitude: 126.977969},
+		},
+	})
+
+	resp, err := client.Geocode(context.Background(), &geocodingv1.GeocodeRequest{
+		Address: "서울특별시 중구 세종대로 110",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.GetSuccess())
+	assert.True(t, resp.GetCoordinateValid())
+	assert.Equal(t, "MockProvider", resp.GetResult().GetProvider())
+	assert.InDelta(t, 37.566535, resp.GetResult().GetLatitude(), 0.000001)
+	assert.InDelta(t, 126.977969, resp.GetResult().GetLongitude(), 0.000001)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", resp.GetResult().GetAddressDetail().GetRoadAddress())
+	assert.Equal(t, "ROOFTOP", resp.GetResult().GetMatchLevel())
+	require.Len(t, resp.GetResult().GetAttempts(), 1)
+	assert.True(t, resp.GetResult().GetAttempts()[0].GetSuccess())
+}
+
+func TestServer_Geocode_NoProvidersAvailable(t *testing.T) {
+	client := newTestClient(t, &mockProvider{available: false})
+
+	_, err := client.Geocode(context.Background(), &geocodingv1.GeocodeRequest{
+		Address: "서울특별시 중구 세종대로 110",
+	})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_GeocodeBatch_Streams(t *testing.T) {
+	client := newTestClient(t, &mockProvider{
+		available: true,
+		coordinates: map[string]model.Coordinate{
+			"서울특별시 중구 세종대로 110":   {Latitude: 37.566535, Longitude: 126.977969},
+			"부산광역시 해운대구 해운대로 264": {Latitude: 35.163310, Longitude: 129.163580},
+		},
+	})
+
+	addresses := []string{
+		"서울특별시 중구 세종대로 110",
+		"없는 주소 123번지",
+		"부산광역시 해운대구 해운대로 264",
+	}
+	stream, err := client.GeocodeBatch(context.Background(), &geocodingv1.GeocodeBatchRequest{
+		Addresses: addresses,
+	})
+	require.NoError(t, err)
+
+	items := make(map[int32]*geocodingv1.BatchItem)
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		items[item.GetIndex()] = item
+	}
+
+	require.Len(t, items, len(addresses))
+	for i, addr := range addresses {
+		assert.Equal(t, addr, items[int32(i)].GetAddress())
+	}
+	assert.True(t, items[0].GetResponse().GetSuccess())
+	assert.False(t, items[1].GetResponse().GetSuccess())
+	assert.True(t, items[2].GetResponse().GetSuccess())
+	assert.InDelta(t, 35.163310, items[2].GetResponse().GetResult().GetLatitude(), 0.000001)
+}
+
+func TestServer_GeocodeBatch_TooMany(t *testing.T) {
+	client := newTestClient(t, &mockProvider{available: true})
+
+	addresses := make([]string, maxBatchSize+1)
+	for i := range addresses {
+		addresses[i] = "서울특별시 중구 세종대로 110"
+	}
+	stream, err := client.GeocodeBatch(context.Background(), &geocodingv1.GeocodeBatchRequest{
+		Addresses: addresses,
+	})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ReverseGeocode(t *testing.T) {
+	client := newTestClient(t, &mockProvider{
+		available: true,
+		coordinates: map[string]model.Coordinate{
+			"서울특별시 중구 세종대로 110": {Latitude: 37.566535, Longitude: 126.977969},
+		},
+	})
+
+	resp, err := client.ReverseGeocode(context.Background(), &geocodingv1.ReverseGeocodeRequest{
+		Latitude:  37.566535,
+		Longitude: 126.977969,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.GetSuccess())
+	assert.Equal(t, "서울특별시 중구 세종대로 110", resp.GetResult().GetAddressDetail().GetRoadAddress())
+
+	resp, err = client.ReverseGeocode(context.Background(), &geocodingv1.ReverseGeocodeRequest{
+		Latitude:  35.1796,
+		Longitude: 129.0756,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.GetSuccess())
+}
+
+func TestServer_HealthCheck(t *testing.T) {
+	client := newTestClient(t, &mockProvider{available: true})
+
+	resp, err := client.HealthCheck(context.Background(), &geocodingv1.HealthCheckRequest{})
+
+	require.NoError(t, err)
+	assert.True(t, resp.GetHealthy())
+	require.Len(t, resp.GetProviders(), 1)
+	assert.Equal(t, "MockProvider", resp.GetProviders()[0].GetName())
+	assert.True(t, resp.GetProviders()[0].GetAvailable())
+}
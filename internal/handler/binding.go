@@ -0,0 +1,197 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// invalidRequestError 필드 단위로 설명할 수 있는 요청 형식 오류
+type invalidRequestError struct {
+	fields []model.FieldError
+}
+
+func (e *invalidRequestError) Error() string {
+	parts := make([]string, len(e.fields))
+	for i, f := range e.fields {
+		parts[i] = fmt.Sprintf("%s: %s", f.Field, f.Reason)
+	}
+	return "invalid request: " + strings.Join(parts, ", ")
+}
+
+// bindJSON 요청 본문을 obj로 엄격하게 파싱하고 binding 태그로 검증
+// ShouldBindJSON과 달리 정의되지 않은 필드와 뒤에 붙은 추가 값을 거부하며,
+// 필드 단위로 설명할 수 있는 오류는 *invalidRequestError로 반환한다
+func bindJSON(c *gin.Context, obj any) error {
+	if c.Request.Body == nil {
+		return errors.New("missing request body")
+	}
+	return decodeJSON(c.Request.Body, obj)
+}
+
+// decodeJSON r의 JSON 값 하나를 bindJSON과 같은 규칙으로 파싱하고 검증 (ndjson 줄 단위 파싱에도 사용)
+func decodeJSON(r io.Reader, obj any) error {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		return decodeError(err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("request body must contain a single JSON object")
+	}
+
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		var verrs validator.ValidationErrors
+		if errors.As(err, &verrs) {
+			return validationError(reflect.TypeOf(obj), verrs)
+		}
+		return err
+	}
+
+	// 주소 타입은 binding 태그 대신 서비스와 같은 규칙으로 검증하고 대문자로 정규화
+	switch req := obj.(type) {
+	case *model.GeocodingRequest:
+		return normalizeAddressType(&req.AddressType)
+	case *model.BulkRequest:
+		return normalizeAddressType(&req.AddressType)
+	}
+	return nil
+}
+
+// normalizeAddressType 요청의 address_type 값을 utils.NormalizeAddressType으로 정규화 (예: " road " → "ROAD")
+// 허용하지 않는 값이면 address_type 필드 오류를 반환한다
+func normalizeAddressType(addressType *string) error {
+	normalized, err := utils.NormalizeAddressType(*addressType)
+	if err != nil {
+		return &invalidRequestError{fields: []model.FieldError{{
+			Field:  "address_type",
+			Reason: fmt.Sprintf("must be %s or %s (case-insensitive)", utils.AddressTypeRoad, utils.AddressTypeParcel),
+		}}}
+	}
+	*addressType = string(normalized)
+	return nil
+}
+
+// decodeError JSON 디코딩 오류 중 필드를 특정할 수 있는 경우를 invalidRequestError로 변환
+func decodeError(err error) error {
+	// encoding/json은 알 수 없는 필드를 `json: unknown field "name"` 형식의 일반 에러로 반환
+	if name, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return &invalidRequestError{fields: []model.FieldError{{
+			Field:  strings.Trim(name, `"`),
+			Reason: "unknown field",
+		}}}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return &invalidRequestError{fields: []model.FieldError{{
+			Field:  typeErr.Field,
+			Reason: fmt.Sprintf("must be %s, got %s", jsonTypeName(typeErr.Type), typeErr.Value),
+		}}}
+	}
+
+	return err
+}
+
+// validationError 검증 실패 내역을 JSON 필드 경로와 사유로 변환
+func validationError(t reflect.Type, verrs validator.ValidationErrors) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	fields := make([]model.FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fields = append(fields, model.FieldError{
+			Field:  jsonFieldPath(t, fe.StructField()),
+			Reason: validationReason(fe),
+		})
+	}
+	return &invalidRequestError{fields: fields}
+}
+
+// jsonFieldPath 구조체 필드 이름(예: "Addresses[3]")을 JSON 경로(예: "addresses[3]")로 변환
+func jsonFieldPath(t reflect.Type, structField string) string {
+	name, index, _ := strings.Cut(structField, "[")
+	if index != "" {
+		index = "[" + index
+	}
+
+	if sf, ok := t.FieldByName(name); ok {
+		if tag, _, _ := strings.Cut(sf.Tag.Get("json"), ","); tag != "" && tag != "-" {
+			name = tag
+		}
+	}
+	return name + index
+}
+
+// validationReason binding 태그 검증 실패 사유 문구
+func validationReason(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "max":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must contain at most %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s characters", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.Join(strings.Fields(fe.Param()), ", "))
+	default:
+		return fmt.Sprintf("failed %q validation", fe.Tag())
+	}
+}
+
+// jsonTypeName Go 타입에 대응하는 JSON 타입 이름
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "a number"
+	default:
+		return t.String()
+	}
+}
+
+// respondInvalidRequest 요청 형식 오류를 400 에러 응답으로 전송 (필드 단위 오류는 fields에 포함)
+func respondInvalidRequest(c *gin.Context, err error) {
+	body := model.NewErrorResponse(model.ErrorCodeInvalidRequest, "invalid request format", c.GetString("requestID"))
+	var reqErr *invalidRequestError
+	if errors.As(err, &reqErr) {
+		body.Error.Fields = reqErr.fields
+	}
+	c.JSON(http.StatusBadRequest, body)
+}
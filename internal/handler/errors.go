@@ -0,0 +1,54 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondError 공통 에러 응답 전송
+func respondError(c *gin.Context, status int, code, message string) {
+	c.JSON(status, model.NewErrorResponse(code, message, c.GetString("requestID")))
+}
+
+// respondServiceError 서비스 에러를 상태 코드와 에러 코드로 변환해 응답
+func respondServiceError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrNoProvidersAvailable) {
+		respondError(c, http.StatusServiceUnavailable, model.ErrorCodeProvidersUnavailable, "no geocoding providers available")
+		return
+	}
+	respondError(c, http.StatusInternalServerError, model.ErrorCodeInternal, "internal server error")
+}
+
+// respondGeocodeFailure 지오코딩 실패 결과를 404 에러 응답으로 전송 (시도 내역은 result에 유지)
+func respondGeocodeFailure(c *gin.Context, resp *model.GeocodingResponse) {
+	body := model.NewErrorResponse(failureCode(resp), resp.Error, c.GetString("requestID"))
+	body.Result = resp
+	c.JSON(http.StatusNotFound, body)
+}
+
+// failureCode 실패한 지오코딩 응답의 에러 코드 (없으면 ADDRESS_NOT_FOUND)
+func failureCode(resp *model.GeocodingResponse) string {
+	if resp.ErrorCode == "" {
+		return model.ErrorCodeAddressNotFound
+	}
+	return resp.ErrorCode
+}
@@ -15,9 +15,14 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 	
+	"github.com/oursportsnation/k-geocode/internal/middleware"
 	"github.com/oursportsnation/k-geocode/internal/model"
 	"github.com/oursportsnation/k-geocode/internal/service"
 	
@@ -27,18 +32,88 @@ import (
 
 // GeocodingHandler 지오코딩 API 핸들러
 type GeocodingHandler struct {
-	service service.GeocodingServiceInterface
-	logger  *zap.Logger
+	service        service.GeocodingServiceInterface
+	logger         *zap.Logger
+	requestTimeout time.Duration // 요청별 처리 제한 시간 (0이면 제한 없음)
 }
 
-// NewGeocodingHandler 지오코딩 핸들러 생성자
+// NewGeocodingHandler 지오코딩 핸들러 생성자 (요청 처리 시간 제한 없음)
 func NewGeocodingHandler(service service.GeocodingServiceInterface, logger *zap.Logger) *GeocodingHandler {
+	return NewGeocodingHandlerWithTimeout(service, logger, 0)
+}
+
+// NewGeocodingHandlerWithTimeout 요청 처리 제한 시간을 지정한 지오코딩 핸들러 생성자
+// 제한 시간을 넘긴 요청은 504 Gateway Timeout (TIMEOUT)으로 응답한다
+func NewGeocodingHandlerWithTimeout(service service.GeocodingServiceInterface, logger *zap.Logger, requestTimeout time.Duration) *GeocodingHandler {
 	return &GeocodingHandler{
-		service: service,
-		logger:  logger,
+		service:        service,
+		logger:         logger,
+		requestTimeout: requestTimeout,
+	}
+}
+
+// requestContext 요청 컨텍스트에 요청 처리 제한 시간 적용
+// refresh면 캐시를 조회하지 않고 Provider를 다시 호출해 캐시를 새 결과로 덮어쓴다
+func (h *GeocodingHandler) requestContext(c *gin.Context, refresh bool) (context.Context, context.CancelFunc) {
+	ctx := c.Request.Context()
+	if refresh {
+		ctx = service.WithSkipCache(ctx)
+	}
+	if h.requestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, h.requestTimeout)
+}
+
+// respondIfTimedOut 요청 처리 제한 시간이 지났으면 504 응답 후 true 반환
+func (h *GeocodingHandler) respondIfTimedOut(ctx context.Context, c *gin.Context, requestID string) bool {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return false
+	}
+	h.logger.Warn("Geocoding request timed out",
+		zap.String("request_id", requestID),
+		zap.Duration("timeout", h.requestTimeout),
+	)
+	respondError(c, http.StatusGatewayTimeout, model.ErrorCodeTimeout, "request timed out")
+	return true
+}
+
+// fieldsCoord ?fields=coord 값 (좌표와 Provider만 담은 축약 응답)
+const fieldsCoord = "coord"
+
+// coordOnly 축약 응답(?fields=coord) 요청 여부 확인
+// 지원하지 않는 fields 값이면 400 응답 후 ok=false를 반환한다
+func coordOnly(c *gin.Context) (coord, ok bool) {
+	switch fields := c.Query("fields"); fields {
+	case "":
+		return false, true
+	case fieldsCoord:
+		return true, true
+	default:
+		respondError(c, http.StatusBadRequest, model.ErrorCodeInvalidRequest, "unsupported fields value (supported: coord)")
+		return false, false
 	}
 }
 
+// cacheRefresh 캐시 갱신 요청 여부 확인 (Cache-Control: no-cache 헤더 또는 ?refresh=true)
+// refresh 값이 불리언이 아니면 400 응답 후 ok=false를 반환한다
+func cacheRefresh(c *gin.Context) (refresh, ok bool) {
+	for _, directive := range strings.Split(c.GetHeader("Cache-Control"), ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+			refresh = true
+		}
+	}
+	if value := c.Query("refresh"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, model.ErrorCodeInvalidRequest, "refresh must be true or false")
+			return false, false
+		}
+		refresh = refresh || parsed
+	}
+	return refresh, true
+}
+
 // Geocode 단건 지오코딩 API
 // @Summary      주소를 좌표로 변환
 // @Description  한글 주소를 WGS84 좌표로 변환합니다. vWorld API를 우선 사용하고 실패 시 Kakao API로 자동 폴백됩니다.
@@ -47,27 +122,46 @@ func NewGeocodingHandler(service service.GeocodingServiceInterface, logger *zap.
 // @Accept       json
 // @Produce      json
 // @Param        request body model.GeocodingRequest true "지오코딩 요청 (address_type은 선택사항: ROAD 또는 PARCEL)"
+// @Param        refresh query bool false "true면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀 (Cache-Control: no-cache 헤더와 같음)"
+// @Param        Cache-Control header string false "no-cache면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀"
+// @Param        fields query string false "coord면 좌표와 Provider만 응답 (model.CoordinateOnlyResponse, 실패 시 result 생략)" Enums(coord)
 // @Success      200 {object} model.GeocodingResponse "변환 성공"
-// @Success      404 {object} model.GeocodingResponse "주소를 찾을 수 없음"
-// @Failure      400 {object} map[string]string "잘못된 요청"
-// @Failure      500 {object} map[string]string "서버 에러"
+// @Failure      404 {object} model.ErrorResponse "주소를 찾을 수 없음 (ADDRESS_NOT_FOUND 등, result에 시도 내역 포함)"
+// @Failure      400 {object} model.ErrorResponse "잘못된 요청 (INVALID_REQUEST)"
+// @Failure      413 {object} model.ErrorResponse "요청 본문 크기 초과 (REQUEST_TOO_LARGE)"
+// @Failure      500 {object} model.ErrorResponse "서버 에러 (INTERNAL_ERROR)"
+// @Failure      503 {object} model.ErrorResponse "사용 가능한 Provider 없음 (PROVIDERS_UNAVAILABLE)"
+// @Failure      504 {object} model.ErrorResponse "요청 처리 시간 초과 (TIMEOUT)"
 // @Router       /api/v1/geocode [post]
 func (h *GeocodingHandler) Geocode(c *gin.Context) {
 	start := time.Now()
 	
 	// Request ID 가져오기 (미들웨어에서 설정)
 	requestID := c.GetString("requestID")
+	coord, ok := coordOnly(c)
+	if !ok {
+		return
+	}
+	refresh, ok := cacheRefresh(c)
+	if !ok {
+		return
+	}
 	
 	// 요청 파싱
 	var req model.GeocodingRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := bindJSON(c, &req); err != nil {
+		if middleware.IsBodyTooLarge(err) {
+			h.logger.Warn("Request body too large",
+				zap.String("request_id", requestID),
+			)
+			middleware.AbortBodyTooLarge(c)
+			return
+		}
 		h.logger.Warn("Invalid request format",
 			zap.String("request_id", requestID),
 			zap.Error(err),
 		)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "invalid request format",
-		})
+		respondInvalidRequest(c, err)
 		return
 	}
 	
@@ -77,16 +171,28 @@ func (h *GeocodingHandler) Geocode(c *gin.Context) {
 		zap.String("address_type", req.AddressType),
 	)
 
-	// 지오코딩 서비스 호출
-	resp, err := h.service.Geocode(c.Request.Context(), req.Address, req.AddressType)
+	// 지오코딩 서비스 호출 (요청 처리 제한 시간 적용)
+	ctx, cancel := h.requestContext(c, refresh)
+	defer cancel()
+	resp, err := h.service.Geocode(ctx, req.Address, req.AddressType)
+	// 제한 시간 직전에 성공한 결과는 버리지 않고 그대로 응답
+	succeeded := err == nil && resp.Success
+	if !succeeded && h.respondIfTimedOut(ctx, c, requestID) {
+		return
+	}
+	if errors.Is(err, service.ErrNoProvidersAvailable) {
+		h.logger.Error("No geocoding providers available",
+			zap.String("request_id", requestID),
+		)
+		respondServiceError(c, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error("Geocoding service error",
 			zap.String("request_id", requestID),
 			zap.Error(err),
 		)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "internal server error",
-		})
+		respondServiceError(c, err)
 		return
 	}
 	
@@ -98,40 +204,68 @@ func (h *GeocodingHandler) Geocode(c *gin.Context) {
 		zap.Duration("duration", time.Since(start)),
 	)
 	
-	// 성공/실패에 따른 상태 코드 설정
-	statusCode := http.StatusOK
+	// 실패 시 404 에러 응답 (에러 코드로 원인 구분)
 	if !resp.Success {
-		statusCode = http.StatusNotFound
+		if coord {
+			respondError(c, http.StatusNotFound, failureCode(resp), resp.Error)
+			return
+		}
+		respondGeocodeFailure(c, resp)
+		return
 	}
 	
-	c.JSON(statusCode, resp)
+	if coord {
+		c.JSON(http.StatusOK, model.NewCoordinateOnlyResponse(resp))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
 }
 
 // GeocodeBulk 대량 지오코딩 API
 // @Summary      여러 주소를 좌표로 변환
 // @Description  여러 한글 주소를 WGS84 좌표로 변환합니다. 최대 100개까지 처리 가능하며, 최대 10개씩 동시 처리됩니다.
+// @Description  address_type을 지정하면 모든 주소를 해당 타입(ROAD/PARCEL)으로만 검색합니다.
 // @Tags         geocoding
 // @Accept       json
 // @Produce      json
-// @Param        request body model.BulkRequest true "대량 지오코딩 요청 (최대 100개)"
+// @Param        request body model.BulkRequest true "대량 지오코딩 요청 (최대 100개, address_type은 선택사항: ROAD 또는 PARCEL)"
+// @Param        refresh query bool false "true면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀 (Cache-Control: no-cache 헤더와 같음)"
+// @Param        Cache-Control header string false "no-cache면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀"
+// @Param        fields query string false "coord면 항목마다 좌표와 Provider만 응답 (model.CoordinateOnlyBulkResponse)" Enums(coord)
 // @Success      200 {object} model.BulkResponse "변환 결과"
-// @Failure      400 {object} map[string]string "잘못된 요청 (빈 배열 또는 100개 초과)"
-// @Failure      500 {object} map[string]string "서버 에러"
+// @Failure      400 {object} model.ErrorResponse "잘못된 요청 (INVALID_REQUEST, TOO_MANY_ADDRESSES)"
+// @Failure      413 {object} model.ErrorResponse "요청 본문 크기 초과 (REQUEST_TOO_LARGE)"
+// @Failure      500 {object} model.ErrorResponse "서버 에러 (INTERNAL_ERROR)"
+// @Failure      503 {object} model.ErrorResponse "사용 가능한 Provider 없음 (PROVIDERS_UNAVAILABLE)"
+// @Failure      504 {object} model.ErrorResponse "요청 처리 시간 초과 (TIMEOUT)"
 // @Router       /api/v1/geocode/bulk [post]
 func (h *GeocodingHandler) GeocodeBulk(c *gin.Context) {
 	start := time.Now()
 	requestID := c.GetString("requestID")
+	coord, ok := coordOnly(c)
+	if !ok {
+		return
+	}
+	refresh, ok := cacheRefresh(c)
+	if !ok {
+		return
+	}
 	
 	// 요청 파싱
 	var req model.BulkRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := bindJSON(c, &req); err != nil {
+		if middleware.IsBodyTooLarge(err) {
+			h.logger.Warn("Request body too large",
+				zap.String("request_id", requestID),
+			)
+			middleware.AbortBodyTooLarge(c)
+			return
+		}
 		h.logger.Warn("Invalid bulk request format",
 			zap.String("request_id", requestID),
 			zap.Error(err),
 		)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "invalid request format",
-		})
+		respondInvalidRequest(c, err)
 		return
 	}
 	
@@ -141,27 +275,38 @@ func (h *GeocodingHandler) GeocodeBulk(c *gin.Context) {
 			zap.String("request_id", requestID),
 			zap.Int("count", len(req.Addresses)),
 		)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "maximum 100 addresses allowed",
-		})
+		respondError(c, http.StatusBadRequest, model.ErrorCodeTooManyAddresses, "maximum 100 addresses allowed")
 		return
 	}
 	
 	h.logger.Info("Bulk geocoding request received",
 		zap.String("request_id", requestID),
 		zap.Int("address_count", len(req.Addresses)),
+		zap.String("address_type", req.AddressType),
 	)
 	
-	// 배치 지오코딩 서비스 호출
-	resp, err := h.service.GeocodeBatch(c.Request.Context(), req.Addresses)
+	// 배치 지오코딩 서비스 호출 (요청 처리 제한 시간 적용, 초과 시 부분 결과 대신 504 응답)
+	ctx, cancel := h.requestContext(c, refresh)
+	defer cancel()
+	resp, err := h.service.GeocodeBatch(ctx, req.Addresses, req.AddressType)
+	// 제한 시간 직전에 모든 주소가 성공했으면 부분 결과가 아니므로 그대로 응답
+	succeeded := err == nil && resp.Summary.Failed == 0
+	if !succeeded && h.respondIfTimedOut(ctx, c, requestID) {
+		return
+	}
+	if errors.Is(err, service.ErrNoProvidersAvailable) {
+		h.logger.Error("No geocoding providers available",
+			zap.String("request_id", requestID),
+		)
+		respondServiceError(c, err)
+		return
+	}
 	if err != nil {
 		h.logger.Error("Bulk geocoding service error",
 			zap.String("request_id", requestID),
 			zap.Error(err),
 		)
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "internal server error",
-		})
+		respondServiceError(c, err)
 		return
 	}
 	
@@ -173,5 +318,155 @@ func (h *GeocodingHandler) GeocodeBulk(c *gin.Context) {
 		zap.Duration("duration", time.Since(start)),
 	)
 	
+	if coord {
+		c.JSON(http.StatusOK, model.NewCoordinateOnlyBulkResponse(resp))
+		return
+	}
 	c.JSON(http.StatusOK, resp)
-}
\ No newline at end of file
+}
+
+// GeocodeBulkStream 대량 지오코딩 진행 상황 스트리밍 API (Server-Sent Events)
+// @Summary      여러 주소를 좌표로 변환 (진행 상황 스트리밍)
+// @Description  /api/v1/geocode/bulk와 같은 요청 본문을 받아 text/event-stream으로 응답합니다.
+// @Description  주소 하나가 끝날 때마다 "result" 이벤트(model.BulkStreamItem, 완료 순서)를, 마지막에 "summary" 이벤트(model.BulkStreamSummary)를 보냅니다.
+// @Description  클라이언트 연결이 끊기면 남은 주소 처리를 중단합니다. 스트리밍 도중 처리 시간이 초과되면 "error" 이벤트(model.ErrorResponse)로 끝납니다.
+// @Tags         geocoding
+// @Accept       json
+// @Produce      text/event-stream
+// @Param        request body model.BulkRequest true "대량 지오코딩 요청 (최대 100개, address_type은 선택사항: ROAD 또는 PARCEL)"
+// @Param        refresh query bool false "true면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀 (Cache-Control: no-cache 헤더와 같음)"
+// @Param        Cache-Control header string false "no-cache면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀"
+// @Success      200 {object} model.BulkStreamItem "result 이벤트 (마지막은 summary 이벤트)"
+// @Failure      400 {object} model.ErrorResponse "잘못된 요청 (INVALID_REQUEST, TOO_MANY_ADDRESSES)"
+// @Failure      413 {object} model.ErrorResponse "요청 본문 크기 초과 (REQUEST_TOO_LARGE)"
+// @Failure      500 {object} model.ErrorResponse "서버 에러 (INTERNAL_ERROR)"
+// @Failure      503 {object} model.ErrorResponse "사용 가능한 Provider 없음 (PROVIDERS_UNAVAILABLE)"
+// @Failure      504 {object} model.ErrorResponse "요청 처리 시간 초과 (TIMEOUT, 첫 이벤트 전에 초과한 경우)"
+// @Router       /api/v1/geocode/bulk/stream [post]
+func (h *GeocodingHandler) GeocodeBulkStream(c *gin.Context) {
+	start := time.Now()
+	requestID := c.GetString("requestID")
+	refresh, ok := cacheRefresh(c)
+	if !ok {
+		return
+	}
+
+	// 요청 파싱
+	var req model.BulkRequest
+	if err := bindJSON(c, &req); err != nil {
+		if middleware.IsBodyTooLarge(err) {
+			h.logger.Warn("Request body too large",
+				zap.String("request_id", requestID),
+			)
+			middleware.AbortBodyTooLarge(c)
+			return
+		}
+		h.logger.Warn("Invalid bulk stream request format",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	// 최대 개수 검증
+	if len(req.Addresses) > 100 {
+		h.logger.Warn("Too many addresses in bulk stream request",
+			zap.String("request_id", requestID),
+			zap.Int("count", len(req.Addresses)),
+		)
+		respondError(c, http.StatusBadRequest, model.ErrorCodeTooManyAddresses, "maximum 100 addresses allowed")
+		return
+	}
+
+	h.logger.Info("Bulk geocoding stream request received",
+		zap.String("request_id", requestID),
+		zap.Int("address_count", len(req.Addresses)),
+		zap.String("address_type", req.AddressType),
+	)
+
+	// 요청 컨텍스트는 클라이언트 연결이 끊기면 취소되어 남은 주소 처리가 중단된다
+	ctx, cancel := h.requestContext(c, refresh)
+	defer cancel()
+
+	summary := model.BulkStreamSummary{Total: len(req.Addresses)}
+	streaming := false
+	startStream := func() {
+		if streaming {
+			return
+		}
+		streaming = true
+		c.Header("Cache-Control", "no-cache") // Content-Type은 c.SSEvent가 설정
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no") // 프록시 버퍼링 방지
+		c.Status(http.StatusOK)
+	}
+
+	opts := service.BatchOptions{AddressType: req.AddressType}
+	err := h.service.GeocodeBatchStream(ctx, req.Addresses, opts, func(index int, result *model.GeocodingResponse) error {
+		// 연결이 끊겼거나 시간이 초과되면 더 보내지 않음
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		startStream()
+		if result.Success {
+			summary.Success++
+		} else {
+			summary.Failed++
+		}
+		c.SSEvent("result", model.BulkStreamItem{
+			Index:   index,
+			Address: req.Addresses[index],
+			Result:  result,
+		})
+		c.Writer.Flush()
+		return nil
+	})
+
+	// 첫 이벤트 전에 실패하면 일반 JSON 에러 응답
+	if !streaming {
+		if h.respondIfTimedOut(ctx, c, requestID) {
+			return
+		}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			h.logger.Error("Bulk geocoding stream service error",
+				zap.String("request_id", requestID),
+				zap.Error(err),
+			)
+			respondServiceError(c, err)
+			return
+		}
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		h.logger.Warn("Bulk geocoding stream timed out",
+			zap.String("request_id", requestID),
+			zap.Int("sent", summary.Success+summary.Failed),
+			zap.Duration("timeout", h.requestTimeout),
+		)
+		c.SSEvent("error", model.NewErrorResponse(model.ErrorCodeTimeout, "request timed out", requestID))
+		c.Writer.Flush()
+		return
+	case ctx.Err() != nil:
+		h.logger.Info("Bulk geocoding stream client disconnected",
+			zap.String("request_id", requestID),
+			zap.Int("sent", summary.Success+summary.Failed),
+			zap.Int("total", summary.Total),
+		)
+		return
+	}
+
+	startStream()
+	summary.ProcessingTimeMs = time.Since(start).Milliseconds()
+	c.SSEvent("summary", summary)
+	c.Writer.Flush()
+
+	h.logger.Info("Bulk geocoding stream completed",
+		zap.String("request_id", requestID),
+		zap.Int("total", summary.Total),
+		zap.Int("success", summary.Success),
+		zap.Int("failed", summary.Failed),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
@@ -1,16 +1,27 @@
 package handler
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/oursportsnation/k-geocode/internal/cache"
+	"github.com/oursportsnation/k-geocode/internal/middleware"
 	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -22,21 +33,48 @@ type mockGeocodingService struct {
 	geocodeErr    error
 	batchResult   *model.BulkResponse
 	batchErr      error
+
+	batchAddressType string // 마지막 GeocodeBatch 호출의 주소 타입
+
+	streamErr error // GeocodeBatchStream이 결과 전송 전에 반환할 에러
 }
 
 func (m *mockGeocodingService) Geocode(ctx context.Context, address string, addressType string) (*model.GeocodingResponse, error) {
 	return m.geocodeResult, m.geocodeErr
 }
 
-func (m *mockGeocodingService) GeocodeBatch(ctx context.Context, addresses []string) (*model.BulkResponse, error) {
+func (m *mockGeocodingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	m.batchAddressType = addressType
 	return m.batchResult, m.batchErr
 }
 
+// GeocodeBatchStream 주소마다 geocodeResult를 인덱스 순서대로 전송
+func (m *mockGeocodingService) GeocodeBatchStream(ctx context.Context, addresses []string, opts service.BatchOptions, send func(index int, result *model.GeocodingResponse) error) error {
+	m.batchAddressType = opts.AddressType
+	if m.streamErr != nil {
+		return m.streamErr
+	}
+	for i := range addresses {
+		if err := send(i, m.geocodeResult); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
 }
 
+// decodeErrorResponse 에러 응답 본문 파싱
+func decodeErrorResponse(t *testing.T, w *httptest.ResponseRecorder) model.ErrorResponse {
+	t.Helper()
+	var resp model.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
 func TestNewGeocodingHandler(t *testing.T) {
 	logger := zap.NewNop()
 	mockService := &mockGeocodingService{}
@@ -103,6 +141,43 @@ func TestGeocodingHandler_Geocode_NotFound(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	resp := decodeErrorResponse(t, w)
+	assert.Equal(t, model.ErrorCodeAddressNotFound, resp.Error.Code)
+	assert.Equal(t, "address not found", resp.Error.Message)
+	require.NotNil(t, resp.Result)
+	assert.Equal(t, "none", resp.Result.Provider)
+}
+
+func TestGeocodingHandler_Geocode_FailureErrorCode(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{
+		geocodeResult: &model.GeocodingResponse{
+			Success:   false,
+			Provider:  "none",
+			Error:     "coordinates outside Korea",
+			ErrorCode: model.ErrorCodeOutsideKorea,
+		},
+	}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.Use(middleware.RequestID())
+	router.POST("/geocode", handler.Geocode)
+
+	body := `{"address": "서울특별시 중구 세종대로 110"}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "req-123")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	resp := decodeErrorResponse(t, w)
+	assert.Equal(t, model.ErrorCodeOutsideKorea, resp.Error.Code)
+	assert.Equal(t, "req-123", resp.Error.RequestID)
 }
 
 func TestGeocodingHandler_Geocode_InvalidRequest(t *testing.T) {
@@ -122,6 +197,83 @@ func TestGeocodingHandler_Geocode_InvalidRequest(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_Geocode_FieldErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		fields []model.FieldError
+	}{
+		{
+			name:   "unknown field",
+			body:   `{"address": "서울특별시 중구 세종대로 110", "adress_type": "ROAD"}`,
+			fields: []model.FieldError{{Field: "adress_type", Reason: "unknown field"}},
+		},
+		{
+			name:   "address too long",
+			body:   fmt.Sprintf(`{"address": %q}`, strings.Repeat("가", model.MaxAddressLength+1)),
+			fields: []model.FieldError{{Field: "address", Reason: "must be at most 200 characters"}},
+		},
+		{
+			name:   "missing address",
+			body:   `{"address_type": "ROAD"}`,
+			fields: []model.FieldError{{Field: "address", Reason: "is required"}},
+		},
+		{
+			name:   "invalid address type",
+			body:   `{"address": "서울특별시 중구 세종대로 110", "address_type": "ZIPCODE"}`,
+			fields: []model.FieldError{{Field: "address_type", Reason: "must be ROAD or PARCEL (case-insensitive)"}},
+		},
+		{
+			name:   "wrong type",
+			body:   `{"address": 110}`,
+			fields: []model.FieldError{{Field: "address", Reason: "must be a string, got number"}},
+		},
+		{
+			name: "trailing data",
+			body: `{"address": "서울특별시 중구 세종대로 110"} {}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewGeocodingHandler(&mockGeocodingService{}, zap.NewNop())
+			router := setupTestRouter()
+			router.POST("/geocode", handler.Geocode)
+
+			req := httptest.NewRequest(http.MethodPost, "/geocode", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			resp := decodeErrorResponse(t, w)
+			assert.Equal(t, model.ErrorCodeInvalidRequest, resp.Error.Code)
+			assert.Equal(t, tt.fields, resp.Error.Fields)
+		})
+	}
+}
+
+func TestGeocodingHandler_Geocode_MaxLengthAddress(t *testing.T) {
+	mockService := &mockGeocodingService{
+		geocodeResult: &model.GeocodingResponse{Success: true, Provider: "vWorld"},
+	}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+	router := setupTestRouter()
+	router.POST("/geocode", handler.Geocode)
+
+	// 한글도 바이트가 아닌 문자 수로 제한
+	body := fmt.Sprintf(`{"address": %q}`, strings.Repeat("가", model.MaxAddressLength))
+	req := httptest.NewRequest(http.MethodPost, "/geocode", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 }
 
 func TestGeocodingHandler_Geocode_ServiceError(t *testing.T) {
@@ -142,6 +294,7 @@ func TestGeocodingHandler_Geocode_ServiceError(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, model.ErrorCodeInternal, decodeErrorResponse(t, w).Error.Code)
 }
 
 func TestGeocodingHandler_GeocodeBulk_Success(t *testing.T) {
@@ -179,6 +332,150 @@ func TestGeocodingHandler_GeocodeBulk_Success(t *testing.T) {
 	assert.Equal(t, 2, resp.Summary.Total)
 }
 
+func TestGeocodingHandler_FieldsCoord(t *testing.T) {
+	mockService := &mockGeocodingService{
+		geocodeResult: &model.GeocodingResponse{
+			Success:       true,
+			Provider:      "vWorld",
+			Coordinate:    &model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+			AddressDetail: &model.AddressDetail{RoadAddress: "서울특별시 중구 세종대로 110"},
+			Attempts:      []model.ProviderAttempt{{Provider: "vWorld", Success: true}},
+		},
+		batchResult: &model.BulkResponse{
+			Results: []*model.GeocodingResponse{
+				{Success: true, Provider: "Kakao", Coordinate: &model.Coordinate{Latitude: 35.1587, Longitude: 129.1604}, MatchedAddress: "부산 해운대구"},
+				{Success: false, Error: "address not found", ErrorCode: model.ErrorCodeAddressNotFound},
+			},
+			Summary: model.BulkSummary{Total: 2, Success: 1, Failed: 1},
+		},
+	}
+	router := setupTestRouter()
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+	router.POST("/geocode", handler.Geocode)
+	router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("single", func(t *testing.T) {
+		w := post("/geocode?fields=coord", `{"address": "서울특별시 중구 세종대로 110"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"coordinate":{"latitude":37.5665,"longitude":126.978},"provider":"vWorld"}`, w.Body.String())
+	})
+
+	t.Run("bulk", func(t *testing.T) {
+		w := post("/geocode/bulk?fields=coord", `{"addresses": ["부산시 해운대구", "없는 주소"]}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{
+			"results": [
+				{"coordinate":{"latitude":35.1587,"longitude":129.1604},"provider":"Kakao"},
+				{"error_code":"ADDRESS_NOT_FOUND"}
+			],
+			"summary": {"total":2,"success":1,"failed":1}
+		}`, w.Body.String())
+	})
+
+	t.Run("default stays full", func(t *testing.T) {
+		w := post("/geocode", `{"address": "서울특별시 중구 세종대로 110"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp model.GeocodingResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.True(t, resp.Success)
+		require.NotNil(t, resp.AddressDetail)
+		assert.Equal(t, "서울특별시 중구 세종대로 110", resp.AddressDetail.RoadAddress)
+		assert.Len(t, resp.Attempts, 1)
+	})
+
+	t.Run("failure omits result", func(t *testing.T) {
+		mockService.geocodeResult = &model.GeocodingResponse{Success: false, Error: "address not found", Attempts: []model.ProviderAttempt{{Provider: "vWorld"}}}
+		w := post("/geocode?fields=coord", `{"address": "없는 주소"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		resp := decodeErrorResponse(t, w)
+		assert.Equal(t, model.ErrorCodeAddressNotFound, resp.Error.Code)
+		assert.Nil(t, resp.Result)
+	})
+
+	t.Run("unsupported value", func(t *testing.T) {
+		w := post("/geocode?fields=address", `{"address": "서울특별시 중구 세종대로 110"}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, model.ErrorCodeInvalidRequest, decodeErrorResponse(t, w).Error.Code)
+	})
+}
+
+func TestGeocodingHandler_GeocodeBulk_AddressType(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{
+		batchResult: &model.BulkResponse{Results: []*model.GeocodingResponse{}},
+	}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+	body := `{"addresses": ["서울특별시 중구 세종대로 110", "서울특별시 중구 태평로1가 31"], "address_type": "PARCEL"}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "PARCEL", mockService.batchAddressType)
+}
+
+func TestGeocodingHandler_GeocodeBulk_NormalizesAddressType(t *testing.T) {
+	for _, addressType := range []string{"parcel", "Parcel", " PARCEL "} {
+		t.Run(addressType, func(t *testing.T) {
+			mockService := &mockGeocodingService{
+				batchResult: &model.BulkResponse{Results: []*model.GeocodingResponse{}},
+			}
+			handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+			router := setupTestRouter()
+			router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+			body, _ := json.Marshal(map[string]any{"addresses": []string{"서울특별시 중구 태평로1가 31"}, "address_type": addressType})
+			req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "PARCEL", mockService.batchAddressType)
+		})
+	}
+}
+
+func TestGeocodingHandler_GeocodeBulk_InvalidAddressType(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+	body := `{"addresses": ["서울특별시 중구 세종대로 110"], "address_type": "ZIPCODE"}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, decodeErrorResponse(t, w).Error.Code)
+}
+
 func TestGeocodingHandler_GeocodeBulk_TooManyAddresses(t *testing.T) {
 	logger := zap.NewNop()
 	mockService := &mockGeocodingService{}
@@ -200,6 +497,7 @@ func TestGeocodingHandler_GeocodeBulk_TooManyAddresses(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, model.ErrorCodeTooManyAddresses, decodeErrorResponse(t, w).Error.Code)
 }
 
 func TestGeocodingHandler_GeocodeBulk_InvalidRequest(t *testing.T) {
@@ -218,6 +516,36 @@ func TestGeocodingHandler_GeocodeBulk_InvalidRequest(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_GeocodeBulk_FieldErrors(t *testing.T) {
+	handler := NewGeocodingHandler(&mockGeocodingService{}, zap.NewNop())
+	router := setupTestRouter()
+	router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+	body := fmt.Sprintf(`{"addresses": ["서울특별시 중구 세종대로 110", %q], "limit": 10}`, strings.Repeat("가", model.MaxAddressLength+1))
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	resp := decodeErrorResponse(t, w)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, resp.Error.Code)
+	assert.Equal(t, []model.FieldError{{Field: "limit", Reason: "unknown field"}}, resp.Error.Fields)
+
+	// 알 수 없는 필드가 없으면 주소별 길이 검증
+	body = fmt.Sprintf(`{"addresses": ["서울특별시 중구 세종대로 110", %q]}`, strings.Repeat("가", model.MaxAddressLength+1))
+	req = httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, []model.FieldError{{Field: "addresses[1]", Reason: "must be at most 200 characters"}}, decodeErrorResponse(t, w).Error.Fields)
 }
 
 func TestGeocodingHandler_GeocodeBulk_ServiceError(t *testing.T) {
@@ -238,4 +566,536 @@ func TestGeocodingHandler_GeocodeBulk_ServiceError(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, model.ErrorCodeInternal, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_Geocode_NoProvidersAvailable(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{
+		geocodeErr: service.ErrNoProvidersAvailable,
+	}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.POST("/geocode", handler.Geocode)
+
+	body := `{"address": "서울특별시 중구 세종대로 110"}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, model.ErrorCodeProvidersUnavailable, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_GeocodeBulk_NoProvidersAvailable(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{
+		batchErr: service.ErrNoProvidersAvailable,
+	}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+	body := `{"addresses": ["서울특별시 중구 세종대로 110"]}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, model.ErrorCodeProvidersUnavailable, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_GeocodeBulk_BodyTooLarge(t *testing.T) {
+	logger := zap.NewNop()
+	mockService := &mockGeocodingService{}
+	handler := NewGeocodingHandler(mockService, logger)
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk", middleware.BodyLimit(1024), handler.GeocodeBulk)
+
+	addresses := make([]string, 50)
+	for i := range addresses {
+		addresses[i] = "서울특별시 중구 세종대로 110"
+	}
+	bodyBytes, _ := json.Marshal(map[string][]string{"addresses": addresses})
+	require.Greater(t, len(bodyBytes), 1024)
+
+	// Content-Length를 모르는 스트리밍 본문 (읽는 도중 한도 초과)
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk", io.MultiReader(bytes.NewReader(bodyBytes)))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "request body too large")
+	assert.Equal(t, model.ErrorCodeRequestTooLarge, decodeErrorResponse(t, w).Error.Code)
+}
+
+// readSSEEvents SSE 응답 본문을 이벤트 이름과 data 목록으로 파싱
+func readSSEEvents(t *testing.T, body io.Reader) (names []string, data []string) {
+	t.Helper()
+	scanner := bufio.NewScanner(body)
+	var name string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimPrefix(line, "event:")
+		case strings.HasPrefix(line, "data:"):
+			names = append(names, name)
+			data = append(data, strings.TrimPrefix(line, "data:"))
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return names, data
+}
+
+func TestGeocodingHandler_GeocodeBulkStream(t *testing.T) {
+	mockService := &mockGeocodingService{
+		geocodeResult: &model.GeocodingResponse{
+			Success:    true,
+			Provider:   "vWorld",
+			Coordinate: &model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk/stream", handler.GeocodeBulkStream)
+
+	// 실제 연결로 읽어야 이벤트가 도착하는 대로 파싱된다
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	addresses := []string{"서울특별시 중구 세종대로 110", "서울특별시 강남구 테헤란로 152", "부산광역시 해운대구 해운대로 264"}
+	body, _ := json.Marshal(map[string]any{"addresses": addresses, "address_type": "ROAD"})
+	resp, err := http.Post(server.URL+"/geocode/bulk/stream", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	names, data := readSSEEvents(t, resp.Body)
+	require.Len(t, names, len(addresses)+1)
+
+	seen := map[int]bool{}
+	for i := 0; i < len(addresses); i++ {
+		assert.Equal(t, "result", names[i])
+		var item model.BulkStreamItem
+		require.NoError(t, json.Unmarshal([]byte(data[i]), &item))
+		assert.Equal(t, addresses[item.Index], item.Address)
+		assert.True(t, item.Result.Success)
+		seen[item.Index] = true
+	}
+	assert.Len(t, seen, len(addresses))
+
+	assert.Equal(t, "summary", names[len(addresses)])
+	var summary model.BulkStreamSummary
+	require.NoError(t, json.Unmarshal([]byte(data[len(addresses)]), &summary))
+	assert.Equal(t, len(addresses), summary.Total)
+	assert.Equal(t, len(addresses), summary.Success)
+	assert.Equal(t, 0, summary.Failed)
+	assert.Less(t, summary.ProcessingTimeMs, int64(10_000), "나노초가 아닌 밀리초")
+	assert.Equal(t, "ROAD", mockService.batchAddressType)
+}
+
+func TestGeocodingHandler_GeocodeBulkStream_NoProvidersAvailable(t *testing.T) {
+	mockService := &mockGeocodingService{streamErr: service.ErrNoProvidersAvailable}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk/stream", handler.GeocodeBulkStream)
+
+	body := `{"addresses": ["서울특별시 중구 세종대로 110"]}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk/stream", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	// 이벤트를 보내기 전의 실패는 일반 JSON 에러 응답
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, model.ErrorCodeProvidersUnavailable, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestGeocodingHandler_GeocodeBulkStream_ClientDisconnect(t *testing.T) {
+	mockService := &mockGeocodingService{
+		geocodeResult: &model.GeocodingResponse{Success: true, Provider: "vWorld"},
+	}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+	router := setupTestRouter()
+	router.POST("/geocode/bulk/stream", handler.GeocodeBulkStream)
+
+	// 이미 연결이 끊긴 요청
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	body := `{"addresses": ["서울특별시 중구 세종대로 110", "서울특별시 강남구 테헤란로 152"]}`
+	req := httptest.NewRequest(http.MethodPost, "/geocode/bulk/stream", bytes.NewBufferString(body)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	names, _ := readSSEEvents(t, w.Body)
+	assert.Empty(t, names)
+}
+
+// slowGeocodingService delay만큼 지연된 뒤 응답하는 서비스
+// 그사이 컨텍스트가 끝나면 실패로 응답하고, lateSuccess면 제한 시간이 지나도 성공으로 응답한다
+type slowGeocodingService struct {
+	delay       time.Duration
+	lateSuccess bool
+}
+
+func (s *slowGeocodingService) succeeded(ctx context.Context) bool {
+	return s.lateSuccess || ctx.Err() == nil
+}
+
+func (s *slowGeocodingService) Geocode(ctx context.Context, address string, addressType string) (*model.GeocodingResponse, error) {
+	time.Sleep(s.delay)
+	if !s.succeeded(ctx) {
+		return &model.GeocodingResponse{Success: false, Error: "context deadline exceeded", ErrorCode: model.ErrorCodeTimeout}, nil
+	}
+	return &model.GeocodingResponse{Success: true, Provider: "vWorld", Coordinate: &model.Coordinate{Latitude: 37.5665, Longitude: 126.978}}, nil
+}
+
+func (s *slowGeocodingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	time.Sleep(s.delay)
+	if !s.succeeded(ctx) {
+		return &model.BulkResponse{
+			Results: []*model.GeocodingResponse{{Success: false, ErrorCode: model.ErrorCodeTimeout}},
+			Summary: model.BulkSummary{Total: 1, Failed: 1},
+		}, nil
+	}
+	return &model.BulkResponse{
+		Results: []*model.GeocodingResponse{{Success: true}},
+		Summary: model.BulkSummary{Total: 1, Success: 1},
+	}, nil
+}
+
+func (s *slowGeocodingService) GeocodeBatchStream(ctx context.Context, addresses []string, opts service.BatchOptions, send func(index int, result *model.GeocodingResponse) error) error {
+	time.Sleep(s.delay)
+	return send(0, &model.GeocodingResponse{Success: true})
+}
+
+func TestGeocodingHandler_RequestTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"geocode", "/geocode", `{"address": "서울특별시 중구 세종대로 110"}`},
+		{"bulk", "/geocode/bulk", `{"addresses": ["서울특별시 중구 세종대로 110"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" times out", func(t *testing.T) {
+			handler := NewGeocodingHandlerWithTimeout(&slowGeocodingService{delay: 100 * time.Millisecond}, zap.NewNop(), 10*time.Millisecond)
+			router := setupTestRouter()
+			router.POST("/geocode", handler.Geocode)
+			router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+			assert.Equal(t, model.ErrorCodeTimeout, decodeErrorResponse(t, w).Error.Code)
+		})
+
+		t.Run(tt.name+" succeeds as the timeout expires", func(t *testing.T) {
+			// 제한 시간이 지난 뒤 돌아왔어도 성공한 결과는 504로 버리지 않음
+			handler := NewGeocodingHandlerWithTimeout(&slowGeocodingService{delay: 50 * time.Millisecond, lateSuccess: true}, zap.NewNop(), 10*time.Millisecond)
+			router := setupTestRouter()
+			router.POST("/geocode", handler.Geocode)
+			router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+
+		t.Run(tt.name+" within timeout", func(t *testing.T) {
+			handler := NewGeocodingHandlerWithTimeout(&slowGeocodingService{}, zap.NewNop(), time.Second)
+			router := setupTestRouter()
+			router.POST("/geocode", handler.Geocode)
+			router.POST("/geocode/bulk", handler.GeocodeBulk)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestGeocodingHandler_Geocode_PassesDeadline(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	mockService := &deadlineRecordingService{record: func(ctx context.Context) {
+		deadline, hasDeadline = ctx.Deadline()
+	}}
+	handler := NewGeocodingHandlerWithTimeout(mockService, zap.NewNop(), 5*time.Second)
+
+	router := setupTestRouter()
+	router.POST("/geocode", handler.Geocode)
+
+	req := httptest.NewRequest(http.MethodPost, "/geocode", bytes.NewBufferString(`{"address": "서울특별시 중구 세종대로 110"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(5*time.Second), deadline, time.Second)
+}
+
+// deadlineRecordingService 서비스에 전달된 컨텍스트를 기록
+type deadlineRecordingService struct {
+	record func(ctx context.Context)
+}
+
+func (s *deadlineRecordingService) Geocode(ctx context.Context, address string, addressType string) (*model.GeocodingResponse, error) {
+	s.record(ctx)
+	return &model.GeocodingResponse{Success: true}, nil
+}
+
+func (s *deadlineRecordingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	s.record(ctx)
+	return &model.BulkResponse{}, nil
+}
+
+func (s *deadlineRecordingService) GeocodeBatchStream(ctx context.Context, addresses []string, opts service.BatchOptions, send func(index int, result *model.GeocodingResponse) error) error {
+	s.record(ctx)
+	return nil
+}
+
+// echoGeocodingService 주소별 결과를 만들어 돌려주는 Mock (주소가 "없는주소"면 실패)
+type echoGeocodingService struct {
+	mockGeocodingService
+	calls map[string][]string // 주소 타입별 GeocodeBatch 호출 주소
+}
+
+func (s *echoGeocodingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	if s.calls == nil {
+		s.calls = map[string][]string{}
+	}
+	s.calls[addressType] = append(s.calls[addressType], addresses...)
+
+	resp := &model.BulkResponse{}
+	for _, address := range addresses {
+		if address == "없는주소" {
+			resp.Results = append(resp.Results, &model.GeocodingResponse{Success: false, Provider: "none", ErrorCode: model.ErrorCodeAddressNotFound})
+			continue
+		}
+		resp.Results = append(resp.Results, &model.GeocodingResponse{
+			Success:       true,
+			Provider:      "vWorld",
+			AddressDetail: &model.AddressDetail{RoadAddress: address},
+		})
+	}
+	return resp, nil
+}
+
+func TestGeocodingHandler_GeocodeNDJSON(t *testing.T) {
+	mockService := &echoGeocodingService{}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+	router := setupTestRouter()
+	router.POST("/geocode/ndjson", handler.GeocodeNDJSON)
+
+	body := strings.Join([]string{
+		`{"address": "서울특별시 중구 세종대로 110"}`,
+		`{"address": "서울특별시 강남구 역삼동 737", "address_type": "parcel"}`,
+		`not json`,
+		``,
+		`{"address": "없는주소"}`,
+		`{"addr": "서울특별시 중구 세종대로 110"}`,
+		`{"address": ""}`,
+		`{"address": "부산광역시 해운대구 해운대로 264"}`,
+	}, "\n")
+	req := httptest.NewRequest(http.MethodPost, "/geocode/ndjson", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	var results []model.NDJSONResult
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		var line model.NDJSONResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		results = append(results, line)
+	}
+	require.Len(t, results, 7, "빈 줄을 제외한 입력 줄마다 한 줄")
+
+	lines := make([]int, len(results))
+	for i, r := range results {
+		lines[i] = r.Line
+	}
+	assert.Equal(t, []int{1, 2, 3, 5, 6, 7, 8}, lines, "입력 순서 유지")
+
+	assert.True(t, results[0].Result.Success)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", results[0].Result.AddressDetail.RoadAddress)
+	assert.True(t, results[1].Result.Success)
+	assert.Equal(t, "서울특별시 강남구 역삼동 737", results[1].Address)
+
+	require.NotNil(t, results[2].Error)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, results[2].Error.Code)
+	assert.Nil(t, results[2].Result)
+
+	assert.Nil(t, results[3].Error)
+	assert.False(t, results[3].Result.Success)
+	assert.Equal(t, model.ErrorCodeAddressNotFound, results[3].Result.ErrorCode)
+
+	require.NotNil(t, results[4].Error)
+	assert.Equal(t, []model.FieldError{{Field: "addr", Reason: "unknown field"}}, results[4].Error.Fields)
+	require.NotNil(t, results[5].Error)
+	assert.Equal(t, []model.FieldError{{Field: "address", Reason: "is required"}}, results[5].Error.Fields)
+
+	assert.True(t, results[6].Result.Success)
+	assert.Equal(t, "부산광역시 해운대구 해운대로 264", results[6].Result.AddressDetail.RoadAddress)
+
+	// 주소 타입별로 묶어 배치 처리 (형식 오류 줄은 서비스로 보내지 않음)
+	assert.Equal(t, map[string][]string{
+		"":       {"서울특별시 중구 세종대로 110", "없는주소", "부산광역시 해운대구 해운대로 264"},
+		"PARCEL": {"서울특별시 강남구 역삼동 737"},
+	}, mockService.calls)
+}
+
+func TestGeocodingHandler_GeocodeNDJSON_NoProvidersAvailable(t *testing.T) {
+	mockService := &mockGeocodingService{batchErr: service.ErrNoProvidersAvailable}
+	handler := NewGeocodingHandler(mockService, zap.NewNop())
+
+	router := setupTestRouter()
+	router.POST("/geocode/ndjson", handler.GeocodeNDJSON)
+
+	req := httptest.NewRequest(http.MethodPost, "/geocode/ndjson", strings.NewReader(`{"address": "서울특별시 중구 세종대로 110"}`+"\n"))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, model.ErrorCodeProvidersUnavailable, decodeErrorResponse(t, w).Error.Code)
+}
+
+// countingProvider 호출할 때마다 조금씩 다른 좌표를 반환하는 Provider (캐시 응답과 새 결과를 구분)
+type countingProvider struct {
+	stubProvider
+	calls atomic.Int32
+}
+
+func (p *countingProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	n := p.calls.Add(1)
+	return &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5 + float64(n)/1000, Longitude: 126.978},
+	}, nil
+}
+
+func TestGeocodingHandler_CacheRefresh(t *testing.T) {
+	p := &countingProvider{stubProvider: stubProvider{name: "vWorld"}}
+	svc := service.NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), service.Options{
+		Cache: cache.NewMemory(time.Minute, 10),
+	})
+	h := NewGeocodingHandler(svc, zap.NewNop())
+	router := setupTestRouter()
+	router.POST("/api/v1/geocode", h.Geocode)
+	router.POST("/api/v1/geocode/bulk", h.GeocodeBulk)
+
+	geocode := func(t *testing.T, target string, header http.Header) (int, float64) {
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"address": "서울특별시 중구 세종대로 110"}`))
+		req.Header.Set("Content-Type", "application/json")
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			return w.Code, 0
+		}
+		var resp model.GeocodingResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return w.Code, resp.Coordinate.Latitude
+	}
+	noCache := http.Header{"Cache-Control": {"max-age=0, No-Cache"}}
+
+	_, first := geocode(t, "/api/v1/geocode", nil)
+	_, cached := geocode(t, "/api/v1/geocode", nil)
+	assert.Equal(t, int32(1), p.calls.Load(), "헤더가 없으면 캐시 사용")
+	assert.Equal(t, first, cached)
+
+	_, refreshed := geocode(t, "/api/v1/geocode", noCache)
+	assert.Equal(t, int32(2), p.calls.Load(), "Cache-Control: no-cache면 캐시를 조회하지 않음")
+	assert.NotEqual(t, first, refreshed)
+
+	_, afterRefresh := geocode(t, "/api/v1/geocode", nil)
+	assert.Equal(t, int32(2), p.calls.Load())
+	assert.Equal(t, refreshed, afterRefresh, "새 결과로 캐시를 덮어씀")
+
+	_, _ = geocode(t, "/api/v1/geocode?refresh=true", nil)
+	assert.Equal(t, int32(3), p.calls.Load(), "?refresh=true도 캐시를 건너뜀")
+	_, _ = geocode(t, "/api/v1/geocode?refresh=false", nil)
+	assert.Equal(t, int32(3), p.calls.Load())
+
+	code, _ := geocode(t, "/api/v1/geocode?refresh=maybe", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, int32(3), p.calls.Load())
+
+	bulk := func(header http.Header) int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/geocode/bulk", strings.NewReader(`{"addresses": ["서울특별시 중구 세종대로 110"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+	require.Equal(t, http.StatusOK, bulk(nil))
+	assert.Equal(t, int32(3), p.calls.Load(), "대량 변환도 캐시 사용")
+	require.Equal(t, http.StatusOK, bulk(noCache))
+	assert.Equal(t, int32(4), p.calls.Load(), "대량 변환도 no-cache면 캐시를 건너뜀")
+}
+
+// TestBindingMaxAddressLength binding 태그의 주소 최대 길이가 model.MaxAddressLength와 같은지 확인
+// 태그에는 상수를 쓸 수 없으므로 상수를 바꾸면 이 테스트가 태그도 바꾸도록 알려준다
+func TestBindingMaxAddressLength(t *testing.T) {
+	want := fmt.Sprintf("max=%d", model.MaxAddressLength)
+	fields := []struct {
+		typ   any
+		field string
+	}{
+		{model.GeocodingRequest{}, "Address"},
+		{model.BulkRequest{}, "Addresses"},
+		{model.NormalizeRequest{}, "Address"},
+	}
+
+	for _, f := range fields {
+		field, ok := reflect.TypeOf(f.typ).FieldByName(f.field)
+		require.True(t, ok)
+		tag := strings.Split(field.Tag.Get("binding"), ",")
+		assert.Equal(t, want, tag[len(tag)-1], "%T.%s", f.typ, f.field)
+	}
 }
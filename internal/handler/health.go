@@ -30,6 +30,7 @@ func NewHealthHandler(coordinator service.CoordinatorInterface, logger *zap.Logg
 // Health 헬스체크 API
 // @Summary      서비스 상태 확인
 // @Description  서비스와 Provider들의 상태를 확인합니다. 시스템 정보(메모리, Goroutine 등)도 함께 제공됩니다.
+// @Description  Redis 캐시를 켠 경우 components에 연결 상태가 포함되며, 캐시는 선택 사항이라 Redis 장애만으로 unhealthy가 되지는 않습니다.
 // @Tags         health
 // @Produce      json
 // @Success      200 {object} HealthResponse "서비스 정상"
@@ -63,6 +64,9 @@ func (h *HealthHandler) Health(c *gin.Context) {
 		})
 	}
 	
+	// 선택 구성 요소 상태 (장애여도 전체 상태는 바꾸지 않음)
+	response.Components = healthStatus.Components
+	
 	// 전체 상태 설정
 	if !healthStatus.Healthy {
 		response.Status = "unhealthy"
@@ -81,6 +85,39 @@ func (h *HealthHandler) Health(c *gin.Context) {
 	c.JSON(statusCode, response)
 }
 
+// Providers Provider별 상세 상태 API
+// @Summary      Provider 상세 상태
+// @Description  Provider별 가용 여부, 비활성화 사유, 남은 일일 할당량 추정치, 마지막 에러를 확인합니다. 정보 제공용이므로 Provider가 모두 장애여도 200을 반환합니다.
+// @Tags         health
+// @Produce      json
+// @Success      200 {object} ProvidersHealthResponse "Provider 상세 상태"
+// @Router       /health/providers [get]
+func (h *HealthHandler) Providers(c *gin.Context) {
+	healthStatus := h.coordinator.HealthCheck(c.Request.Context())
+
+	response := ProvidersHealthResponse{
+		Timestamp: time.Now(),
+		Providers: make([]ProviderDetail, 0, len(healthStatus.Providers)),
+	}
+	for _, ps := range healthStatus.Providers {
+		response.Providers = append(response.Providers, ProviderDetail{
+			Name:           ps.Name,
+			Available:      ps.Available,
+			Disabled:       ps.Disabled,
+			DisableReason:  ps.DisableReason,
+			DisabledUntil:  ps.DisabledUntil,
+			DailyLimit:     ps.DailyLimit,
+			CallsToday:     ps.CallsToday,
+			RemainingQuota: ps.RemainingQuota,
+			LastError:      ps.LastError,
+			LastErrorAt:    ps.LastErrorAt,
+			CheckError:     ps.CheckError,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // Ping 간단한 ping 체크
 // @Summary      Ping
 // @Description  서비스가 살아있는지 간단히 확인합니다
@@ -98,10 +135,11 @@ func (h *HealthHandler) Ping(c *gin.Context) {
 // Ready readiness 체크
 // @Summary      Readiness 체크
 // @Description  서비스가 요청을 처리할 준비가 되었는지 확인합니다. Kubernetes Readiness Probe에 사용할 수 있습니다.
+// @Description  Redis 캐시를 켠 경우 components에 연결 상태가 포함되지만, Redis 장애만으로 준비 안됨이 되지는 않습니다.
 // @Tags         health
 // @Produce      json
-// @Success      200 {object} map[string]bool "준비 완료"
-// @Success      503 {object} map[string]bool "준비 안됨"
+// @Success      200 {object} ReadyResponse "준비 완료"
+// @Success      503 {object} ReadyResponse "준비 안됨"
 // @Router       /ready [get]
 func (h *HealthHandler) Ready(c *gin.Context) {
 	healthStatus := h.coordinator.HealthCheck(c.Request.Context())
@@ -113,17 +151,25 @@ func (h *HealthHandler) Ready(c *gin.Context) {
 		statusCode = http.StatusServiceUnavailable
 	}
 	
-	c.JSON(statusCode, gin.H{
-		"ready": ready,
+	c.JSON(statusCode, ReadyResponse{
+		Ready:      ready,
+		Components: healthStatus.Components,
 	})
 }
 
 // HealthResponse 헬스체크 응답
 type HealthResponse struct {
-	Status    string           `json:"status"`
-	Timestamp time.Time        `json:"timestamp"`
-	Providers []ProviderStatus `json:"providers"`
-	System    SystemInfo       `json:"system"`
+	Status     string                    `json:"status"`
+	Timestamp  time.Time                 `json:"timestamp"`
+	Providers  []ProviderStatus          `json:"providers"`
+	Components []service.ComponentStatus `json:"components,omitempty"` // 선택 구성 요소 (Redis 등)
+	System     SystemInfo                `json:"system"`
+}
+
+// ReadyResponse readiness 체크 응답
+type ReadyResponse struct {
+	Ready      bool                      `json:"ready"`
+	Components []service.ComponentStatus `json:"components,omitempty"` // 선택 구성 요소 (준비 여부에 반영하지 않음)
 }
 
 // ProviderStatus Provider 상태
@@ -132,6 +178,27 @@ type ProviderStatus struct {
 	Available bool   `json:"available"`
 }
 
+// ProvidersHealthResponse Provider 상세 상태 응답
+type ProvidersHealthResponse struct {
+	Timestamp time.Time        `json:"timestamp"`
+	Providers []ProviderDetail `json:"providers"`
+}
+
+// ProviderDetail Provider 상세 상태
+type ProviderDetail struct {
+	Name           string     `json:"name"`
+	Available      bool       `json:"available"`
+	Disabled       bool       `json:"disabled"`
+	DisableReason  string     `json:"disable_reason,omitempty"`
+	DisabledUntil  *time.Time `json:"disabled_until,omitempty"`  // 일시 비활성화 만료 시각
+	DailyLimit     int        `json:"daily_limit,omitempty"`     // 일일 할당량
+	CallsToday     int64      `json:"calls_today"`               // 오늘(한국 시간) 보낸 HTTP 요청 수
+	RemainingQuota *int       `json:"remaining_quota,omitempty"` // 남은 일일 할당량 추정치
+	LastError      string     `json:"last_error,omitempty"`      // 마지막 에러 메시지
+	LastErrorAt    *time.Time `json:"last_error_at,omitempty"`   // 마지막 에러 발생 시각
+	CheckError     string     `json:"check_error,omitempty"`     // 가용성 확인 실패 사유 (제한 시간 초과 등)
+}
+
 // SystemInfo 시스템 정보
 type SystemInfo struct {
 	Uptime     string  `json:"uptime"`
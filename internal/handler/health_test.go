@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/oursportsnation/k-geocode/internal/service"
 	"github.com/stretchr/testify/assert"
@@ -168,3 +169,100 @@ func TestHealthHandler_Ready_NotReady(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, resp["ready"])
 }
+
+func TestHealthHandler_Providers(t *testing.T) {
+	remaining := 39950
+	until := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	lastErrorAt := time.Date(2026, 1, 2, 14, 50, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		healthy bool
+	}{
+		{"mixed providers", true},
+		{"all providers down", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCoord := &mockCoordinator{
+				healthStatus: service.HealthStatus{
+					Healthy: tt.healthy,
+					Providers: []service.ProviderStatus{
+						{Name: "vWorld", Available: tt.healthy, DailyLimit: 40000, CallsToday: 50, RemainingQuota: &remaining},
+						{Name: "Kakao", Available: false, Disabled: true, DisableReason: "rate limit exceeded", DisabledUntil: &until,
+							DailyLimit: 100000, LastError: "Rate limit exceeded: daily quota exceeded", LastErrorAt: &lastErrorAt},
+					},
+				},
+			}
+			handler := NewHealthHandler(mockCoord, zap.NewNop())
+
+			router := setupTestRouter()
+			router.GET("/health/providers", handler.Providers)
+
+			req := httptest.NewRequest(http.MethodGet, "/health/providers", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			// 정보 제공용이므로 Provider가 모두 장애여도 200
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var resp ProvidersHealthResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Len(t, resp.Providers, 2)
+
+			vworld := resp.Providers[0]
+			assert.Equal(t, "vWorld", vworld.Name)
+			assert.Equal(t, tt.healthy, vworld.Available)
+			assert.False(t, vworld.Disabled)
+			assert.Empty(t, vworld.DisableReason)
+			assert.Equal(t, int64(50), vworld.CallsToday)
+			require.NotNil(t, vworld.RemainingQuota)
+			assert.Equal(t, 39950, *vworld.RemainingQuota)
+			assert.Empty(t, vworld.LastError)
+			assert.Nil(t, vworld.LastErrorAt)
+
+			kakao := resp.Providers[1]
+			assert.False(t, kakao.Available)
+			assert.True(t, kakao.Disabled)
+			assert.Equal(t, "rate limit exceeded", kakao.DisableReason)
+			require.NotNil(t, kakao.DisabledUntil)
+			assert.True(t, until.Equal(*kakao.DisabledUntil))
+			assert.Nil(t, kakao.RemainingQuota)
+			assert.Equal(t, "Rate limit exceeded: daily quota exceeded", kakao.LastError)
+			require.NotNil(t, kakao.LastErrorAt)
+			assert.True(t, lastErrorAt.Equal(*kakao.LastErrorAt))
+		})
+	}
+}
+
+func TestHealthHandler_Ready_RedisDownStaysReady(t *testing.T) {
+	mockCoord := &mockCoordinator{
+		healthStatus: service.HealthStatus{
+			Healthy: true,
+			Components: []service.ComponentStatus{
+				{Name: "redis", Healthy: false, Error: "redis connect: connection refused"},
+			},
+		},
+	}
+	handler := NewHealthHandler(mockCoord, zap.NewNop())
+
+	router := setupTestRouter()
+	router.GET("/ready", handler.Ready)
+	router.GET("/health", handler.Health)
+
+	for _, path := range []string{"/ready", "/health"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		var resp struct {
+			Components []service.ComponentStatus `json:"components"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, mockCoord.healthStatus.Components, resp.Components, path)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/middleware"
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ndjsonContentType JSON Lines 미디어 타입
+const ndjsonContentType = "application/x-ndjson"
+
+// maxNDJSONLines ndjson 요청 한 번에 처리하는 최대 줄 수 (빈 줄 제외, /geocode/bulk와 같은 한도)
+const maxNDJSONLines = 100
+
+// ndjsonLine 파싱한 입력 줄
+type ndjsonLine struct {
+	number  int
+	request model.GeocodingRequest
+	err     error // 형식 오류 (nil이면 변환 대상)
+}
+
+// GeocodeNDJSON JSON Lines 대량 지오코딩 API
+// @Summary      여러 주소를 좌표로 변환 (JSON Lines)
+// @Description  요청 본문의 각 줄은 /api/v1/geocode와 같은 {"address": ..., "address_type": ...} 객체입니다 (빈 줄은 무시).
+// @Description  응답은 application/x-ndjson으로, 입력 줄 순서대로 한 줄에 model.NDJSONResult 하나를 씁니다.
+// @Description  형식이 잘못된 줄은 요청 전체를 실패시키지 않고 해당 줄에 error(INVALID_REQUEST)를 담아 응답합니다.
+// @Tags         geocoding
+// @Accept       x-ndjson
+// @Produce      x-ndjson
+// @Param        request body string true "줄마다 지오코딩 요청 객체 하나 (최대 100줄)"
+// @Param        refresh query bool false "true면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀 (Cache-Control: no-cache 헤더와 같음)"
+// @Param        Cache-Control header string false "no-cache면 캐시를 조회하지 않고 새 결과로 캐시를 덮어씀"
+// @Success      200 {object} model.NDJSONResult "입력 줄마다 한 줄"
+// @Failure      400 {object} model.ErrorResponse "잘못된 요청 (INVALID_REQUEST, TOO_MANY_ADDRESSES)"
+// @Failure      413 {object} model.ErrorResponse "요청 본문 크기 초과 (REQUEST_TOO_LARGE)"
+// @Failure      500 {object} model.ErrorResponse "서버 에러 (INTERNAL_ERROR)"
+// @Failure      503 {object} model.ErrorResponse "사용 가능한 Provider 없음 (PROVIDERS_UNAVAILABLE)"
+// @Failure      504 {object} model.ErrorResponse "요청 처리 시간 초과 (TIMEOUT)"
+// @Router       /api/v1/geocode/ndjson [post]
+func (h *GeocodingHandler) GeocodeNDJSON(c *gin.Context) {
+	start := time.Now()
+	requestID := c.GetString("requestID")
+	refresh, ok := cacheRefresh(c)
+	if !ok {
+		return
+	}
+
+	// 요청 파싱 (줄 단위 형식 오류는 응답 줄로 전달)
+	lines, err := readNDJSONLines(c.Request.Body)
+	if err != nil {
+		if middleware.IsBodyTooLarge(err) {
+			h.logger.Warn("Request body too large",
+				zap.String("request_id", requestID),
+			)
+			middleware.AbortBodyTooLarge(c)
+			return
+		}
+		h.logger.Warn("Invalid ndjson request",
+			zap.String("request_id", requestID),
+			zap.Error(err),
+		)
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	// 최대 줄 수 검증
+	if len(lines) > maxNDJSONLines {
+		h.logger.Warn("Too many lines in ndjson request",
+			zap.String("request_id", requestID),
+			zap.Int("count", len(lines)),
+		)
+		respondError(c, http.StatusBadRequest, model.ErrorCodeTooManyAddresses, "maximum 100 lines allowed")
+		return
+	}
+
+	// 주소 타입별로 묶어 배치 처리 (배치는 주소 타입을 하나만 받음)
+	var order []string
+	groups := make(map[string][]int)
+	for i, line := range lines {
+		if line.err != nil {
+			continue
+		}
+		addressType := line.request.AddressType
+		if _, ok := groups[addressType]; !ok {
+			order = append(order, addressType)
+		}
+		groups[addressType] = append(groups[addressType], i)
+	}
+
+	h.logger.Info("NDJSON geocoding request received",
+		zap.String("request_id", requestID),
+		zap.Int("line_count", len(lines)),
+		zap.Int("address_types", len(order)),
+	)
+
+	ctx, cancel := h.requestContext(c, refresh)
+	defer cancel()
+
+	results := make([]*model.GeocodingResponse, len(lines))
+	for _, addressType := range order {
+		indexes := groups[addressType]
+		addresses := make([]string, len(indexes))
+		for j, i := range indexes {
+			addresses[j] = lines[i].request.Address
+		}
+
+		resp, err := h.service.GeocodeBatch(ctx, addresses, addressType)
+		if h.respondIfTimedOut(ctx, c, requestID) {
+			return
+		}
+		if err != nil {
+			h.logger.Error("NDJSON geocoding service error",
+				zap.String("request_id", requestID),
+				zap.Error(err),
+			)
+			respondServiceError(c, err)
+			return
+		}
+		for j, i := range indexes {
+			results[i] = resp.Results[j]
+		}
+	}
+
+	// 입력 줄 순서대로 응답
+	c.Header("Content-Type", ndjsonContentType)
+	c.Status(http.StatusOK)
+	encoder := json.NewEncoder(c.Writer)
+	success, failed := 0, 0
+	for i, line := range lines {
+		out := model.NDJSONResult{Line: line.number, Address: line.request.Address}
+		switch {
+		case line.err != nil:
+			out.Error = lineError(line.err, requestID)
+			failed++
+		default:
+			out.Result = results[i]
+			if out.Result.Success {
+				success++
+			} else {
+				failed++
+			}
+		}
+		if err := encoder.Encode(out); err != nil {
+			h.logger.Warn("NDJSON response write failed",
+				zap.String("request_id", requestID),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	h.logger.Info("NDJSON geocoding request completed",
+		zap.String("request_id", requestID),
+		zap.Int("total", len(lines)),
+		zap.Int("success", success),
+		zap.Int("failed", failed),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// readNDJSONLines 요청 본문을 줄 단위로 파싱 (빈 줄은 건너뛰고, 형식이 잘못된 줄은 err에 사유 기록)
+// 본문을 읽지 못한 경우에만 에러를 반환한다
+func readNDJSONLines(body io.Reader) ([]ndjsonLine, error) {
+	if body == nil {
+		return nil, errors.New("missing request body")
+	}
+
+	var lines []ndjsonLine
+	reader := bufio.NewReader(body)
+	for number := 1; ; number++ {
+		raw, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 {
+			line := ndjsonLine{number: number}
+			line.err = decodeJSON(bytes.NewReader(trimmed), &line.request)
+			lines = append(lines, line)
+		}
+		if errors.Is(err, io.EOF) {
+			return lines, nil
+		}
+	}
+}
+
+// lineError 줄 형식 오류를 응답 줄의 에러 상세로 변환 (필드 단위 오류는 fields에 포함)
+func lineError(err error, requestID string) *model.ErrorDetail {
+	detail := model.NewErrorResponse(model.ErrorCodeInvalidRequest, "invalid line: "+err.Error(), requestID).Error
+	var reqErr *invalidRequestError
+	if errors.As(err, &reqErr) {
+		detail.Message = "invalid line"
+		detail.Fields = reqErr.fields
+	}
+	return &detail
+}
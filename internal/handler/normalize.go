@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/oursportsnation/k-geocode/internal/middleware"
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// NormalizeHandler 주소 정규화 API 핸들러
+// 좌표 없이 정제·검증된 주소와 구성 요소만 필요한 연동용으로, 지오코딩 Provider를 호출하지 않는다
+// 정규화 Provider(도로명주소 API)가 설정되어 있으면 공식 주소를 함께 반환한다
+type NormalizeHandler struct {
+	logger     *zap.Logger
+	normalizer provider.AddressNormalizer // nil이면 공식 주소 조회 안 함
+}
+
+// NewNormalizeHandler 주소 정규화 핸들러 생성자
+func NewNormalizeHandler(logger *zap.Logger) *NormalizeHandler {
+	return NewNormalizeHandlerWithNormalizer(logger, nil)
+}
+
+// NewNormalizeHandlerWithNormalizer 공식 주소를 조회할 정규화 Provider를 지정한 핸들러 생성자 (nil이면 조회 안 함)
+func NewNormalizeHandlerWithNormalizer(logger *zap.Logger, normalizer provider.AddressNormalizer) *NormalizeHandler {
+	return &NormalizeHandler{logger: logger, normalizer: normalizer}
+}
+
+// Normalize 주소 정규화 API
+// @Summary      주소 정규화
+// @Description  주소를 정규화하고 유효성, 추정 주소 타입(ROAD/PARCEL), 우편번호, 구성 요소를 반환합니다.
+// @Description  지오코딩 Provider를 호출하지 않으므로 좌표는 없으며 할당량을 사용하지 않습니다. 유효하지 않은 주소도 200으로 응답하고 valid=false와 사유를 담습니다.
+// @Description  도로명주소 API가 설정되어 있으면 유효한 주소의 공식 주소(canonical)를 함께 반환합니다 (찾지 못하면 생략).
+// @Tags         geocoding
+// @Accept       json
+// @Produce      json
+// @Param        request body model.NormalizeRequest true "정규화 요청"
+// @Success      200 {object} model.NormalizeResponse "정규화 결과"
+// @Failure      400 {object} model.ErrorResponse "잘못된 요청 (INVALID_REQUEST)"
+// @Failure      413 {object} model.ErrorResponse "요청 본문 크기 초과 (REQUEST_TOO_LARGE)"
+// @Router       /api/v1/normalize [post]
+func (h *NormalizeHandler) Normalize(c *gin.Context) {
+	var req model.NormalizeRequest
+	if err := bindJSON(c, &req); err != nil {
+		if middleware.IsBodyTooLarge(err) {
+			middleware.AbortBodyTooLarge(c)
+			return
+		}
+		h.logger.Warn("Invalid request format",
+			zap.String("request_id", c.GetString("requestID")),
+			zap.Error(err),
+		)
+		respondInvalidRequest(c, err)
+		return
+	}
+
+	resp := normalizeAddress(req.Address)
+	if resp.Valid && h.normalizer != nil && h.normalizer.IsAvailable(c.Request.Context()) {
+		canonical, err := h.normalizer.Normalize(c.Request.Context(), resp.Normalized)
+		if err != nil {
+			h.logger.Debug("Canonical address lookup failed",
+				zap.String("request_id", c.GetString("requestID")),
+				zap.String("normalizer", h.normalizer.Name()),
+				zap.Error(err),
+			)
+		} else {
+			resp.Canonical = canonical
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// normalizeAddress 지오코딩 입력 검증과 같은 정규화·검증 규칙으로 주소 분석
+func normalizeAddress(address string) *model.NormalizeResponse {
+	normalized := utils.NormalizeAddress(address)
+	resp := &model.NormalizeResponse{
+		Address:     address,
+		Normalized:  normalized,
+		Valid:       true,
+		AddressType: string(utils.DetectAddressType(normalized)),
+		Zipcode:     utils.ExtractZipcode(normalized),
+	}
+	if err := utils.CheckAddress(normalized, model.MaxAddressLength); err != nil {
+		resp.Valid = false
+		resp.InvalidReason = err.Error()
+	}
+
+	parts := utils.ParseAddressComponents(normalized)
+	resp.Components = model.AddressComponents{
+		Sido:         parts.Sido,
+		Sigungu:      parts.Sigungu,
+		EupMyeonDong: parts.EupMyeonDong,
+		RoadName:     parts.RoadName,
+		BuildingNo:   parts.BuildingNo,
+		LotNo:        parts.LotNo,
+		Underground:  parts.Underground,
+		BuildingName: parts.BuildingName,
+		BuildingDong: parts.BuildingDong,
+		BuildingUnit: parts.BuildingUnit,
+	}
+	return resp
+}
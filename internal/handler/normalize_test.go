@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNormalizeHandler_Normalize(t *testing.T) {
+	router := setupTestRouter()
+	router.POST("/api/v1/normalize", NewNormalizeHandler(zap.NewNop()).Normalize)
+
+	normalize := func(t *testing.T, body string) (int, model.NormalizeResponse) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/normalize", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp model.NormalizeResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w.Code, resp
+	}
+
+	t.Run("valid road address", func(t *testing.T) {
+		code, resp := normalize(t, `{"address": "(04524) 서울 중구 세종대로 110 서울특별시청"}`)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, resp.Valid)
+		assert.Empty(t, resp.InvalidReason)
+		assert.Equal(t, "ROAD", resp.AddressType)
+		assert.Equal(t, "04524", resp.Zipcode)
+		assert.Equal(t, model.AddressComponents{
+			Sido:         "서울특별시",
+			Sigungu:      "중구",
+			RoadName:     "세종대로",
+			BuildingNo:   "110",
+			BuildingName: "서울특별시청",
+		}, resp.Components)
+	})
+
+	t.Run("messy whitespace", func(t *testing.T) {
+		code, resp := normalize(t, `{"address": "  서울특별시\t강남구　역삼동   737  101동 1502호 "}`)
+
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, resp.Valid)
+		assert.Equal(t, "서울특별시 강남구 역삼동 737 101동 1502호", resp.Normalized)
+		assert.Equal(t, "PARCEL", resp.AddressType)
+		assert.Equal(t, model.AddressComponents{
+			Sido:         "서울특별시",
+			Sigungu:      "강남구",
+			EupMyeonDong: "역삼동",
+			LotNo:        "737",
+			BuildingDong: "101동",
+			BuildingUnit: "1502호",
+		}, resp.Components)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		code, resp := normalize(t, `{"address": "1234 Main Street"}`)
+
+		assert.Equal(t, http.StatusOK, code, "유효하지 않은 주소도 분석 결과로 응답")
+		assert.False(t, resp.Valid)
+		assert.Equal(t, "address contains no Korean characters", resp.InvalidReason)
+		assert.Empty(t, resp.AddressType)
+	})
+
+	t.Run("malformed request", func(t *testing.T) {
+		code, _ := normalize(t, `{"address": "서울특별시 중구", "limit": 1}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = normalize(t, `{}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+// stubNormalizer 주소별 공식 주소를 돌려주는 정규화 Provider
+type stubNormalizer struct {
+	details map[string]*model.AddressDetail
+	calls   int
+}
+
+func (n *stubNormalizer) Name() string                         { return "Juso" }
+func (n *stubNormalizer) IsAvailable(ctx context.Context) bool { return true }
+func (n *stubNormalizer) Normalize(ctx context.Context, address string) (*model.AddressDetail, error) {
+	n.calls++
+	if detail, ok := n.details[address]; ok {
+		return detail, nil
+	}
+	return nil, provider.NewClassifiedError(provider.ErrorTypeNotFound, "no matching address", provider.ErrAddressNotFound)
+}
+
+func TestNormalizeHandler_Normalize_Canonical(t *testing.T) {
+	normalizer := &stubNormalizer{details: map[string]*model.AddressDetail{
+		"서울특별시 중구 세종대로 110": {
+			RoadAddress:              "서울특별시 중구 세종대로 110 (태평로1가)",
+			ParcelAddress:            "서울특별시 중구 태평로1가 31",
+			Zipcode:                  "04524",
+			BuildingManagementNumber: "1114010300100310000000001",
+		},
+	}}
+	router := setupTestRouter()
+	router.POST("/api/v1/normalize", NewNormalizeHandlerWithNormalizer(zap.NewNop(), normalizer).Normalize)
+
+	normalize := func(t *testing.T, body string) model.NormalizeResponse {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/normalize", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp model.NormalizeResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := normalize(t, `{"address": "  서울특별시 중구   세종대로 110 "}`)
+	require.NotNil(t, resp.Canonical)
+	assert.Equal(t, "서울특별시 중구 세종대로 110 (태평로1가)", resp.Canonical.RoadAddress)
+	assert.Equal(t, "1114010300100310000000001", resp.Canonical.BuildingManagementNumber)
+
+	resp = normalize(t, `{"address": "서울특별시 중구 없는로 1"}`)
+	assert.Nil(t, resp.Canonical, "찾지 못하면 공식 주소 생략")
+
+	calls := normalizer.calls
+	resp = normalize(t, `{"address": "1234 Main Street"}`)
+	assert.Nil(t, resp.Canonical)
+	assert.Equal(t, calls, normalizer.calls, "유효하지 않은 주소는 조회하지 않음")
+}
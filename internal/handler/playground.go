@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed static/playground.html
+var playgroundFS embed.FS
+
+// PlaygroundHandler 수동 테스트용 웹 페이지 핸들러
+// 주소 입력 폼이 /api/v1/geocode를 호출하고 결과 좌표를 지도 타일 위에 표시한다 (외부 스크립트 없음)
+type PlaygroundHandler struct {
+	page []byte // 타일 설정을 적용해 미리 렌더링한 페이지
+}
+
+// playgroundData 페이지 템플릿 값
+type playgroundData struct {
+	TileURL         string // 지도 타일 URL 템플릿 ({z}, {x}, {y}, 선택적으로 {s})
+	TileAttribution string // 지도 아래 표시할 타일 저작권 문구
+}
+
+// NewPlaygroundHandler 플레이그라운드 핸들러 생성자
+// tileURL은 지도 타일 URL 템플릿 (예: https://tile.openstreetmap.org/{z}/{x}/{y}.png)
+func NewPlaygroundHandler(tileURL, tileAttribution string) (*PlaygroundHandler, error) {
+	tmpl, err := template.ParseFS(playgroundFS, "static/playground.html")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, playgroundData{TileURL: tileURL, TileAttribution: tileAttribution}); err != nil {
+		return nil, err
+	}
+	return &PlaygroundHandler{page: buf.Bytes()}, nil
+}
+
+// Page 플레이그라운드 페이지 반환
+func (h *PlaygroundHandler) Page(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", h.page)
+}
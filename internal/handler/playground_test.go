@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaygroundHandler_Page(t *testing.T) {
+	h, err := NewPlaygroundHandler("https://tiles.example.com/{z}/{x}/{y}.png", "© Example <Maps>")
+	require.NoError(t, err)
+
+	router := setupTestRouter()
+	router.GET("/", h.Page)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	assert.Contains(t, body, `<form id="form">`)
+	assert.Contains(t, body, `fetch("/api/v1/geocode"`)
+	assert.Contains(t, body, `tiles.example.com`)
+	assert.Contains(t, body, "© Example &lt;Maps&gt;", "저작권 문구는 HTML 이스케이프")
+	assert.NotContains(t, body, "{{", "템플릿이 모두 렌더링됨")
+}
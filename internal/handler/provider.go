@@ -0,0 +1,113 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ProviderHandler Provider 관리 API 핸들러
+type ProviderHandler struct {
+	providers []provider.GeocodingProvider
+	logger    *zap.Logger
+}
+
+// NewProviderHandler Provider 관리 핸들러 생성자
+func NewProviderHandler(providers []provider.GeocodingProvider, logger *zap.Logger) *ProviderHandler {
+	return &ProviderHandler{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+// ProviderInfo Provider 활성화 상태
+type ProviderInfo struct {
+	Name          string `json:"name"`
+	Enabled       bool   `json:"enabled"`
+	DisableReason string `json:"disable_reason,omitempty"`
+}
+
+// ProvidersResponse Provider 목록 응답
+type ProvidersResponse struct {
+	Providers []ProviderInfo `json:"providers"`
+}
+
+// List Provider 목록 조회 API
+// @Summary      Provider 목록 조회
+// @Description  등록된 Provider의 이름, 활성화 여부, 비활성화 사유를 반환합니다. X-API-Key 헤더가 필요합니다.
+// @Tags         providers
+// @Produce      json
+// @Param        X-API-Key header string true "관리 API 키"
+// @Success      200 {object} ProvidersResponse "Provider 목록"
+// @Failure      401 {object} model.ErrorResponse "API 키 인증 실패 (UNAUTHORIZED)"
+// @Router       /api/v1/providers [get]
+func (h *ProviderHandler) List(c *gin.Context) {
+	response := ProvidersResponse{Providers: make([]ProviderInfo, 0, len(h.providers))}
+	for _, p := range h.providers {
+		response.Providers = append(response.Providers, providerInfo(p))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Enable Provider 재활성화 API
+// @Summary      Provider 재활성화
+// @Description  비활성화된 Provider의 비활성화 상태와 사유를 해제합니다 (API 키 교체 후 서버 재시작 없이 복구). X-API-Key 헤더가 필요합니다.
+// @Tags         providers
+// @Produce      json
+// @Param        X-API-Key header string true "관리 API 키"
+// @Param        name path string true "Provider 이름 (예: vWorld, Kakao)"
+// @Success      200 {object} ProviderInfo "재활성화된 Provider 상태"
+// @Failure      401 {object} model.ErrorResponse "API 키 인증 실패 (UNAUTHORIZED)"
+// @Failure      404 {object} model.ErrorResponse "존재하지 않는 Provider (PROVIDER_NOT_FOUND)"
+// @Router       /api/v1/providers/{name}/enable [post]
+func (h *ProviderHandler) Enable(c *gin.Context) {
+	name := c.Param("name")
+
+	for _, p := range h.providers {
+		if p.Name() != name {
+			continue
+		}
+
+		previousReason := p.GetDisableReason()
+		p.Enable()
+
+		h.logger.Info("Provider enabled via admin API",
+			zap.String("request_id", c.GetString("requestID")),
+			zap.String("provider", name),
+			zap.String("previous_reason", previousReason),
+		)
+
+		c.JSON(http.StatusOK, providerInfo(p))
+		return
+	}
+
+	respondError(c, http.StatusNotFound, model.ErrorCodeProviderNotFound, "unknown provider: "+name)
+}
+
+// providerInfo Provider의 현재 활성화 상태
+func providerInfo(p provider.GeocodingProvider) ProviderInfo {
+	return ProviderInfo{
+		Name:          p.Name(),
+		Enabled:       !p.IsDisabled(),
+		DisableReason: p.GetDisableReason(),
+	}
+}
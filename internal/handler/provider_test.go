@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oursportsnation/k-geocode/internal/middleware"
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// stubProvider 비활성화 상태만 관리하는 Mock Provider
+type stubProvider struct {
+	name          string
+	disabled      bool
+	disableReason string
+}
+
+func (p *stubProvider) Name() string                         { return p.name }
+func (p *stubProvider) IsAvailable(ctx context.Context) bool { return !p.disabled }
+func (p *stubProvider) Disable(reason string)                { p.disabled = true; p.disableReason = reason }
+func (p *stubProvider) Enable()                              { p.disabled = false; p.disableReason = "" }
+func (p *stubProvider) IsDisabled() bool                     { return p.disabled }
+func (p *stubProvider) GetDisableReason() string             { return p.disableReason }
+func (p *stubProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	return &model.ProviderResult{Success: false}, nil
+}
+
+func setupProviderRouter(providers ...provider.GeocodingProvider) *gin.Engine {
+	router := setupTestRouter()
+	handler := NewProviderHandler(providers, zap.NewNop())
+
+	admin := router.Group("/api/v1/providers")
+	admin.Use(middleware.APIKeyAuth([]string{"admin-key"}))
+	admin.GET("", handler.List)
+	admin.POST("/:name/enable", handler.Enable)
+	return router
+}
+
+func TestProviderHandler_List(t *testing.T) {
+	vworld := &stubProvider{name: "vWorld"}
+	vworld.Disable("Authentication failed")
+	router := setupProviderRouter(vworld, &stubProvider{name: "Kakao"})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/providers", nil)
+	req.Header.Set(middleware.APIKeyHeader, "admin-key")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp ProvidersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []ProviderInfo{
+		{Name: "vWorld", Enabled: false, DisableReason: "Authentication failed"},
+		{Name: "Kakao", Enabled: true},
+	}, resp.Providers)
+}
+
+func TestProviderHandler_Enable(t *testing.T) {
+	vworld := &stubProvider{name: "vWorld"}
+	vworld.Disable("Authentication failed")
+	router := setupProviderRouter(vworld)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/providers/vWorld/enable", nil)
+	req.Header.Set(middleware.APIKeyHeader, "admin-key")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp ProviderInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, ProviderInfo{Name: "vWorld", Enabled: true}, resp)
+	assert.False(t, vworld.IsDisabled())
+	assert.Empty(t, vworld.GetDisableReason())
+}
+
+func TestProviderHandler_Enable_UnknownProvider(t *testing.T) {
+	router := setupProviderRouter(&stubProvider{name: "vWorld"})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/providers/Naver/enable", nil)
+	req.Header.Set(middleware.APIKeyHeader, "admin-key")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, model.ErrorCodeProviderNotFound, decodeErrorResponse(t, w).Error.Code)
+}
+
+func TestProviderHandler_Enable_RequiresAPIKey(t *testing.T) {
+	vworld := &stubProvider{name: "vWorld"}
+	vworld.Disable("Authentication failed")
+	router := setupProviderRouter(vworld)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/providers/vWorld/enable", nil)
+	req.Header.Set(middleware.APIKeyHeader, "wrong-key")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, model.ErrorCodeUnauthorized, decodeErrorResponse(t, w).Error.Code)
+	assert.True(t, vworld.IsDisabled())
+}
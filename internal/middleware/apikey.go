@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader API 키 인증 헤더 이름
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuth API 키 인증 미들웨어
+// X-API-Key 헤더가 keys 중 하나와 일치해야 통과하며, 키가 설정되지 않았으면 모든 요청을 거부한다
+func APIKeyAuth(keys []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given := []byte(c.GetHeader(APIKeyHeader))
+
+		// 타이밍 공격 방지를 위해 상수 시간 비교
+		for _, key := range keys {
+			if key != "" && subtle.ConstantTimeCompare(given, []byte(key)) == 1 {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusUnauthorized,
+			model.NewErrorResponse(model.ErrorCodeUnauthorized, "invalid or missing API key", c.GetString("requestID")))
+	}
+}
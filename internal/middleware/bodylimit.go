@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodyLimit 요청 본문 크기 제한 미들웨어
+// Content-Length가 한도를 넘으면 즉시 413을 반환하고, 그 외에는 본문을
+// http.MaxBytesReader로 감싸 한도 이상 읽지 못하게 한다
+// (읽는 도중 한도를 넘으면 핸들러에서 IsBodyTooLarge로 확인)
+func BodyLimit(maxBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > maxBytes {
+			AbortBodyTooLarge(c)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes)
+		c.Next()
+	}
+}
+
+// IsBodyTooLarge 본문 크기 제한 초과로 발생한 에러인지 확인
+func IsBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
+
+// AbortBodyTooLarge 413 응답 후 요청 처리 중단
+func AbortBodyTooLarge(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge,
+		model.NewErrorResponse(model.ErrorCodeRequestTooLarge, "request body too large", c.GetString("requestID")))
+}
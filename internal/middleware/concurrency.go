@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxInFlight 동시에 처리하는 요청 수를 limit으로 제한하는 미들웨어
+// 한도에 도달하면 최대 wait 동안 자리가 나기를 기다리고 (0이면 기다리지 않음),
+// 그래도 자리가 없으면 503과 Retry-After를 반환한다
+// 배치 요청 하나가 내부에서 여러 주소를 동시에 처리하는 것(ConcurrentLimit)과는 별개로 요청 단위로 센다
+func MaxInFlight(limit int, wait time.Duration) gin.HandlerFunc {
+	slots := make(chan struct{}, limit)
+
+	return func(c *gin.Context) {
+		if !acquireSlot(c, slots, wait) {
+			c.Header("Retry-After", "1")
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable,
+				model.NewErrorResponse(model.ErrorCodeServerBusy, "too many requests in flight", c.GetString("requestID")))
+			return
+		}
+		defer func() { <-slots }()
+
+		c.Next()
+	}
+}
+
+// acquireSlot 처리 자리 확보 (wait 안에 확보하지 못하거나 클라이언트가 요청을 취소하면 false)
+func acquireSlot(c *gin.Context, slots chan struct{}, wait time.Duration) bool {
+	select {
+	case slots <- struct{}{}:
+		return true
+	default:
+	}
+	if wait <= 0 {
+		return false
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-c.Request.Context().Done():
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ipAllowlistExempt 접근 제어 없이 허용하는 헬스체크 경로 (로드밸런서·오케스트레이터용)
+var ipAllowlistExempt = map[string]bool{
+	"/ping":             true,
+	"/health":           true,
+	"/health/providers": true,
+	"/ready":            true,
+}
+
+// IPAllowlist 클라이언트 IP가 cidrs 중 하나에 속할 때만 통과시키는 미들웨어 (IPv4, IPv6)
+// CIDR 대신 단일 IP도 허용하며, 목록은 생성 시 한 번만 파싱한다 (잘못된 항목이 있으면 에러)
+// 클라이언트 IP는 c.ClientIP()를 사용하므로 X-Forwarded-For는 gin 신뢰 프록시(SetTrustedProxies)를 거친 요청에서만 반영된다
+// 목록이 비어 있으면 헬스체크 외 모든 요청을 거부한다
+func IPAllowlist(cidrs []string) (gin.HandlerFunc, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := parsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return func(c *gin.Context) {
+		if ipAllowlistExempt[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
+		if addr, err := netip.ParseAddr(c.ClientIP()); err == nil {
+			addr = addr.Unmap() // IPv4-mapped IPv6 (::ffff:a.b.c.d)도 IPv4 대역과 비교
+			for _, prefix := range prefixes {
+				if prefix.Contains(addr) {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden,
+			model.NewErrorResponse(model.ErrorCodeForbidden, "client IP not allowed", c.GetString("requestID")))
+	}, nil
+}
+
+// parsePrefix CIDR 또는 단일 IP를 대역으로 변환
+func parsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: %w", s, err)
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: %w", s, err)
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
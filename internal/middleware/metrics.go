@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute 등록된 라우트가 없는 요청의 path 라벨 (원본 경로를 쓰면 라벨 수가 무한히 늘어남)
+const unmatchedRoute = "unmatched"
+
+// otherMethod 표준이 아닌 HTTP 메서드의 method 라벨 (임의의 메서드로 라벨 수가 늘어나지 않도록)
+const otherMethod = "OTHER"
+
+// Metrics 라우트별 요청 수와 처리 시간을 Prometheus 메트릭으로 기록하는 미들웨어
+// 라벨은 method(표준 메서드 외에는 OTHER), path(라우트 템플릿, 예: /api/v1/providers/:name/enable), status
+// 핸들러가 패닉해도 기록되도록 defer에서 기록하며, 패닉은 바깥의 Recovery가 응답할 500으로 기록한 뒤 다시 전파한다
+// 같은 레지스트리에 이미 등록된 메트릭이 있으면 그것을 재사용한다
+func Metrics(registry prometheus.Registerer) gin.HandlerFunc {
+	labels := []string{"method", "path", "status"}
+	requests := registerOrExisting(registry, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests by route and status code.",
+	}, labels))
+	duration := registerOrExisting(registry, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by route and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, labels))
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		record := func(code int) {
+			path := c.FullPath()
+			if path == "" {
+				path = unmatchedRoute
+			}
+			method := metricMethod(c.Request.Method)
+			status := strconv.Itoa(code)
+
+			requests.WithLabelValues(method, path, status).Inc()
+			duration.WithLabelValues(method, path, status).Observe(time.Since(start).Seconds())
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				record(http.StatusInternalServerError)
+				panic(r)
+			}
+			record(c.Writer.Status())
+		}()
+
+		c.Next()
+	}
+}
+
+// metricMethod 요청 메서드의 method 라벨 (표준 메서드가 아니면 OTHER)
+func metricMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return method
+	default:
+		return otherMethod
+	}
+}
+
+// registerOrExisting 컬렉터 등록 (이미 등록되어 있으면 기존 컬렉터 반환)
+func registerOrExisting[T prometheus.Collector](registry prometheus.Registerer, collector T) T {
+	if err := registry.Register(collector); err != nil {
+		var already prometheus.AlreadyRegisteredError
+		if errors.As(err, &already) {
+			if existing, ok := already.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+		panic(err)
+	}
+	return collector
+}
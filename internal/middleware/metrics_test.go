@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	router := setupTestRouter()
+	router.Use(Recovery(zap.NewNop()))
+	router.Use(Metrics(registry))
+	router.POST("/api/v1/providers/:name/enable", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/fail", func(c *gin.Context) {
+		c.Status(http.StatusInternalServerError)
+	})
+	router.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+	router.Handle("PURGE", "/fail", func(c *gin.Context) {
+		c.Status(http.StatusInternalServerError)
+	})
+
+	for _, path := range []string{"/api/v1/providers/Kakao/enable", "/api/v1/providers/vWorld/enable"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/fail", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/no/such/route", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/panic", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("PURGE", "/fail", nil))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	byName := map[string][]map[string]string{}
+	var observed uint64
+	counts := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			byName[family.GetName()] = append(byName[family.GetName()], labels)
+			switch family.GetName() {
+			case "http_request_duration_seconds":
+				observed += m.GetHistogram().GetSampleCount()
+			case "http_requests_total":
+				counts[labels["method"]+" "+labels["path"]+" "+labels["status"]] = m.GetCounter().GetValue()
+			}
+		}
+	}
+
+	// 원본 경로가 아닌 라우트 템플릿으로 집계, 패닉은 500으로, 비표준 메서드는 OTHER로 기록
+	assert.ElementsMatch(t, []map[string]string{
+		{"method": "POST", "path": "/api/v1/providers/:name/enable", "status": "204"},
+		{"method": "GET", "path": "/fail", "status": "500"},
+		{"method": "GET", "path": "unmatched", "status": "404"},
+		{"method": "GET", "path": "/panic", "status": "500"},
+		{"method": "OTHER", "path": "/fail", "status": "500"},
+	}, byName["http_request_duration_seconds"])
+	assert.Equal(t, uint64(6), observed)
+	assert.Equal(t, map[string]float64{
+		"POST /api/v1/providers/:name/enable 204": 2,
+		"GET /fail 500":     1,
+		"GET unmatched 404": 1,
+		"GET /panic 500":    1,
+		"OTHER /fail 500":   1,
+	}, counts)
+}
+
+func TestMetrics_ReusesRegisteredCollectors(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	assert.NotPanics(t, func() {
+		Metrics(registry)
+		Metrics(registry)
+	})
+}
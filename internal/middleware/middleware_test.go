@@ -1,9 +1,15 @@
 package middleware
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -142,9 +148,10 @@ func TestRequestID(t *testing.T) {
 	router := setupTestRouter()
 	router.Use(RequestID())
 
-	var capturedID string
+	var capturedID, contextID string
 	router.GET("/test", func(c *gin.Context) {
 		capturedID = c.GetString("requestID")
+		contextID = logger.RequestID(c.Request.Context())
 		c.String(http.StatusOK, "OK")
 	})
 
@@ -168,6 +175,7 @@ func TestRequestID(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, "custom-id-123", capturedID)
+		assert.Equal(t, "custom-id-123", contextID)
 		assert.Equal(t, "custom-id-123", w.Header().Get("X-Request-ID"))
 	})
 }
@@ -467,3 +475,231 @@ func TestIntToString(t *testing.T) {
 		assert.Equal(t, tt.expected, result)
 	}
 }
+
+// BodyLimit Tests
+func TestBodyLimit(t *testing.T) {
+	router := setupTestRouter()
+	router.Use(BodyLimit(16))
+	router.POST("/test", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if IsBodyTooLarge(err) {
+			AbortBodyTooLarge(c)
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	})
+
+	t.Run("body within limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader("small body"))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "small body", w.Body.String())
+	})
+
+	t.Run("content length over limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(strings.Repeat("x", 1024)))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "request body too large")
+	})
+
+	t.Run("streamed body over limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/test", io.MultiReader(strings.NewReader(strings.Repeat("x", 1024))))
+		req.ContentLength = -1
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	newRouter := func(keys []string) *gin.Engine {
+		router := setupTestRouter()
+		router.Use(APIKeyAuth(keys))
+		router.GET("/admin", func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+		return router
+	}
+
+	tests := []struct {
+		name       string
+		keys       []string
+		header     string
+		wantStatus int
+	}{
+		{"valid key", []string{"key1", "key2"}, "key2", http.StatusOK},
+		{"wrong key", []string{"key1"}, "nope", http.StatusUnauthorized},
+		{"missing header", []string{"key1"}, "", http.StatusUnauthorized},
+		{"no keys configured", nil, "", http.StatusUnauthorized},
+		{"empty configured key", []string{""}, "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+			if tt.header != "" {
+				req.Header.Set(APIKeyHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			newRouter(tt.keys).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Contains(t, w.Body.String(), "UNAUTHORIZED")
+			}
+		})
+	}
+}
+
+func TestIPAllowlist(t *testing.T) {
+	newRouter := func(t *testing.T, cidrs []string, trustedProxies []string) *gin.Engine {
+		t.Helper()
+		router := setupTestRouter()
+		require.NoError(t, router.SetTrustedProxies(trustedProxies))
+		allowlist, err := IPAllowlist(cidrs)
+		require.NoError(t, err)
+		router.Use(allowlist)
+		router.GET("/api", func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+		router.GET("/health", func(c *gin.Context) {
+			c.String(http.StatusOK, "ok")
+		})
+		return router
+	}
+
+	tests := []struct {
+		name           string
+		cidrs          []string
+		trustedProxies []string
+		path           string
+		remoteAddr     string
+		forwardedFor   string
+		wantStatus     int
+	}{
+		{"IPv4 in range", []string{"10.0.0.0/8"}, nil, "/api", "10.1.2.3:1234", "", http.StatusOK},
+		{"IPv4 out of range", []string{"10.0.0.0/8"}, nil, "/api", "192.168.0.1:1234", "", http.StatusForbidden},
+		{"single IP", []string{"192.168.0.1"}, nil, "/api", "192.168.0.1:1234", "", http.StatusOK},
+		{"IPv6 in range", []string{"fd00::/8"}, nil, "/api", "[fd12::1]:1234", "", http.StatusOK},
+		{"IPv6 out of range", []string{"fd00::/8"}, nil, "/api", "[2001:db8::1]:1234", "", http.StatusForbidden},
+		{"health check exempt", []string{"10.0.0.0/8"}, nil, "/health", "192.168.0.1:1234", "", http.StatusOK},
+		{"no CIDRs rejects all", nil, nil, "/api", "10.1.2.3:1234", "", http.StatusForbidden},
+		{"forwarded-for from trusted proxy", []string{"10.0.0.0/8"}, []string{"192.168.0.1"}, "/api", "192.168.0.1:1234", "10.1.2.3", http.StatusOK},
+		{"forwarded-for from untrusted proxy ignored", []string{"10.0.0.0/8"}, nil, "/api", "192.168.0.1:1234", "10.1.2.3", http.StatusForbidden},
+		{"spoofed forwarded-for ignored", []string{"10.0.0.0/8"}, []string{"192.168.0.1"}, "/api", "172.16.0.1:1234", "10.1.2.3", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+
+			newRouter(t, tt.cidrs, tt.trustedProxies).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), "FORBIDDEN")
+			}
+		})
+	}
+}
+
+func TestIPAllowlist_MalformedCIDR(t *testing.T) {
+	for _, cidr := range []string{"10.0.0.0/33", "not-an-ip", "", "fd00::/129"} {
+		t.Run(cidr, func(t *testing.T) {
+			_, err := IPAllowlist([]string{"10.0.0.0/8", cidr})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMaxInFlight(t *testing.T) {
+	const limit = 3
+	newRouter := func(wait time.Duration) (*gin.Engine, *atomic.Int32, chan struct{}) {
+		var entered atomic.Int32
+		release := make(chan struct{})
+		router := setupTestRouter()
+		router.Use(MaxInFlight(limit, wait))
+		router.POST("/geocode", func(c *gin.Context) {
+			entered.Add(1)
+			<-release
+			c.String(http.StatusOK, "ok")
+		})
+		return router, &entered, release
+	}
+	serve := func(router *gin.Engine) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/geocode", nil))
+		return w
+	}
+	// saturate 한도만큼 처리 중인 요청을 만들고 완료 시 응답 코드를 보내는 채널 반환
+	saturate := func(t *testing.T, router *gin.Engine, entered *atomic.Int32) chan int {
+		codes := make(chan int, limit)
+		for range limit {
+			go func() { codes <- serve(router).Code }()
+		}
+		require.Eventually(t, func() bool { return entered.Load() == limit }, time.Second, time.Millisecond)
+		return codes
+	}
+
+	t.Run("rejects over the cap", func(t *testing.T) {
+		router, entered, release := newRouter(0)
+		codes := saturate(t, router, entered)
+
+		for range 5 {
+			w := serve(router)
+			assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+			assert.Equal(t, "1", w.Header().Get("Retry-After"))
+			assert.Contains(t, w.Body.String(), "SERVER_BUSY")
+		}
+		assert.Equal(t, int32(limit), entered.Load(), "거부된 요청은 핸들러에 도달하지 않음")
+
+		close(release)
+		for range limit {
+			assert.Equal(t, http.StatusOK, <-codes)
+		}
+		assert.Equal(t, http.StatusOK, serve(router).Code, "자리가 나면 다시 처리")
+	})
+
+	t.Run("queues up to wait", func(t *testing.T) {
+		router, entered, release := newRouter(time.Second)
+		codes := saturate(t, router, entered)
+
+		queued := make(chan int, 1)
+		go func() { queued <- serve(router).Code }()
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, int32(limit), entered.Load(), "자리가 날 때까지 대기")
+
+		close(release)
+		assert.Equal(t, http.StatusOK, <-queued)
+		for range limit {
+			assert.Equal(t, http.StatusOK, <-codes)
+		}
+	})
+
+	t.Run("rejects after wait", func(t *testing.T) {
+		router, entered, release := newRouter(20 * time.Millisecond)
+		codes := saturate(t, router, entered)
+
+		assert.Equal(t, http.StatusServiceUnavailable, serve(router).Code)
+
+		close(release)
+		for range limit {
+			assert.Equal(t, http.StatusOK, <-codes)
+		}
+	})
+}
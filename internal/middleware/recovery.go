@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"runtime/debug"
 	
+	"github.com/oursportsnation/k-geocode/internal/model"
+	
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -34,10 +36,7 @@ func Recovery(logger *zap.Logger) gin.HandlerFunc {
 				}
 				
 				// 에러 응답
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"error":      "internal server error",
-					"request_id": requestID,
-				})
+				c.JSON(http.StatusInternalServerError, model.NewErrorResponse(model.ErrorCodeInternal, "internal server error", requestID))
 				
 				// 후속 처리 중단
 				c.Abort()
@@ -67,10 +66,7 @@ func CustomRecovery(logger *zap.Logger, handle gin.RecoveryFunc) gin.HandlerFunc
 			handle(c, recovered)
 		} else {
 			// 기본 응답
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error":      "internal server error",
-				"request_id": requestID,
-			})
+			c.JSON(http.StatusInternalServerError, model.NewErrorResponse(model.ErrorCodeInternal, "internal server error", requestID))
 			c.Abort()
 		}
 	})
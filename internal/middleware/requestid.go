@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"github.com/oursportsnation/k-geocode/pkg/logger"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -18,6 +20,8 @@ func RequestID() gin.HandlerFunc {
 		
 		// Context에 저장
 		c.Set("requestID", requestID)
+		// 서비스·Provider 로그에도 같은 ID가 남도록 요청 context에 저장
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))
 		
 		// Response 헤더에 추가
 		c.Writer.Header().Set("X-Request-ID", requestID)
@@ -73,6 +77,8 @@ func RequestIDWithConfig(config RequestIDConfig) gin.HandlerFunc {
 		
 		// Context에 저장
 		c.Set(config.ContextKey, requestID)
+		// 서비스·Provider 로그에도 같은 ID가 남도록 요청 context에 저장
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))
 		
 		// Response 헤더에 추가
 		c.Writer.Header().Set(config.HeaderName, requestID)
@@ -0,0 +1,56 @@
+package model
+
+// 에러 코드 (HTTP API 에러 응답 및 GeocodingResponse.ErrorCode)
+// 클라이언트가 분기에 사용하는 값이므로 한 번 공개된 코드는 변경하지 않는다
+const (
+	ErrorCodeInvalidRequest       = "INVALID_REQUEST"       // 요청 형식 오류
+	ErrorCodeTooManyAddresses     = "TOO_MANY_ADDRESSES"    // 대량 요청 개수 초과
+	ErrorCodeRequestTooLarge      = "REQUEST_TOO_LARGE"     // 요청 본문 크기 초과
+	ErrorCodeUnauthorized         = "UNAUTHORIZED"          // API 키 인증 실패
+	ErrorCodeForbidden            = "FORBIDDEN"             // 허용되지 않은 클라이언트 IP
+	ErrorCodeProviderNotFound     = "PROVIDER_NOT_FOUND"    // 존재하지 않는 Provider
+	ErrorCodeInvalidAddress       = "INVALID_ADDRESS"       // 주소 형식 오류
+	ErrorCodeAddressNotFound      = "ADDRESS_NOT_FOUND"     // 주소를 찾을 수 없음
+	ErrorCodeInvalidCoordinates   = "INVALID_COORDINATES"   // 좌표 범위 오류
+	ErrorCodeOutsideKorea         = "OUTSIDE_KOREA"         // 한국 영역 밖 좌표
+	ErrorCodeOutsideBounds        = "OUTSIDE_BOUNDS"        // 요청한 경계 상자 밖 좌표
+	ErrorCodeProviderUnauthorized = "PROVIDER_UNAUTHORIZED" // Provider 인증 실패
+	ErrorCodeRateLimitExceeded    = "RATE_LIMIT_EXCEEDED"   // Provider 할당량 초과
+	ErrorCodeTimeout              = "TIMEOUT"               // 요청 타임아웃
+	ErrorCodeProviderError        = "PROVIDER_ERROR"        // Provider 시스템 오류
+	ErrorCodeProvidersUnavailable = "PROVIDERS_UNAVAILABLE" // 사용 가능한 Provider 없음
+	ErrorCodeServerBusy           = "SERVER_BUSY"           // 서버 동시 처리 한도 초과
+	ErrorCodeNotFound             = "NOT_FOUND"             // 존재하지 않는 경로
+	ErrorCodeInternal             = "INTERNAL_ERROR"        // 서버 내부 오류
+)
+
+// ErrorDetail 에러 상세 정보
+type ErrorDetail struct {
+	Code      string       `json:"code"`                 // 에러 코드 (ErrorCode* 상수)
+	Message   string       `json:"message"`              // 사람이 읽을 수 있는 메시지
+	RequestID string       `json:"request_id,omitempty"` // 요청 ID
+	Fields    []FieldError `json:"fields,omitempty"`     // 필드별 검증 실패 내역 (INVALID_REQUEST)
+}
+
+// FieldError 요청 필드 하나의 검증 실패 내역
+type FieldError struct {
+	Field  string `json:"field"`  // JSON 필드 경로 (예: "address", "addresses[3]")
+	Reason string `json:"reason"` // 실패 사유 (예: "must be at most 200 characters")
+}
+
+// ErrorResponse HTTP API 공통 에러 응답
+type ErrorResponse struct {
+	Error  ErrorDetail        `json:"error"`
+	Result *GeocodingResponse `json:"result,omitempty"` // 지오코딩 실패 시 시도 내역 등 원본 결과
+}
+
+// NewErrorResponse 에러 응답 생성
+func NewErrorResponse(code, message, requestID string) ErrorResponse {
+	return ErrorResponse{
+		Error: ErrorDetail{
+			Code:      code,
+			Message:   message,
+			RequestID: requestID,
+		},
+	}
+}
@@ -1,11 +1,18 @@
 package model
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+// MaxAddressLength HTTP 요청 주소 최대 길이 (문자 수, binding 태그의 max 값과 일치해야 함)
+// 서버의 요청 제한이며, 라이브러리 설정의 MaxAddressLength(기본값 utils.DefaultMaxAddressLength)와는 별개다
+const MaxAddressLength = 200
 
 // GeocodingRequest 지오코딩 요청
 type GeocodingRequest struct {
-	Address     string `json:"address" binding:"required"`                  // 검색 주소
-	AddressType string `json:"address_type,omitempty" binding:"omitempty,oneof=ROAD PARCEL road parcel"` // 주소 타입 (ROAD, PARCEL) - 선택적
+	Address     string `json:"address" binding:"required,max=200"`          // 검색 주소 (최대 MaxAddressLength자)
+	AddressType string `json:"address_type,omitempty"` // 주소 타입 (ROAD, PARCEL, 대소문자 무시) - 선택적
 }
 
 // Coordinate 좌표 정보 (소수점 6자리 정밀도)
@@ -20,47 +27,181 @@ type AddressDetail struct {
 	ParcelAddress string `json:"parcel_address"` // 지번 주소
 	Zipcode       string `json:"zipcode"`        // 우편번호
 	BuildingName  string `json:"building_name"`  // 건물명
+
+	EnglishAddress string `json:"english_address,omitempty"` // 영문 도로명 주소 (로컬 데이터셋, 도로명주소 API 정규화 결과)
+
+	BuildingManagementNumber string `json:"building_management_number,omitempty"` // 건물관리번호 (도로명주소 API)
+	LegalDongCode            string `json:"legal_dong_code,omitempty"`            // 법정동코드
+	AdminDongCode            string `json:"admin_dong_code,omitempty"`            // 행정동코드
+
+	Sido       string `json:"sido,omitempty"`        // 시·도 (예: "서울특별시")
+	Sigungu    string `json:"sigungu,omitempty"`     // 시·군·구, 일반구 포함 (예: "성남시 분당구")
+	Dong       string `json:"dong,omitempty"`        // 법정 읍·면·동
+	AdminDong  string `json:"admin_dong,omitempty"`  // 행정동
+	RoadName   string `json:"road_name,omitempty"`   // 도로명
+	BuildingNo string `json:"building_no,omitempty"` // 건물번호 (예: "110", "12-3")
+
+	RefinedAddress string `json:"refined_address,omitempty"` // Provider가 정제한 주소 원문 (vWorld refined.text)
+
+	IsMountain bool   `json:"is_mountain,omitempty"` // 산 번지 여부 (지번)
+	MainNo     string `json:"main_no,omitempty"`     // 지번 본번
+	SubNo      string `json:"sub_no,omitempty"`      // 지번 부번 (없으면 빈 값)
+
+	BuildingDong string `json:"building_dong,omitempty"` // 공동주택 동 (예: "101동", 입력 주소에서 보존)
+	BuildingUnit string `json:"building_unit,omitempty"` // 공동주택 호 (예: "1502호", 입력 주소에서 보존)
 }
 
 // ProviderAttempt Provider 시도 정보
 type ProviderAttempt struct {
-	Provider string `json:"provider"`           // Provider 이름
-	Success  bool   `json:"success"`            // 성공 여부
-	Error    string `json:"error,omitempty"`    // 에러 메시지
+	Provider   string `json:"provider"`              // Provider 이름
+	Success    bool   `json:"success"`               // 성공 여부
+	Error      string `json:"error,omitempty"`       // 에러 메시지
+	RequestURL string `json:"request_url,omitempty"` // 요청 URL (디버그 모드, API 키 제거)
 }
 
 // GeocodingResponse 지오코딩 응답
 type GeocodingResponse struct {
-	Success        bool               `json:"success"`
-	Coordinate     *Coordinate        `json:"coordinate,omitempty"`
-	AddressDetail  *AddressDetail     `json:"address_detail,omitempty"`
-	Provider       string             `json:"provider"`                                  // 최종 사용된 제공자
-	Attempts       []ProviderAttempt  `json:"attempts,omitempty"`                        // Provider 시도 내역
-	ProcessedAt    time.Time          `json:"processed_at"`
-	ProcessingTime time.Duration      `json:"processing_time_ms" swaggertype:"integer"` // 밀리초
-	Error          string             `json:"error,omitempty"`
+	Success         bool              `json:"success"`
+	Coordinate      *Coordinate       `json:"coordinate,omitempty"`
+	CoordinateValid bool              `json:"coordinate_valid"`                         // 좌표 유효성 (false면 Coordinate는 검증되지 않은 원본 값)
+	AddressDetail   *AddressDetail    `json:"address_detail,omitempty"`
+	Provider        string            `json:"provider"`                                 // 최종 사용된 제공자
+	Attempts        []ProviderAttempt `json:"attempts,omitempty"`                       // Provider 시도 내역
+	ProcessedAt     time.Time         `json:"processed_at"`
+	ProcessingTime  time.Duration     `json:"processing_time_ms" swaggertype:"integer"` // 밀리초
+	Error           string            `json:"error,omitempty"`
+	ErrorCode       string            `json:"error_code,omitempty"`                     // 에러 코드 (ErrorCode* 상수)
+	Source          string            `json:"source,omitempty"`                         // 결과 출처 (keyword: 장소명 키워드 검색)
+	MatchLevel      string            `json:"match_level,omitempty"`                    // 결과 정밀도 (ROOFTOP, STREET, REGION)
+	Confidence      float64           `json:"confidence,omitempty"`                     // 결과 신뢰도 (0~1, 높을수록 입력 주소와 정확히 일치)
+	LowConfidence   bool              `json:"low_confidence,omitempty"`                 // 신뢰도가 MinConfidence 미만이지만 더 나은 결과가 없어 반환됨
+	MatchedAddress  string            `json:"matched_address,omitempty"`                // Provider가 입력을 해석해 매칭한 정규 주소 (vWorld refined.text, Kakao address_name)
+	CRS             string            `json:"crs,omitempty"`                            // 좌표의 좌표계 (WGS84가 아닌 좌표계를 요청한 경우, 예: EPSG:5179)
+
+	RoadCoordinate   *Coordinate `json:"road_coordinate,omitempty"`   // 도로명 주소로 찾은 좌표 (도로명/지번 동시 검색 시)
+	ParcelCoordinate *Coordinate `json:"parcel_coordinate,omitempty"` // 지번 주소로 찾은 좌표 (도로명/지번 동시 검색 시)
+
+	Raw json.RawMessage `json:"raw,omitempty"` // 결과를 반환한 Provider의 원본 응답 (원본 응답을 요청한 경우, API 키 제거)
 }
 
 // BulkRequest 대량 변환 요청
 type BulkRequest struct {
-	Addresses []string `json:"addresses" binding:"required,max=100"` // 최대 100건
+	Addresses   []string `json:"addresses" binding:"required,dive,max=200" maxItems:"100"` // 최대 100건 (초과하면 핸들러가 TOO_MANY_ADDRESSES로 거부), 주소별 최대 MaxAddressLength자
+	AddressType string   `json:"address_type,omitempty"` // 주소 타입 (ROAD, PARCEL, 대소문자 무시) - 선택적, 모든 주소에 적용
 }
 
 // BulkResponse 대량 변환 응답
 type BulkResponse struct {
 	Results []*GeocodingResponse `json:"results"`
-	Summary struct {
-		Total   int `json:"total"`
-		Success int `json:"success"`
-		Failed  int `json:"failed"`
-	} `json:"summary"`
+	Summary        BulkSummary   `json:"summary"`
 	ProcessingTime time.Duration `json:"processing_time_ms" swaggertype:"integer"`
 }
 
+// BulkSummary 대량 변환 결과 집계
+type BulkSummary struct {
+	Total   int `json:"total"`
+	Success int `json:"success"`
+	Failed  int `json:"failed"`
+}
+
+// CoordinateOnlyResponse 좌표와 Provider만 담은 축약 응답 (?fields=coord, 모바일 등 작은 응답이 필요한 클라이언트용)
+type CoordinateOnlyResponse struct {
+	Coordinate *Coordinate `json:"coordinate,omitempty"`
+	Provider   string      `json:"provider,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"` // 대량 변환에서 실패한 항목의 에러 코드
+}
+
+// NewCoordinateOnlyResponse 응답에서 좌표와 Provider만 남김 (실패하면 에러 코드만)
+func NewCoordinateOnlyResponse(resp *GeocodingResponse) *CoordinateOnlyResponse {
+	if !resp.Success {
+		return &CoordinateOnlyResponse{ErrorCode: resp.ErrorCode}
+	}
+	return &CoordinateOnlyResponse{Coordinate: resp.Coordinate, Provider: resp.Provider}
+}
+
+// CoordinateOnlyBulkResponse 대량 변환 축약 응답 (?fields=coord)
+type CoordinateOnlyBulkResponse struct {
+	Results []*CoordinateOnlyResponse `json:"results"`
+	Summary BulkSummary               `json:"summary"`
+}
+
+// NewCoordinateOnlyBulkResponse 항목마다 좌표와 Provider만 남긴 대량 변환 응답
+func NewCoordinateOnlyBulkResponse(resp *BulkResponse) *CoordinateOnlyBulkResponse {
+	results := make([]*CoordinateOnlyResponse, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = NewCoordinateOnlyResponse(r)
+	}
+	return &CoordinateOnlyBulkResponse{Results: results, Summary: resp.Summary}
+}
+
+// BulkStreamItem 대량 변환 스트림의 항목별 이벤트 (SSE "result" 이벤트 데이터)
+type BulkStreamItem struct {
+	Index   int                `json:"index"`   // 요청 addresses 내 위치 (완료 순서이므로 순서대로 오지 않음)
+	Address string             `json:"address"` // 요청 주소
+	Result  *GeocodingResponse `json:"result"`  // 변환 결과 (실패 시 error, error_code 포함)
+}
+
+// NDJSONResult ndjson 대량 변환 응답의 줄 하나 (입력 줄 순서대로, 빈 줄은 건너뜀)
+// 변환을 시도한 줄은 Result, 형식이 잘못된 줄은 Error를 가진다
+type NDJSONResult struct {
+	Line    int                `json:"line"`              // 입력 줄 번호 (1부터, 빈 줄 포함)
+	Address string             `json:"address,omitempty"` // 요청 주소
+	Result  *GeocodingResponse `json:"result,omitempty"`  // 변환 결과 (실패 시 error, error_code 포함)
+	Error   *ErrorDetail       `json:"error,omitempty"`   // 줄 형식 오류 (INVALID_REQUEST)
+}
+
+// BulkStreamSummary 대량 변환 스트림의 마지막 요약 이벤트 (SSE "summary" 이벤트 데이터)
+type BulkStreamSummary struct {
+	Total            int   `json:"total"`
+	Success          int   `json:"success"`
+	Failed           int   `json:"failed"`
+	ProcessingTimeMs int64 `json:"processing_time_ms"` // 밀리초
+}
+
+// NormalizeRequest 주소 정규화 요청 (좌표 없이 정규화·검증·구성 요소 분리만 수행)
+type NormalizeRequest struct {
+	Address string `json:"address" binding:"required,max=200"` // 정규화할 주소 (최대 MaxAddressLength자)
+}
+
+// NormalizeResponse 주소 정규화 응답 (Provider를 호출하지 않으므로 할당량을 쓰지 않음)
+type NormalizeResponse struct {
+	Address       string            `json:"address"`                  // 요청 주소 원문
+	Normalized    string            `json:"normalized"`               // 정규화된 주소 (지오코딩 시 Provider에 보내는 형태)
+	Valid         bool              `json:"valid"`                    // 지오코딩 요청으로 보낼 수 있는 주소인지 여부
+	InvalidReason string            `json:"invalid_reason,omitempty"` // 유효하지 않은 사유
+	AddressType   string            `json:"address_type,omitempty"`   // 추정한 주소 타입 (ROAD, PARCEL, 판별 불가면 생략)
+	Zipcode       string            `json:"zipcode,omitempty"`        // 주소에 포함된 5자리 우편번호
+	Components    AddressComponents `json:"components"`               // 주소 구성 요소
+	Canonical     *AddressDetail    `json:"canonical,omitempty"`      // 도로명주소 API로 찾은 공식 주소 (설정하지 않았거나 찾지 못하면 생략)
+}
+
+// AddressComponents 주소 문자열을 나눈 구성 요소 (찾지 못한 요소는 생략)
+type AddressComponents struct {
+	Sido         string `json:"sido,omitempty"`           // 시·도 공식 명칭 (약칭은 확장)
+	Sigungu      string `json:"sigungu,omitempty"`        // 시·군·구, 일반구 포함 (예: "성남시 분당구")
+	EupMyeonDong string `json:"eup_myeon_dong,omitempty"` // 읍·면·동·리 (예: "애월읍 고내리")
+	RoadName     string `json:"road_name,omitempty"`      // 도로명
+	BuildingNo   string `json:"building_no,omitempty"`    // 도로명 주소 건물번호 (예: "110", "38-10")
+	LotNo        string `json:"lot_no,omitempty"`         // 지번 (예: "737", "산12-3")
+	Underground  bool   `json:"underground,omitempty"`    // 지하 건물 여부
+	BuildingName string `json:"building_name,omitempty"`  // 건물명
+	BuildingDong string `json:"building_dong,omitempty"`  // 공동주택 동 (예: "101동")
+	BuildingUnit string `json:"building_unit,omitempty"`  // 공동주택 호 (예: "1502호")
+}
+
 // ProviderResult Provider에서 반환하는 내부 결과
 type ProviderResult struct {
-	Coordinate    Coordinate
-	AddressDetail AddressDetail
-	Success       bool
-	Error         error
-}
\ No newline at end of file
+	Coordinate     Coordinate
+	AddressDetail  AddressDetail
+	Success        bool
+	Error          error
+	RequestURL     string  // 마지막 요청 URL (API 키 제거, 디버그용)
+	Source         string  // 결과 출처 (주소 검색이면 빈 값, SourceKeyword 등)
+	Confidence     float64 // Provider 자체 신뢰도 (0~1, 0이면 판단 불가)
+	MatchedAddress string  // Provider가 매칭한 정규 주소 (없으면 빈 값)
+	CRS            string  // 좌표의 좌표계 (예: "EPSG:5179", WGS84 위경도면 빈 값)
+	MatchLevel     string  // Provider가 판단한 결과 정밀도 (ROOFTOP, STREET, REGION, 빈 값이면 ROOFTOP)
+}
+
+// SourceKeyword 주소 검색 대신 키워드(장소명) 검색으로 찾은 결과
+const SourceKeyword = "keyword"
\ No newline at end of file
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// 주소 타입 (TypedGeocoder에 전달하는 값)
+const (
+	AddressTypeRoad   = "ROAD"   // 도로명 주소
+	AddressTypeParcel = "PARCEL" // 지번 주소
+)
+
+// ParseAddressTypes 허용 주소 타입 목록 검증 (대소문자 무시, 중복 제거, 입력 순서 유지)
+// 빈 목록은 제한 없음(nil)을 뜻한다
+func ParseAddressTypes(types []string) ([]string, error) {
+	var parsed []string
+	for _, t := range types {
+		upper := strings.ToUpper(strings.TrimSpace(t))
+		if upper != AddressTypeRoad && upper != AddressTypeParcel {
+			return nil, fmt.Errorf("invalid address type %q (must be %s or %s)", t, AddressTypeRoad, AddressTypeParcel)
+		}
+		if !slices.Contains(parsed, upper) {
+			parsed = append(parsed, upper)
+		}
+	}
+	return parsed, nil
+}
+
+// addressTypesKey 요청별 허용 주소 타입 context 키
+type addressTypesKey struct{}
+
+// WithAddressTypes 이 컨텍스트로 호출하는 주소 타입 지원 Provider가 시도할 주소 타입 제한
+// Provider 설정(SetAddressTypes)보다 우선하며, 빈 목록이면 Provider 설정을 따른다
+// 값은 ParseAddressTypes로 검증된 것이어야 한다
+func WithAddressTypes(ctx context.Context, types []string) context.Context {
+	if len(types) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, addressTypesKey{}, types)
+}
+
+// addressTypesFor 요청 컨텍스트에 지정된 허용 주소 타입, 없으면 Provider 설정 (nil이면 제한 없음)
+func addressTypesFor(ctx context.Context, configured []string) []string {
+	if types, ok := ctx.Value(addressTypesKey{}).([]string); ok {
+		return types
+	}
+	return configured
+}
+
+// addressTypeAllowed 주소 타입이 허용 목록에 있는지 확인 (목록이 비어 있으면 모두 허용)
+func addressTypeAllowed(allowed []string, addrType string) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, addrType)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+)
+
+// MaxBiasRadius 위치 힌트 반경 최대값 (미터, Kakao 키워드 검색 radius 상한)
+const MaxBiasRadius = 20000
+
+// Bias 결과를 특정 위치 근처로 우선하는 요청별 힌트
+// 흔한 장소명("시청역", "중앙공원")처럼 여러 곳에 있는 이름을 구분하는 데 쓴다
+type Bias struct {
+	Center model.Coordinate // 기준 위치 (WGS84)
+	Radius int              // 기준 위치에서 이 반경(미터) 안의 결과로 제한 (0이면 제한 없이 가까운 순으로 우선)
+}
+
+// Validate 기준 위치와 반경 검증
+func (b Bias) Validate() error {
+	if !utils.ValidateCoordinate(b.Center.Latitude, b.Center.Longitude) {
+		return fmt.Errorf("bias center out of range: %s", utils.FormatCoordinate(b.Center.Latitude, b.Center.Longitude))
+	}
+	if b.Radius < 0 || b.Radius > MaxBiasRadius {
+		return fmt.Errorf("bias radius must be between 0 and %d meters", MaxBiasRadius)
+	}
+	return nil
+}
+
+// String 캐시 키 등에 쓰는 문자열 표현 (예: "bias=37.566500,126.978000,500")
+func (b Bias) String() string {
+	return fmt.Sprintf("bias=%s,%d", utils.FormatCoordinate(b.Center.Latitude, b.Center.Longitude), b.Radius)
+}
+
+// biasKey 요청별 위치 힌트 context 키
+type biasKey struct{}
+
+// WithBias 이 컨텍스트로 호출하는 지오코딩에 위치 힌트 지정 (nil이면 ctx를 그대로 반환)
+// 현재는 Kakao 키워드(장소명) 검색만 힌트를 적용하며, 지원하지 않는 Provider와 검색은 무시한다
+func WithBias(ctx context.Context, bias *Bias) context.Context {
+	if bias == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, biasKey{}, *bias)
+}
+
+// biasFor 요청 컨텍스트에 지정된 위치 힌트 (없으면 ok=false)
+func biasFor(ctx context.Context) (Bias, bool) {
+	bias, ok := ctx.Value(biasKey{}).(Bias)
+	return bias, ok
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CRSWGS84 기본 출력 좌표계 (위경도)
+const CRSWGS84 = "EPSG:4326"
+
+// vworldCRS vWorld 지오코딩(getcoord)이 지원하는 출력 좌표계
+var vworldCRS = []string{
+	// 경위도 (WGS84, GRS80, Korean 1995, Bessel)
+	CRSWGS84, "EPSG:4019", "EPSG:4166", "EPSG:4004",
+	// Web Mercator
+	"EPSG:3857", "EPSG:900913",
+	// UTM-K, UTM 51N·52N
+	"EPSG:5179", "EPSG:32651", "EPSG:32652",
+	// TM 원점 (GRS80, 5180~5184는 구 가산값)
+	"EPSG:5180", "EPSG:5181", "EPSG:5182", "EPSG:5183", "EPSG:5184",
+	"EPSG:5185", "EPSG:5186", "EPSG:5187", "EPSG:5188",
+	// TM 원점 (Bessel)
+	"EPSG:2096", "EPSG:2097", "EPSG:2098",
+}
+
+// ParseVWorldCRS 출력 좌표계 값 검증 (대소문자 무시, "EPSG:5179" 형식으로 정규화)
+// 빈 값이면 CRSWGS84
+func ParseVWorldCRS(crs string) (string, error) {
+	upper := strings.ToUpper(strings.TrimSpace(crs))
+	if upper == "" {
+		return CRSWGS84, nil
+	}
+	if !slices.Contains(vworldCRS, upper) {
+		return "", fmt.Errorf("unsupported CRS %q (vWorld supports %s)", crs, strings.Join(vworldCRS, ", "))
+	}
+	return upper, nil
+}
+
+// crsKey 요청별 출력 좌표계 context 키
+type crsKey struct{}
+
+// WithCRS 이 컨텍스트로 호출하는 vWorld 지오코딩의 출력 좌표계 지정
+// 값은 ParseVWorldCRS로 정규화된 것이어야 하며, 빈 값이나 CRSWGS84면 ctx를 그대로 반환한다
+// 좌표계를 지원하지 않는 Provider는 무시하고 WGS84 좌표를 반환하므로 호출자가 Provider를 제한해야 한다
+func WithCRS(ctx context.Context, crs string) context.Context {
+	if crs == "" || crs == CRSWGS84 {
+		return ctx
+	}
+	return context.WithValue(ctx, crsKey{}, crs)
+}
+
+// crsFor 요청 컨텍스트에 지정된 출력 좌표계, 없으면 CRSWGS84
+func crsFor(ctx context.Context) string {
+	if crs, ok := ctx.Value(crsKey{}).(string); ok {
+		return crs
+	}
+	return CRSWGS84
+}
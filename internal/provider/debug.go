@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"net/url"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+
+	"go.uber.org/zap"
+)
+
+// redactedParams API 키를 담는 쿼리 파라미터 이름
+var redactedParams = []string{"key", "confmKey", "apiKey", "appkey"}
+
+// RedactURL 요청 URL에서 API 키 쿼리 파라미터 값을 가림
+// 파싱할 수 없는 URL은 키 유출을 막기 위해 빈 문자열 반환
+func RedactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	query := u.Query()
+	for _, name := range redactedParams {
+		if query.Has(name) {
+			query.Set(name, "REDACTED")
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// withRequestURL 결과 또는 분류된 에러에 (키가 제거된) 요청 URL 첨부
+func withRequestURL(result *model.ProviderResult, err error, requestURL string) (*model.ProviderResult, error) {
+	if result != nil {
+		result.RequestURL = requestURL
+	}
+	if ce, ok := IsClassifiedError(err); ok {
+		ce.RequestURL = requestURL
+	}
+	return result, err
+}
+
+// logExchange Provider 요청 URL(API 키 제거)과 응답 요약을 디버그 레벨로 기록
+// 디버그 레벨이 꺼져 있으면 URL 가공도 하지 않는다
+func logExchange(log *zap.Logger, provider, requestURL string, statusCode int, summary ...zap.Field) {
+	ce := log.Check(zap.DebugLevel, "Provider response")
+	if ce == nil {
+		return
+	}
+	fields := append([]zap.Field{
+		zap.String("provider", provider),
+		zap.String("request_url", RedactURL(requestURL)),
+		zap.Int("http_status", statusCode),
+	}, summary...)
+	ce.Write(fields...)
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{
+			name: "vWorld key",
+			url:  "https://api.vworld.kr/req/address?address=test&key=secret-key",
+			want: "https://api.vworld.kr/req/address?address=test&key=REDACTED",
+		},
+		{
+			name: "Juso confmKey",
+			url:  "https://www.juso.go.kr/addrlink/addrLinkApi.do?confmKey=secret-key&keyword=test",
+			want: "https://www.juso.go.kr/addrlink/addrLinkApi.do?confmKey=REDACTED&keyword=test",
+		},
+		{
+			name: "no key",
+			url:  "https://dapi.kakao.com/v2/local/search/address.json?query=test",
+			want: "https://dapi.kakao.com/v2/local/search/address.json?query=test",
+		},
+		{
+			name: "unparsable",
+			url:  "://bad url",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RedactURL(tt.url))
+		})
+	}
+}
+
+func TestVWorldProvider_Geocode_RequestURLRedacted(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		return vworldSuccessResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.Geocode(context.Background(), "서울특별시 강남구 역삼동 737")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Contains(t, result.RequestURL, server.URL)
+	assert.Contains(t, result.RequestURL, "key=REDACTED")
+	assert.NotContains(t, result.RequestURL, "test-key")
+}
+
+func TestKakaoProvider_Geocode_RawCapture(t *testing.T) {
+	const body = `{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535","echo":"test-key"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	ctx, capture := WithRawCapture(context.Background())
+	result, err := p.Geocode(ctx, "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	require.True(t, result.Success, "본문을 먼저 읽어도 파싱 결과는 같음")
+	assert.InDelta(t, 37.566535, result.Coordinate.Latitude, 1e-9)
+	assert.JSONEq(t, strings.ReplaceAll(body, "test-key", "REDACTED"), string(capture.Body()))
+}
+
+func TestVWorldProvider_Geocode_RequestURLOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+
+	_, err := p.Geocode(context.Background(), "서울특별시 강남구 테헤란로 152")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Contains(t, ce.RequestURL, "key=REDACTED")
+	assert.NotContains(t, ce.RequestURL, "test-key")
+}
+
+func TestKakaoProvider_Geocode_RequestURLKeyFree(t *testing.T) {
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("kakao-secret", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	result, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	// 키는 헤더로만 전송되고 URL에는 포함되지 않아야 함
+	assert.Equal(t, "KakaoAK kakao-secret", authHeader)
+	assert.Contains(t, result.RequestURL, server.URL)
+	assert.Contains(t, result.RequestURL, "query=")
+	assert.NotContains(t, result.RequestURL, "kakao-secret")
+}
+
+func TestVWorldProvider_Geocode_LogsExchangeAtDebug(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		return vworldSuccessResponse
+	})
+
+	for _, tt := range []struct {
+		level   zapcore.Level
+		wantLog bool
+	}{
+		{zapcore.DebugLevel, true},
+		{zapcore.InfoLevel, false},
+	} {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			core, logs := observer.New(tt.level)
+			p := NewVWorldProvider("test-key", httpclient.NewClient(0), zap.New(core))
+			p.baseURL = server.URL
+
+			_, err := p.Geocode(context.Background(), "서울특별시 강남구 역삼동 737")
+			require.NoError(t, err)
+
+			entries := logs.FilterMessage("Provider response").All()
+			if !tt.wantLog {
+				assert.Empty(t, entries)
+				return
+			}
+			require.Len(t, entries, 1)
+			fields := entries[0].ContextMap()
+			assert.Equal(t, zapcore.DebugLevel, entries[0].Level)
+			assert.Equal(t, "vWorld", fields["provider"])
+			assert.Contains(t, fields["request_url"], "key=REDACTED")
+			assert.NotContains(t, fields["request_url"], "test-key")
+			assert.Equal(t, int64(http.StatusOK), fields["http_status"])
+			assert.Equal(t, "OK", fields["status"])
+			for _, entry := range logs.All() {
+				for _, value := range entry.ContextMap() {
+					assert.NotContains(t, fmt.Sprint(value), "test-key")
+				}
+			}
+		})
+	}
+}
+
+func TestKakaoProvider_Geocode_LogsExchangeAtDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	p := NewKakaoProvider("kakao-secret", httpclient.NewClient(0), zap.New(core))
+	p.baseURL = server.URL
+
+	_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.NoError(t, err)
+
+	entries := logs.FilterMessage("Provider response").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "Kakao", fields["provider"])
+	assert.Contains(t, fields["request_url"], server.URL)
+	assert.NotContains(t, fields["request_url"], "kakao-secret")
+	assert.Equal(t, int64(1), fields["total_count"])
+	assert.Equal(t, int64(1), fields["documents"])
+}
+
+func TestKakaoProvider_Geocode_LogsErrorResponseAtDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	p := NewKakaoProvider("kakao-secret", httpclient.NewClient(0), zap.New(core))
+	p.baseURL = server.URL
+
+	_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+	require.Error(t, err)
+
+	// 상태 코드 확인에서 실패한 응답도 기록
+	entries := logs.FilterMessage("Provider response").All()
+	require.NotEmpty(t, entries)
+	assert.Equal(t, int64(http.StatusBadGateway), entries[0].ContextMap()["http_status"])
+}
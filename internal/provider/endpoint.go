@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// NormalizeBaseURL API 기본 URL(스킴+호스트, 선택적 경로 접두사) 검증 및 정규화
+// 모의 서버, 미러, 프록시로 요청을 보낼 때 사용하며 끝의 "/"는 제거
+func NormalizeBaseURL(rawURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid base URL %q: scheme must be http or https", rawURL)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid base URL %q: missing host", rawURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid base URL %q: query and fragment are not allowed", rawURL)
+	}
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
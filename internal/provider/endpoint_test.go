@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNormalizeBaseURL(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "https://dapi.kakao.com", want: "https://dapi.kakao.com"},
+		{input: "http://localhost:8080/", want: "http://localhost:8080"},
+		{input: " https://proxy.example.com/kakao/ ", want: "https://proxy.example.com/kakao"},
+		{input: "localhost:8080", wantErr: true},
+		{input: "ftp://example.com", wantErr: true},
+		{input: "https://", wantErr: true},
+		{input: "https://example.com?key=1", wantErr: true},
+		{input: "://bad", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeBaseURL(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKakaoProvider_SetBaseURL(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	require.NoError(t, p.SetBaseURL(server.URL+"/mirror"))
+	p.SetKeywordFallback(true)
+
+	_, err := p.Geocode(context.Background(), "잠실종합운동장")
+	require.NoError(t, err)
+	_, err = p.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 37.5, Longitude: 127.0})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/mirror/v2/local/search/address.json",
+		"/mirror/v2/local/search/keyword.json",
+		"/mirror/v2/local/geo/coord2address.json",
+	}, paths)
+
+	assert.Error(t, p.SetBaseURL("not a url"))
+}
+
+func TestVWorldProvider_SetBaseURL(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"response":{"status":"NOT_FOUND"}}`))
+	}))
+	defer server.Close()
+
+	p := NewVWorldProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	require.NoError(t, p.SetBaseURL(server.URL))
+
+	_, err := p.GeocodeWithType(context.Background(), "서울특별시 중구 세종대로 110", "ROAD")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/req/address", path)
+}
@@ -1,8 +1,15 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // ErrorType 에러 분류
@@ -24,6 +31,48 @@ type ClassifiedError struct {
 	Original  error
 	Retriable bool // 재시도 가능 여부
 	Fallback  bool // 다음 Provider로 폴백 가능 여부
+
+	RequestURL string // 에러가 발생한 요청 URL (API 키 제거, 디버그용)
+
+	RetryAfter time.Duration // 한도 초과 시 Retry-After 헤더로 받은 대기 시간 (없으면 0, 0초나 지난 시각이면 MinRateLimitCooldown)
+
+	StatusCode int    // 에러로 처리된 HTTP 응답의 상태 코드 (HTTP 응답을 받지 못했으면 0)
+	Body       string // 200이 아니거나 JSON이 아닌 HTTP 응답 본문 앞부분 (최대 MaxErrorBodyBytes, API 키 제거, 디버그용)
+}
+
+// MaxErrorBodyBytes ClassifiedError.Body에 담는 응답 본문 최대 바이트 수
+const MaxErrorBodyBytes = 512
+
+// maxErrorBodyRead 200이 아닌 응답에서 읽는 본문 최대 바이트 수 (에러 응답 파싱용)
+const maxErrorBodyRead = 64 << 10
+
+// readErrorBody 200이 아닌 응답의 본문을 읽음 (최대 maxErrorBodyRead, 읽기 실패 시 읽은 부분까지)
+func readErrorBody(resp *http.Response) []byte {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyRead))
+	return body
+}
+
+// withResponse 에러에 HTTP 상태 코드와 응답 본문 첨부
+// 본문에 secrets(API 키)가 포함되어 있으면 가린 뒤 MaxErrorBodyBytes로 자른다
+func (ce *ClassifiedError) withResponse(statusCode int, body []byte, secrets ...string) *ClassifiedError {
+	ce.StatusCode = statusCode
+
+	text := string(body)
+	for _, secret := range secrets {
+		if secret != "" {
+			text = strings.ReplaceAll(text, secret, "REDACTED")
+		}
+	}
+	if len(text) > MaxErrorBodyBytes {
+		// 멀티바이트 문자 중간에서 자르지 않도록 문자 경계까지 되돌림
+		cut := MaxErrorBodyBytes
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut]
+	}
+	ce.Body = text
+	return ce
 }
 
 func (ce *ClassifiedError) Error() string {
@@ -49,6 +98,46 @@ func (t ErrorType) String() string {
 	}
 }
 
+// decodeSnippetBytes JSON 파싱 실패 시 에러에 담기 위해 보관하는 응답 본문 앞부분 크기
+// (API 키를 가린 뒤 MaxErrorBodyBytes로 자르므로 그보다 넉넉하게 보관)
+const decodeSnippetBytes = 4 << 10
+
+// headBuffer 처음 limit 바이트까지만 보관하는 io.Writer (나머지는 버림)
+type headBuffer struct {
+	buf   []byte
+	limit int
+}
+
+func (h *headBuffer) Write(p []byte) (int, error) {
+	if room := h.limit - len(h.buf); room > 0 {
+		h.buf = append(h.buf, p[:min(room, len(p))]...)
+	}
+	return len(p), nil
+}
+
+// decodeResponse 200 응답 본문을 JSON으로 파싱
+// 게이트웨이 에러 페이지(HTML) 등 JSON이 아니면 Content-Type과 본문 앞부분(secrets 제거)을 담은
+// SYSTEM_FAILURE 에러를 반환해 다음 Provider로 폴백하게 한다
+// 요청 컨텍스트에 RawCapture가 있으면 본문 전체를 읽어 (secrets를 가려) 담은 뒤 파싱한다
+func decodeResponse(resp *http.Response, v any, name string, secrets ...string) error {
+	var body io.Reader = resp.Body
+	if capture := rawCaptureFor(resp.Request); capture != nil {
+		raw, _ := io.ReadAll(resp.Body)
+		capture.set(raw, secrets...)
+		body = bytes.NewReader(raw)
+	}
+
+	head := &headBuffer{limit: decodeSnippetBytes}
+	if err := json.NewDecoder(io.TeeReader(body, head)).Decode(v); err != nil {
+		// 디코더는 에러 지점까지만 읽으므로 남은 본문을 보관 한도까지 더 읽는다
+		io.CopyN(head, body, int64(head.limit-len(head.buf)))
+		ce := NewClassifiedError(ErrorTypeSystemFailure,
+			fmt.Sprintf("failed to decode %s response (Content-Type %q)", name, resp.Header.Get("Content-Type")), err)
+		return ce.withResponse(resp.StatusCode, head.buf, secrets...)
+	}
+	return nil
+}
+
 // NewClassifiedError 에러 분류 생성자
 func NewClassifiedError(errorType ErrorType, message string, original error) *ClassifiedError {
 	ce := &ClassifiedError{
@@ -82,4 +171,8 @@ var (
 	ErrInvalidAddress  = errors.New("invalid address format")
 	ErrAPIKeyInvalid   = errors.New("API key is invalid or expired")
 	ErrQuotaExceeded   = errors.New("daily quota exceeded")
+
+	// ErrNotCovered Provider가 다루지 않는 주소 (로컬 데이터셋에 없는 주소, 허용하지 않은 주소 타입 등)
+	// 주소가 없다는 판단이 아니므로 StopOnNotFound와 관계없이 다음 Provider로 폴백한다
+	ErrNotCovered = errors.New("address not covered by provider")
 )
\ No newline at end of file
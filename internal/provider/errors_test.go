@@ -2,7 +2,9 @@ package provider
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -151,3 +153,22 @@ func TestDailyLimits(t *testing.T) {
 	assert.Equal(t, 40000, DailyLimits["vWorld"])
 	assert.Equal(t, 100000, DailyLimits["Kakao"])
 }
+
+func TestClassifiedError_WithResponse(t *testing.T) {
+	t.Run("redacts secrets", func(t *testing.T) {
+		ce := NewClassifiedError(ErrorTypeSystemFailure, "API returned status 500", nil).
+			withResponse(500, []byte("bad key secret-key"), "secret-key", "")
+
+		assert.Equal(t, 500, ce.StatusCode)
+		assert.Equal(t, "bad key REDACTED", ce.Body)
+	})
+
+	t.Run("truncates on a rune boundary", func(t *testing.T) {
+		body := strings.Repeat("a", MaxErrorBodyBytes-1) + "가나다"
+		ce := NewClassifiedError(ErrorTypeSystemFailure, "API returned status 500", nil).
+			withResponse(500, []byte(body))
+
+		assert.Equal(t, strings.Repeat("a", MaxErrorBodyBytes-1), ce.Body)
+		assert.True(t, utf8.ValidString(ce.Body))
+	})
+}
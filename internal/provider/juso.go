@@ -0,0 +1,219 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// JusoProvider 행정안전부 도로명주소 API 클라이언트
+//
+// 도로명주소 API는 정규화된 주소 문자열과 건물관리번호를 반환하지만 좌표는 제공하지 않는다.
+// 따라서 GeocodingProvider가 아닌 AddressNormalizer를 구현하며,
+// "정규화 후 지오코딩" 파이프라인의 앞단에서 사용한다.
+type JusoProvider struct {
+	confmKey      string
+	httpClient    *httpclient.Client
+	baseURL       string
+	logger        *zap.Logger
+	disabled      bool
+	disableReason string
+	mu            sync.RWMutex
+}
+
+// JusoResponse 도로명주소 API 응답 구조체
+type JusoResponse struct {
+	Results struct {
+		Common struct {
+			ErrorMessage string `json:"errorMessage"`
+			CountPerPage string `json:"countPerPage"`
+			TotalCount   string `json:"totalCount"`
+			ErrorCode    string `json:"errorCode"`
+			CurrentPage  string `json:"currentPage"`
+		} `json:"common"`
+		Juso []struct {
+			RoadAddr      string `json:"roadAddr"`      // 전체 도로명주소
+			RoadAddrPart1 string `json:"roadAddrPart1"` // 도로명주소 (참고항목 제외)
+			JibunAddr     string `json:"jibunAddr"`     // 지번주소
+			EngAddr       string `json:"engAddr"`       // 영문 도로명주소
+			ZipNo         string `json:"zipNo"`         // 우편번호
+			BdMgtSn       string `json:"bdMgtSn"`       // 건물관리번호
+			BdNm          string `json:"bdNm"`          // 건물명
+			AdmCd         string `json:"admCd"`         // 행정구역코드
+		} `json:"juso"`
+	} `json:"results"`
+}
+
+// NewJusoProvider 도로명주소 Provider 생성자
+func NewJusoProvider(confmKey string, httpClient *httpclient.Client, logger *zap.Logger) *JusoProvider {
+	return &JusoProvider{
+		confmKey:   confmKey,
+		httpClient: httpClient,
+		baseURL:    "https://www.juso.go.kr/addrlink/addrLinkApi.do",
+		logger:     logger,
+	}
+}
+
+func (j *JusoProvider) Name() string {
+	return "Juso"
+}
+
+// log 요청 컨텍스트의 상관관계 ID(request_id)를 붙인 로거 반환
+func (j *JusoProvider) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, j.logger)
+}
+
+func (j *JusoProvider) IsAvailable(ctx context.Context) bool {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return !j.disabled
+}
+
+// Disable Provider를 비활성화
+func (j *JusoProvider) Disable(reason string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.disabled = true
+	j.disableReason = reason
+	j.logger.Warn("Juso provider disabled",
+		zap.String("reason", reason),
+	)
+}
+
+// Enable 비활성화 상태와 사유를 해제
+func (j *JusoProvider) Enable() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.disabled = false
+	j.disableReason = ""
+	j.logger.Info("Juso provider enabled")
+}
+
+// IsDisabled Provider가 비활성화 되었는지 확인
+func (j *JusoProvider) IsDisabled() bool {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.disabled
+}
+
+// GetDisableReason 비활성화 사유 반환
+func (j *JusoProvider) GetDisableReason() string {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.disableReason
+}
+
+// Normalize 주소를 도로명주소 API의 정규화된 주소로 변환 (좌표 없음)
+func (j *JusoProvider) Normalize(ctx context.Context, address string) (*model.AddressDetail, error) {
+	// 주소 전처리
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return nil, NewClassifiedError(ErrorTypeInvalid, "empty address", ErrInvalidAddress)
+	}
+
+	// URL 파라미터
+	params := url.Values{}
+	params.Set("confmKey", j.confmKey)
+	params.Set("currentPage", "1")
+	params.Set("countPerPage", "1")
+	params.Set("keyword", address)
+	params.Set("resultType", "json")
+
+	requestURL := fmt.Sprintf("%s?%s", j.baseURL, params.Encode())
+
+	// HTTP 요청 생성
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// HTTP 요청 실행
+	resp, err := j.httpClient.DoWithRetry(req, j.httpClient.MaxRetries)
+	if err != nil {
+		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+	}
+	defer resp.Body.Close()
+
+	// 상태 코드 확인
+	if resp.StatusCode != http.StatusOK {
+		ce := NewClassifiedError(ErrorTypeSystemFailure,
+			fmt.Sprintf("API returned status %d", resp.StatusCode), nil)
+		return nil, ce.withResponse(resp.StatusCode, readErrorBody(resp), j.confmKey)
+	}
+
+	// 응답 파싱
+	var jusoResp JusoResponse
+	if err := decodeResponse(resp, &jusoResp, "Juso", j.confmKey); err != nil {
+		return nil, err
+	}
+
+	// 에러 코드 확인 (HTTP 200 이어도 errorCode로 실패를 알림)
+	if err := classifyJusoError(jusoResp.Results.Common.ErrorCode, jusoResp.Results.Common.ErrorMessage); err != nil {
+		j.log(ctx).Warn("Juso API error",
+			zap.String("error_code", jusoResp.Results.Common.ErrorCode),
+			zap.String("error_message", jusoResp.Results.Common.ErrorMessage),
+		)
+		return nil, err
+	}
+
+	// 결과 없음
+	if len(jusoResp.Results.Juso) == 0 {
+		return nil, NewClassifiedError(ErrorTypeNotFound, "no matching address", ErrAddressNotFound)
+	}
+
+	juso := jusoResp.Results.Juso[0]
+
+	j.log(ctx).Info("Juso normalization succeeded",
+		zap.String("road_address", juso.RoadAddr),
+		zap.String("total_count", jusoResp.Results.Common.TotalCount),
+	)
+
+	return &model.AddressDetail{
+		RoadAddress:              juso.RoadAddr,
+		ParcelAddress:            juso.JibunAddr,
+		Zipcode:                  juso.ZipNo,
+		BuildingName:             juso.BdNm,
+		BuildingManagementNumber: juso.BdMgtSn,
+		EnglishAddress:           juso.EngAddr,
+	}, nil
+}
+
+// classifyJusoError 도로명주소 API 에러 코드를 분류된 에러로 변환
+// 에러 코드 "0"은 정상 응답이므로 nil 반환
+func classifyJusoError(code, message string) error {
+	switch code {
+	case "", "0":
+		return nil
+	case "E0001", "E0014", "E0015":
+		// 승인되지 않은 KEY / 개발 KEY 기간 만료 등
+		return NewClassifiedError(ErrorTypeUnauthorized, message, ErrAPIKeyInvalid)
+	case "-999":
+		return NewClassifiedError(ErrorTypeSystemFailure, message, nil)
+	default:
+		// E0005(검색어 미입력), E0006(상세 주소 필요) 등 입력 관련 오류
+		return NewClassifiedError(ErrorTypeInvalid, message, ErrInvalidAddress)
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const jusoSuccessResponse = `{
+  "results": {
+    "common": {
+      "errorMessage": "정상",
+      "countPerPage": "1",
+      "totalCount": "1",
+      "errorCode": "0",
+      "currentPage": "1"
+    },
+    "juso": [
+      {
+        "roadAddr": "서울특별시 중구 세종대로 110 (태평로1가)",
+        "roadAddrPart1": "서울특별시 중구 세종대로 110",
+        "jibunAddr": "서울특별시 중구 태평로1가 31 서울특별시청",
+        "engAddr": "110 Sejong-daero, Jung-gu, Seoul",
+        "zipNo": "04524",
+        "bdMgtSn": "1114010300100310000000001",
+        "bdNm": "서울특별시청",
+        "admCd": "1114010300"
+      }
+    ]
+  }
+}`
+
+func newTestJusoProvider(t *testing.T, body string) *JusoProvider {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-key", r.URL.Query().Get("confmKey"))
+		assert.Equal(t, "json", r.URL.Query().Get("resultType"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	p := NewJusoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+	return p
+}
+
+func TestJusoProvider_ImplementsAddressNormalizer(t *testing.T) {
+	var _ AddressNormalizer = (*JusoProvider)(nil)
+}
+
+func TestJusoProvider_Normalize_Success(t *testing.T) {
+	p := newTestJusoProvider(t, jusoSuccessResponse)
+
+	detail, err := p.Normalize(context.Background(), "세종대로 110")
+
+	require.NoError(t, err)
+	require.NotNil(t, detail)
+	assert.Equal(t, "서울특별시 중구 세종대로 110 (태평로1가)", detail.RoadAddress)
+	assert.Equal(t, "서울특별시 중구 태평로1가 31 서울특별시청", detail.ParcelAddress)
+	assert.Equal(t, "04524", detail.Zipcode)
+	assert.Equal(t, "서울특별시청", detail.BuildingName)
+	assert.Equal(t, "1114010300100310000000001", detail.BuildingManagementNumber)
+	assert.Equal(t, "110 Sejong-daero, Jung-gu, Seoul", detail.EnglishAddress)
+}
+
+func TestJusoProvider_Normalize_NoResults(t *testing.T) {
+	p := newTestJusoProvider(t, `{"results":{"common":{"errorCode":"0","errorMessage":"정상","totalCount":"0"},"juso":[]}}`)
+
+	detail, err := p.Normalize(context.Background(), "존재하지 않는 주소")
+
+	require.Error(t, err)
+	assert.Nil(t, detail)
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeNotFound, ce.Type)
+}
+
+func TestJusoProvider_Normalize_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		message  string
+		wantType ErrorType
+	}{
+		{"unapproved key", "E0001", "승인되지 않은 KEY 입니다.", ErrorTypeUnauthorized},
+		{"system error", "-999", "시스템에러", ErrorTypeSystemFailure},
+		{"detail required", "E0006", "주소를 상세히 입력해 주시기 바랍니다.", ErrorTypeInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"results":{"common":{"errorCode":"` + tt.code + `","errorMessage":"` + tt.message + `"},"juso":null}}`
+			p := newTestJusoProvider(t, body)
+
+			_, err := p.Normalize(context.Background(), "서울")
+
+			require.Error(t, err)
+			ce, ok := IsClassifiedError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantType, ce.Type)
+			assert.Contains(t, ce.Message, tt.message)
+		})
+	}
+}
+
+func TestJusoProvider_Normalize_EmptyAddress(t *testing.T) {
+	p := NewJusoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+
+	_, err := p.Normalize(context.Background(), "   ")
+
+	require.Error(t, err)
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeInvalid, ce.Type)
+}
+
+func TestJusoProvider_Disable(t *testing.T) {
+	p := NewJusoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	assert.True(t, p.IsAvailable(context.Background()))
+
+	p.Disable("invalid key")
+
+	assert.False(t, p.IsAvailable(context.Background()))
+	assert.True(t, p.IsDisabled())
+	assert.Equal(t, "invalid key", p.GetDisableReason())
+}
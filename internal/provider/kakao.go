@@ -15,6 +15,7 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -23,9 +24,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
 	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
 
 	"go.uber.org/zap"
 )
@@ -35,9 +40,17 @@ type KakaoProvider struct {
 	apiKey        string
 	httpClient    *httpclient.Client
 	baseURL       string
+	reverseURL    string
+	keywordURL    string
+	keywordSearch bool   // 주소 검색 결과가 없으면 키워드(장소명) 검색으로 재시도
+	analyzeType   string // 주소 검색 analyze_type (similar 또는 exact)
+	exactFallback bool   // exact 검색 결과가 없으면 similar로 재시도
 	logger        *zap.Logger
 	disabled      bool
 	disableReason string
+	disabledUntil time.Time   // 비활성화 만료 시각 (zero면 영구 비활성화)
+	clock         clock.Clock // 현재 시각 공급자 (SetClock으로 교체)
+	stats         Stats       // Geocode 호출 통계
 	mu            sync.RWMutex
 }
 
@@ -80,19 +93,74 @@ type KakaoResponse struct {
 	} `json:"documents"`
 }
 
+// KakaoCoord2AddressResponse Kakao 좌표→주소 변환 API 응답 구조체
+type KakaoCoord2AddressResponse struct {
+	Meta struct {
+		TotalCount int `json:"total_count"`
+	} `json:"meta"`
+	Documents []struct {
+		Address *struct {
+			AddressName   string `json:"address_name"`
+			MountainYn    string `json:"mountain_yn"`
+			MainAddressNo string `json:"main_address_no"`
+			SubAddressNo  string `json:"sub_address_no"`
+		} `json:"address"`
+		RoadAddress *struct {
+			AddressName  string `json:"address_name"`
+			BuildingName string `json:"building_name"`
+			ZoneNo       string `json:"zone_no"` // 우편번호
+		} `json:"road_address"` // 도로명 주소가 없는 좌표는 null
+	} `json:"documents"`
+}
+
+// KakaoKeywordResponse Kakao 키워드(장소) 검색 API 응답 구조체
+type KakaoKeywordResponse struct {
+	Meta struct {
+		TotalCount int `json:"total_count"`
+	} `json:"meta"`
+	Documents []struct {
+		PlaceName       string `json:"place_name"`
+		AddressName     string `json:"address_name"`      // 지번 주소
+		RoadAddressName string `json:"road_address_name"` // 도로명 주소
+		X               string `json:"x"`                 // 경도
+		Y               string `json:"y"`                 // 위도
+	} `json:"documents"`
+}
+
 // KakaoErrorResponse Kakao API 에러 응답
 type KakaoErrorResponse struct {
 	ErrorType string `json:"errorType"`
 	Message   string `json:"message"`
 }
 
+const (
+	// KakaoDefaultBaseURL Kakao Local API 기본 URL
+	KakaoDefaultBaseURL = "https://dapi.kakao.com"
+
+	kakaoAddressPath = "/v2/local/search/address.json"
+	kakaoReversePath = "/v2/local/geo/coord2address.json"
+	kakaoKeywordPath = "/v2/local/search/keyword.json"
+
+	// kakaoKeywordConfidence 키워드(장소명) 검색 결과 신뢰도 (주소가 아닌 장소명 일치라 낮게 책정)
+	kakaoKeywordConfidence = 0.5
+
+	// KakaoAnalyzeSimilar 입력과 비슷한 주소까지 검색 (기본값)
+	KakaoAnalyzeSimilar = "similar"
+	// KakaoAnalyzeExact 입력과 정확히 일치하는 주소만 검색
+	KakaoAnalyzeExact = "exact"
+)
+
 // NewKakaoProvider Kakao Provider 생성자
 func NewKakaoProvider(apiKey string, httpClient *httpclient.Client, logger *zap.Logger) *KakaoProvider {
 	return &KakaoProvider{
-		apiKey:     apiKey,
-		httpClient: httpClient,
-		baseURL:    "https://dapi.kakao.com/v2/local/search/address.json",
-		logger:     logger,
+		apiKey:      apiKey,
+		httpClient:  httpClient,
+		baseURL:     KakaoDefaultBaseURL + kakaoAddressPath,
+		reverseURL:  KakaoDefaultBaseURL + kakaoReversePath,
+		keywordURL:  KakaoDefaultBaseURL + kakaoKeywordPath,
+		analyzeType: KakaoAnalyzeSimilar,
+		logger:      logger,
+		clock:       clock.Real{},
 	}
 }
 
@@ -100,10 +168,15 @@ func (k *KakaoProvider) Name() string {
 	return "Kakao"
 }
 
+// log 요청 컨텍스트의 상관관계 ID(request_id)를 붙인 로거 반환
+func (k *KakaoProvider) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, k.logger)
+}
+
 func (k *KakaoProvider) IsAvailable(ctx context.Context) bool {
 	k.mu.RLock()
 	defer k.mu.RUnlock()
-	return !k.disabled
+	return !disabledAt(k.disabled, k.disabledUntil, k.clock.Now())
 }
 
 // Disable Provider를 비활성화
@@ -112,26 +185,69 @@ func (k *KakaoProvider) Disable(reason string) {
 	defer k.mu.Unlock()
 	k.disabled = true
 	k.disableReason = reason
+	k.disabledUntil = time.Time{}
 	k.logger.Warn("Kakao provider disabled",
 		zap.String("reason", reason),
 	)
 }
 
+// DisableFor 지정한 기간 동안 Provider를 비활성화 (만료 시각은 사유에 함께 기록)
+func (k *KakaoProvider) DisableFor(reason string, d time.Duration) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.disabled = true
+	k.disabledUntil = k.clock.Now().Add(d)
+	k.disableReason = fmt.Sprintf("%s (until %s)", reason, k.disabledUntil.Format(time.RFC3339))
+	k.logger.Warn("Kakao provider disabled temporarily",
+		zap.String("reason", reason),
+		zap.Time("until", k.disabledUntil),
+	)
+}
+
+// DisabledUntil 비활성화 만료 시각 (비활성화 상태가 아니거나 영구 비활성화면 zero)
+func (k *KakaoProvider) DisabledUntil() time.Time {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if !disabledAt(k.disabled, k.disabledUntil, k.clock.Now()) {
+		return time.Time{}
+	}
+	return k.disabledUntil
+}
+
+// Enable 비활성화 상태와 사유를 해제
+func (k *KakaoProvider) Enable() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.disabled = false
+	k.disableReason = ""
+	k.disabledUntil = time.Time{}
+	k.logger.Info("Kakao provider enabled")
+}
+
 // IsDisabled Provider가 비활성화 되었는지 확인
 func (k *KakaoProvider) IsDisabled() bool {
 	k.mu.RLock()
 	defer k.mu.RUnlock()
-	return k.disabled
+	return disabledAt(k.disabled, k.disabledUntil, k.clock.Now())
 }
 
 // GetDisableReason 비활성화 사유 반환
 func (k *KakaoProvider) GetDisableReason() string {
 	k.mu.RLock()
 	defer k.mu.RUnlock()
+	if !disabledAt(k.disabled, k.disabledUntil, k.clock.Now()) {
+		return ""
+	}
 	return k.disableReason
 }
 
-func (k *KakaoProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+func (k *KakaoProvider) Geocode(ctx context.Context, address string) (result *model.ProviderResult, err error) {
+	start := k.clock.Now()
+	defer func() {
+		k.stats.Record(err == nil && result.Success, k.clock.Since(start))
+		k.stats.RecordError(err)
+	}()
+
 	// 주소 전처리
 	address = strings.TrimSpace(address)
 	if address == "" {
@@ -141,74 +257,56 @@ func (k *KakaoProvider) Geocode(ctx context.Context, address string) (*model.Pro
 		}, nil
 	}
 	
-	// URL 파라미터
-	params := url.Values{}
-	params.Set("query", address)
-	params.Set("analyze_type", "similar") // similar 또는 exact
-	params.Set("size", "10")              // 최대 10개 결과
-	
-	requestURL := fmt.Sprintf("%s?%s", k.baseURL, params.Encode())
-	
-	// HTTP 요청 생성
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-	
-	// Kakao API 인증 헤더
-	req.Header.Set("Authorization", fmt.Sprintf("KakaoAK %s", k.apiKey))
+	analyzeType := k.analyzeTypeFor(ctx)
+	requestURL := k.addressSearchURL(address, analyzeType)
+
+	// 디버그용 요청 URL 첨부 (API 키는 Authorization 헤더로만 전송되며 URL에 포함하지 않음)
+	defer func() {
+		result, err = withRequestURL(result, err, RedactURL(requestURL))
+	}()
 	
-	// HTTP 요청 실행
-	resp, err := k.httpClient.Do(req)
+	kakaoResp, err := k.searchAddress(ctx, requestURL)
 	if err != nil {
-		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-	
-	// 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		// 에러 응답 파싱 시도
-		var errResp KakaoErrorResponse
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil {
-			k.logger.Warn("Kakao API error response",
-				zap.String("error_type", errResp.ErrorType),
-				zap.String("message", errResp.Message),
-			)
-		}
-		
-		switch resp.StatusCode {
-		case http.StatusUnauthorized:
-			return nil, NewClassifiedError(ErrorTypeUnauthorized, "Invalid API key", ErrAPIKeyInvalid)
-		case http.StatusBadRequest:
-			return nil, NewClassifiedError(ErrorTypeInvalid, "Bad request", nil)
-		case http.StatusTooManyRequests:
-			return nil, NewClassifiedError(ErrorTypeRateLimitExceeded, "Rate limit exceeded", ErrQuotaExceeded)
-		default:
-			return nil, NewClassifiedError(ErrorTypeSystemFailure,
-				fmt.Sprintf("API returned status %d", resp.StatusCode), nil)
+
+	// exact 검색 결과가 없으면 설정에 따라 similar로 재시도
+	if len(kakaoResp.Documents) == 0 && analyzeType == KakaoAnalyzeExact && k.exactFallback {
+		k.log(ctx).Debug("Kakao exact search returned no results, retrying with similar",
+			zap.String("address", address),
+		)
+		requestURL = k.addressSearchURL(address, KakaoAnalyzeSimilar)
+		kakaoResp, err = k.searchAddress(ctx, requestURL)
+		if err != nil {
+			return nil, err
 		}
 	}
 	
-	// 응답 파싱
-	var kakaoResp KakaoResponse
-	if err := json.NewDecoder(resp.Body).Decode(&kakaoResp); err != nil {
-		return nil, fmt.Errorf("failed to decode Kakao response: %w", err)
-	}
-	
 	// 결과 없음
 	if len(kakaoResp.Documents) == 0 {
-		k.logger.Debug("Kakao returned no results",
+		k.log(ctx).Debug("Kakao returned no results",
 			zap.String("address", address),
+			zap.String("analyze_type", analyzeType),
 			zap.Int("total_count", kakaoResp.Meta.TotalCount),
 		)
+		if k.keywordSearch {
+			params := url.Values{}
+			params.Set("query", address)
+			params.Set("size", "1")
+			if bias, ok := biasFor(ctx); ok {
+				setKakaoBias(params, bias)
+			}
+			requestURL = fmt.Sprintf("%s?%s", k.keywordURL, params.Encode())
+			return k.geocodeKeyword(ctx, address, requestURL)
+		}
 		return &model.ProviderResult{
 			Success: false,
 			Error:   ErrAddressNotFound,
 		}, nil
 	}
 	
-	// 첫 번째 결과 사용
-	doc := kakaoResp.Documents[0]
+	// 후보 중 입력과 가장 잘 맞는 결과 사용 (선택 규칙은 selectKakaoDocument 참고)
+	doc := kakaoResp.Documents[selectKakaoDocument(address, kakaoResp)]
 	
 	// 좌표 파싱
 	lng, err := strconv.ParseFloat(doc.X, 64)
@@ -246,7 +344,7 @@ func (k *KakaoProvider) Geocode(ctx context.Context, address string) (*model.Pro
 		}
 	}
 	
-	k.logger.Info("Kakao geocoding succeeded",
+	k.log(ctx).Info("Kakao geocoding succeeded",
 		zap.Float64("latitude", lat),
 		zap.Float64("longitude", lng),
 		zap.String("address_type", doc.AddressType),
@@ -263,7 +361,523 @@ func (k *KakaoProvider) Geocode(ctx context.Context, address string) (*model.Pro
 			ParcelAddress: parcelAddr,
 			Zipcode:       zipcode,
 			BuildingName:  buildingName,
+			LegalDongCode: doc.Address.BCode,
+			AdminDongCode: doc.Address.HCode,
+			IsMountain:    doc.Address.MountainYn == "Y",
+			MainNo:        doc.Address.MainAddressNo,
+			SubNo:         doc.Address.SubAddressNo,
+			Sido:          cmp.Or(doc.RoadAddress.Region1depthName, doc.Address.Region1depthName),
+			Sigungu:       cmp.Or(doc.RoadAddress.Region2depthName, doc.Address.Region2depthName),
+			Dong:          cmp.Or(doc.Address.Region3depthName, doc.RoadAddress.Region3depthName),
+			AdminDong:     doc.Address.Region3depthHName,
+			RoadName:      doc.RoadAddress.RoadName,
+			BuildingNo:    kakaoBuildingNo(doc.RoadAddress.MainBuildingNo, doc.RoadAddress.SubBuildingNo),
 		},
-		Success: true,
+		Success:        true,
+		Confidence:     kakaoConfidence(address, doc.AddressType, kakaoResp.Meta.TotalCount, doc.AddressName, roadAddr, parcelAddr),
+		MatchedAddress: doc.AddressName,
 	}, nil
-}
\ No newline at end of file
+}
+
+// kakaoBuildingNo 건물 본번과 부번을 건물번호로 결합 (예: "12", "3" → "12-3")
+func kakaoBuildingNo(main, sub string) string {
+	if main == "" || sub == "" || sub == "0" {
+		return main
+	}
+	return main + "-" + sub
+}
+
+// setKakaoBias 키워드 검색 파라미터에 위치 힌트 추가
+// 반경이 있으면 x, y 중심 radius 미터 안의 장소로 제한하고, 없으면 중심에서 가까운 순(sort=distance)으로 정렬한다
+// (주소 검색 API는 위치 파라미터가 없어 힌트를 적용하지 않음)
+func setKakaoBias(params url.Values, bias Bias) {
+	params.Set("x", strconv.FormatFloat(bias.Center.Longitude, 'f', -1, 64))
+	params.Set("y", strconv.FormatFloat(bias.Center.Latitude, 'f', -1, 64))
+	if bias.Radius > 0 {
+		params.Set("radius", strconv.Itoa(bias.Radius))
+	} else {
+		params.Set("sort", "distance")
+	}
+}
+
+// addressSearchURL 주소 검색 요청 URL 생성
+func (k *KakaoProvider) addressSearchURL(address, analyzeType string) string {
+	params := url.Values{}
+	params.Set("query", address)
+	params.Set("analyze_type", analyzeType) // similar 또는 exact
+	params.Set("size", "10")                // 최대 10개 결과
+	return fmt.Sprintf("%s?%s", k.baseURL, params.Encode())
+}
+
+// searchAddress 주소 검색 API 호출 후 응답 파싱
+func (k *KakaoProvider) searchAddress(ctx context.Context, requestURL string) (*KakaoResponse, error) {
+	// HTTP 요청 생성
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	
+	// Kakao API 인증 헤더
+	req.Header.Set("Authorization", fmt.Sprintf("KakaoAK %s", k.apiKey))
+	
+	// HTTP 요청 실행
+	k.stats.RecordRequest()
+	resp, err := k.httpClient.DoWithRetry(req, k.httpClient.MaxRetries)
+	if err != nil {
+		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+	}
+	defer resp.Body.Close()
+
+	// 상태 코드나 본문이 잘못된 응답도 기록되도록 반환 시점에 기록 (요약은 파싱에 성공한 경우만)
+	var summary []zap.Field
+	defer func() { logExchange(k.log(ctx), k.Name(), requestURL, resp.StatusCode, summary...) }()
+	
+	// 상태 코드 확인
+	if resp.StatusCode != http.StatusOK {
+		return nil, k.statusError(resp)
+	}
+	
+	// 응답 파싱
+	var kakaoResp KakaoResponse
+	if err := decodeResponse(resp, &kakaoResp, "Kakao", k.apiKey); err != nil {
+		return nil, err
+	}
+	summary = []zap.Field{
+		zap.Int("total_count", kakaoResp.Meta.TotalCount),
+		zap.Int("documents", len(kakaoResp.Documents)),
+	}
+	return &kakaoResp, nil
+}
+
+// kakaoConfidence Kakao 주소 검색 결과의 신뢰도 계산 (0~1)
+// analyze_type=similar 검색은 입력과 비슷한 주소도 돌려주므로, 번지까지 일치한 결과
+// (ROAD_ADDR/REGION_ADDR)와 도로명·지명만 일치한 결과(ROAD/REGION)를 구분하고,
+// 결과 주소가 입력과 글자 그대로 같으면 정확 일치로 가산, 후보가 여러 개면 감산
+func kakaoConfidence(query, addressType string, totalCount int, candidates ...string) float64 {
+	var score float64
+	switch addressType {
+	case "ROAD_ADDR", "REGION_ADDR":
+		score = 0.8
+	default:
+		score = 0.4
+	}
+
+	compactQuery := strings.ReplaceAll(query, " ", "")
+	for _, candidate := range candidates {
+		if candidate != "" && strings.ReplaceAll(candidate, " ", "") == compactQuery {
+			score += 0.15
+			break
+		}
+	}
+
+	if totalCount > 1 {
+		score -= 0.1
+	}
+	return score
+}
+
+// kakaoTypeRank address_type별 후보 우선순위 (클수록 우선)
+// 번지까지 일치한 결과를 먼저, 같은 수준이면 도로명 계열을 지명 계열보다 우선
+var kakaoTypeRank = map[string]int{
+	"ROAD_ADDR":   3,
+	"REGION_ADDR": 2,
+	"ROAD":        1,
+	"REGION":      0,
+}
+
+// selectKakaoDocument 여러 후보 중 입력 주소와 가장 잘 맞는 문서의 인덱스 반환
+// Kakao는 결과를 자체 순서로 돌려주므로 첫 번째가 지명 수준의 유사 결과이고
+// 뒤쪽에 정확한 도로명 주소가 있는 경우가 있다. 선택 규칙 (앞 규칙이 우선):
+//  1. 입력 토큰과 후보 주소(address_name, 도로명, 지번 중 하나)의 토큰이 모두 같은 정확 일치
+//     (시·도 약칭은 공식 명칭으로 맞춘 뒤 비교, 예: "서울" = "서울특별시")
+//  2. address_type 우선순위: ROAD_ADDR > REGION_ADDR > ROAD > REGION
+//  3. 입력 토큰이 후보 주소에 더 많이 포함된 부분 일치
+//  4. 모두 같으면 API가 돌려준 순서 (결국 0번 문서)
+func selectKakaoDocument(query string, resp *KakaoResponse) int {
+	queryTokens := kakaoTokens(query)
+	best, bestExact, bestType, bestMatched := 0, false, -1, -1
+	for i, doc := range resp.Documents {
+		exact, matched := false, 0
+		for _, candidate := range []string{doc.AddressName, doc.RoadAddress.AddressName, doc.Address.AddressName} {
+			if candidate == "" {
+				continue
+			}
+			e, m := matchKakaoTokens(queryTokens, kakaoTokens(candidate))
+			exact = exact || e
+			if m > matched {
+				matched = m
+			}
+		}
+		typeRank, ok := kakaoTypeRank[doc.AddressType]
+		if !ok {
+			typeRank = -1
+		}
+
+		better := false
+		switch {
+		case i == 0:
+			better = true
+		case exact != bestExact:
+			better = exact
+		case typeRank != bestType:
+			better = typeRank > bestType
+		default:
+			better = matched > bestMatched
+		}
+		if better {
+			best, bestExact, bestType, bestMatched = i, exact, typeRank, matched
+		}
+	}
+	return best
+}
+
+// kakaoTokens 주소를 비교용 토큰으로 분리 (시·도 약칭은 공식 명칭으로 확장)
+func kakaoTokens(address string) []string {
+	return strings.Fields(utils.ExpandSidoAbbreviations(address))
+}
+
+// matchKakaoTokens 입력 토큰과 후보 토큰 비교
+// exact: 두 토큰 목록이 순서까지 같음, matched: 후보에 그대로 있는 입력 토큰 수
+func matchKakaoTokens(query, candidate []string) (exact bool, matched int) {
+	set := make(map[string]struct{}, len(candidate))
+	for _, t := range candidate {
+		set[t] = struct{}{}
+	}
+	for _, t := range query {
+		if _, ok := set[t]; ok {
+			matched++
+		}
+	}
+	if len(query) != len(candidate) {
+		return false, matched
+	}
+	for i := range query {
+		if query[i] != candidate[i] {
+			return false, matched
+		}
+	}
+	return true, matched
+}
+
+// SetBaseURL API 기본 URL 변경 (모의 서버, 미러, 프록시용)
+// 주소 검색, 좌표→주소 변환, 키워드 검색 엔드포인트 모두 새 기본 URL 아래로 이동
+func (k *KakaoProvider) SetBaseURL(baseURL string) error {
+	base, err := NormalizeBaseURL(baseURL)
+	if err != nil {
+		return err
+	}
+	k.baseURL = base + kakaoAddressPath
+	k.reverseURL = base + kakaoReversePath
+	k.keywordURL = base + kakaoKeywordPath
+	return nil
+}
+
+// Stats Geocode 호출 통계 스냅샷 반환
+func (k *KakaoProvider) Stats() StatsSnapshot {
+	return k.stats.Snapshot()
+}
+
+// SetClock 비활성화 만료와 호출 통계에 쓸 시계 설정 (nil이면 시스템 시계, 사용 전에 호출)
+func (k *KakaoProvider) SetClock(c clock.Clock) {
+	k.clock = clock.OrReal(c)
+	k.stats.SetClock(c)
+}
+
+// SetKeywordFallback 주소 검색 결과가 없을 때 키워드(장소명) 검색 재시도 여부 설정
+func (k *KakaoProvider) SetKeywordFallback(enabled bool) {
+	k.keywordSearch = enabled
+}
+
+// SetAnalyzeType 주소 검색 기본 analyze_type 설정 (KakaoAnalyzeSimilar 또는 KakaoAnalyzeExact, 빈 값이면 similar)
+// 요청별로는 WithKakaoAnalyzeType으로 바꿀 수 있다
+func (k *KakaoProvider) SetAnalyzeType(analyzeType string) error {
+	analyzeType, err := ParseKakaoAnalyzeType(analyzeType)
+	if err != nil {
+		return err
+	}
+	k.analyzeType = analyzeType
+	return nil
+}
+
+// SetExactFallback exact 검색 결과가 없을 때 similar로 재시도 여부 설정
+func (k *KakaoProvider) SetExactFallback(enabled bool) {
+	k.exactFallback = enabled
+}
+
+// ParseKakaoAnalyzeType analyze_type 값 검증 (대소문자 무시, 빈 값이면 KakaoAnalyzeSimilar)
+func ParseKakaoAnalyzeType(analyzeType string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(analyzeType)) {
+	case "", KakaoAnalyzeSimilar:
+		return KakaoAnalyzeSimilar, nil
+	case KakaoAnalyzeExact:
+		return KakaoAnalyzeExact, nil
+	default:
+		return "", fmt.Errorf("invalid Kakao analyze type %q (must be %q or %q)", analyzeType, KakaoAnalyzeSimilar, KakaoAnalyzeExact)
+	}
+}
+
+// kakaoAnalyzeTypeKey 요청별 analyze_type context 키
+type kakaoAnalyzeTypeKey struct{}
+
+// WithKakaoAnalyzeType 이 컨텍스트로 호출하는 Kakao 주소 검색의 analyze_type 지정
+// 빈 값이면 Provider 기본값을 사용한다
+func WithKakaoAnalyzeType(ctx context.Context, analyzeType string) context.Context {
+	if analyzeType == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, kakaoAnalyzeTypeKey{}, analyzeType)
+}
+
+// analyzeTypeFor 요청 컨텍스트에 지정된 analyze_type, 없으면 Provider 기본값
+func (k *KakaoProvider) analyzeTypeFor(ctx context.Context) string {
+	if analyzeType, ok := ctx.Value(kakaoAnalyzeTypeKey{}).(string); ok {
+		if parsed, err := ParseKakaoAnalyzeType(analyzeType); err == nil {
+			return parsed
+		}
+	}
+	if k.analyzeType == "" {
+		return KakaoAnalyzeSimilar
+	}
+	return k.analyzeType
+}
+
+// geocodeKeyword 키워드 검색 API로 장소명(예: "잠실종합운동장")의 좌표 조회
+// 결과의 Source는 model.SourceKeyword로 표시
+func (k *KakaoProvider) geocodeKeyword(ctx context.Context, address, requestURL string) (*model.ProviderResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("KakaoAK %s", k.apiKey))
+
+	k.stats.RecordRequest()
+	resp, err := k.httpClient.DoWithRetry(req, k.httpClient.MaxRetries)
+	if err != nil {
+		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+	}
+	defer resp.Body.Close()
+
+	var summary []zap.Field
+	defer func() { logExchange(k.log(ctx), k.Name(), requestURL, resp.StatusCode, summary...) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, k.statusError(resp)
+	}
+
+	var keywordResp KakaoKeywordResponse
+	if err := decodeResponse(resp, &keywordResp, "Kakao keyword", k.apiKey); err != nil {
+		return nil, err
+	}
+	summary = []zap.Field{zap.Int("documents", len(keywordResp.Documents))}
+
+	if len(keywordResp.Documents) == 0 {
+		k.log(ctx).Debug("Kakao keyword search returned no results",
+			zap.String("address", address),
+		)
+		return &model.ProviderResult{
+			Success: false,
+			Error:   ErrAddressNotFound,
+		}, nil
+	}
+
+	doc := keywordResp.Documents[0]
+
+	lng, err := strconv.ParseFloat(doc.X, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude: %w", err)
+	}
+
+	lat, err := strconv.ParseFloat(doc.Y, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude: %w", err)
+	}
+
+	k.log(ctx).Info("Kakao keyword search succeeded",
+		zap.String("place_name", doc.PlaceName),
+		zap.Float64("latitude", lat),
+		zap.Float64("longitude", lng),
+	)
+
+	return &model.ProviderResult{
+		Coordinate: model.Coordinate{
+			Latitude:  lat,
+			Longitude: lng,
+		},
+		AddressDetail: model.AddressDetail{
+			RoadAddress:   doc.RoadAddressName,
+			ParcelAddress: doc.AddressName,
+			BuildingName:  doc.PlaceName,
+		},
+		Success:        true,
+		Source:         model.SourceKeyword,
+		Confidence:     kakaoKeywordConfidence,
+		MatchedAddress: cmp.Or(doc.RoadAddressName, doc.AddressName),
+	}, nil
+}
+
+// kakaoZipcodePageSize 우편번호 검색에서 가져오는 주소 수 (주소 검색 API 최대값)
+const kakaoZipcodePageSize = 30
+
+// GeocodeZipcode 우편번호로 주소를 검색해 구역의 대표 좌표 반환 (ZipcodeGeocoder)
+// 검색 결과 중 도로명 주소의 구역번호(zone_no)가 우편번호와 일치하는 주소들의 좌표 평균을 대표 좌표로 쓴다
+func (k *KakaoProvider) GeocodeZipcode(ctx context.Context, zipcode string) (result *model.ProviderResult, err error) {
+	start := k.clock.Now()
+	defer func() {
+		k.stats.Record(err == nil && result.Success, k.clock.Since(start))
+		k.stats.RecordError(err)
+	}()
+
+	params := url.Values{}
+	params.Set("query", zipcode)
+	params.Set("size", strconv.Itoa(kakaoZipcodePageSize))
+	requestURL := fmt.Sprintf("%s?%s", k.baseURL, params.Encode())
+
+	defer func() {
+		result, err = withRequestURL(result, err, RedactURL(requestURL))
+	}()
+
+	kakaoResp, err := k.searchAddress(ctx, requestURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var sum model.Coordinate
+	var matched int
+	var detail model.AddressDetail
+	for _, doc := range kakaoResp.Documents {
+		if doc.RoadAddress.ZoneNo != zipcode {
+			continue
+		}
+		lng, lngErr := strconv.ParseFloat(doc.X, 64)
+		lat, latErr := strconv.ParseFloat(doc.Y, 64)
+		if lngErr != nil || latErr != nil {
+			continue
+		}
+		if matched == 0 {
+			detail.Sido = doc.RoadAddress.Region1depthName
+			detail.Sigungu = doc.RoadAddress.Region2depthName
+		}
+		sum.Latitude += lat
+		sum.Longitude += lng
+		matched++
+	}
+
+	k.log(ctx).Debug("Kakao zipcode search",
+		zap.String("zipcode", zipcode),
+		zap.Int("documents", len(kakaoResp.Documents)),
+		zap.Int("matched", matched),
+	)
+	if matched == 0 {
+		return &model.ProviderResult{
+			Success: false,
+			Error:   ErrAddressNotFound,
+		}, nil
+	}
+
+	detail.Zipcode = zipcode
+	return &model.ProviderResult{
+		Coordinate: model.Coordinate{
+			Latitude:  sum.Latitude / float64(matched),
+			Longitude: sum.Longitude / float64(matched),
+		},
+		AddressDetail: detail,
+		Success:       true,
+	}, nil
+}
+
+// ReverseGeocode 좌표를 주소로 변환 (coord2address API)
+func (k *KakaoProvider) ReverseGeocode(ctx context.Context, coord model.Coordinate) (result *model.ProviderResult, err error) {
+	params := url.Values{}
+	params.Set("x", strconv.FormatFloat(coord.Longitude, 'f', -1, 64))
+	params.Set("y", strconv.FormatFloat(coord.Latitude, 'f', -1, 64))
+	params.Set("input_coord", "WGS84")
+
+	requestURL := fmt.Sprintf("%s?%s", k.reverseURL, params.Encode())
+
+	// 디버그용 요청 URL 첨부
+	defer func() {
+		result, err = withRequestURL(result, err, RedactURL(requestURL))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("KakaoAK %s", k.apiKey))
+
+	k.stats.RecordRequest()
+	resp, err := k.httpClient.DoWithRetry(req, k.httpClient.MaxRetries)
+	if err != nil {
+		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+	}
+	defer resp.Body.Close()
+
+	var summary []zap.Field
+	defer func() { logExchange(k.log(ctx), k.Name(), requestURL, resp.StatusCode, summary...) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, k.statusError(resp)
+	}
+
+	var kakaoResp KakaoCoord2AddressResponse
+	if err := decodeResponse(resp, &kakaoResp, "Kakao", k.apiKey); err != nil {
+		return nil, err
+	}
+	summary = []zap.Field{zap.Int("documents", len(kakaoResp.Documents))}
+
+	// 결과 없음 (바다 등 주소가 없는 좌표)
+	if len(kakaoResp.Documents) == 0 {
+		return &model.ProviderResult{
+			Success: false,
+			Error:   ErrAddressNotFound,
+		}, nil
+	}
+
+	doc := kakaoResp.Documents[0]
+	var detail model.AddressDetail
+	if doc.RoadAddress != nil {
+		detail.RoadAddress = doc.RoadAddress.AddressName
+		detail.BuildingName = doc.RoadAddress.BuildingName
+		detail.Zipcode = doc.RoadAddress.ZoneNo
+	}
+	if doc.Address != nil {
+		detail.ParcelAddress = doc.Address.AddressName
+		detail.IsMountain = doc.Address.MountainYn == "Y"
+		detail.MainNo = doc.Address.MainAddressNo
+		detail.SubNo = doc.Address.SubAddressNo
+	}
+
+	return &model.ProviderResult{
+		Coordinate:    coord,
+		AddressDetail: detail,
+		Success:       true,
+	}, nil
+}
+
+// statusError 200이 아닌 응답을 분류된 에러로 변환 (상태 코드와 API 키를 가린 본문 포함)
+func (k *KakaoProvider) statusError(resp *http.Response) error {
+	body := readErrorBody(resp)
+
+	// 에러 응답 파싱 시도
+	var errResp KakaoErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		k.log(resp.Request.Context()).Warn("Kakao API error response",
+			zap.String("error_type", errResp.ErrorType),
+			zap.String("message", errResp.Message),
+		)
+	}
+
+	var ce *ClassifiedError
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		ce = NewClassifiedError(ErrorTypeUnauthorized, "Invalid API key", ErrAPIKeyInvalid)
+	case http.StatusBadRequest:
+		ce = NewClassifiedError(ErrorTypeInvalid, "Bad request", nil)
+	case http.StatusTooManyRequests:
+		ce = newRateLimitError(resp, k.clock.Now())
+	default:
+		ce = NewClassifiedError(ErrorTypeSystemFailure,
+			fmt.Sprintf("API returned status %d", resp.StatusCode), nil)
+	}
+	return ce.withResponse(resp.StatusCode, body, k.apiKey)
+}
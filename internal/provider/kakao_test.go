@@ -0,0 +1,626 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestKakaoProvider 테스트 서버를 바라보는 Kakao Provider 생성
+func newTestKakaoProvider(t *testing.T, body string) *KakaoProvider {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+	return p
+}
+
+func TestKakaoProvider_Geocode_MountainParcel(t *testing.T) {
+	p := newTestKakaoProvider(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "강원특별자치도 평창군 대관령면 횡계리 산 1-5",
+			"address_type": "REGION_ADDR",
+			"x": "128.716794",
+			"y": "37.677453",
+			"address": {
+				"address_name": "강원특별자치도 평창군 대관령면 횡계리 산 1-5",
+				"b_code": "5176037021",
+				"h_code": "5176037000",
+				"mountain_yn": "Y",
+				"main_address_no": "1",
+				"sub_address_no": "5"
+			}
+		}]
+	}`)
+
+	result, err := p.Geocode(context.Background(), "강원특별자치도 평창군 대관령면 횡계리 산 1-5")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.True(t, result.AddressDetail.IsMountain)
+	assert.Equal(t, "1", result.AddressDetail.MainNo)
+	assert.Equal(t, "5", result.AddressDetail.SubNo)
+	assert.Equal(t, "강원특별자치도 평창군 대관령면 횡계리 산 1-5", result.AddressDetail.ParcelAddress)
+}
+
+func TestKakaoProvider_Geocode_RegularParcel(t *testing.T) {
+	p := newTestKakaoProvider(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "서울 중구 태평로1가 31",
+			"address_type": "REGION_ADDR",
+			"x": "126.977969",
+			"y": "37.566535",
+			"address": {
+				"address_name": "서울 중구 태평로1가 31",
+				"mountain_yn": "N",
+				"main_address_no": "31",
+				"sub_address_no": ""
+			}
+		}]
+	}`)
+
+	result, err := p.Geocode(context.Background(), "서울 중구 태평로1가 31")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.False(t, result.AddressDetail.IsMountain)
+	assert.Equal(t, "31", result.AddressDetail.MainNo)
+	assert.Empty(t, result.AddressDetail.SubNo)
+}
+
+func TestKakaoProvider_Geocode_RegionFields(t *testing.T) {
+	p := newTestKakaoProvider(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "경기 성남시 분당구 판교역로 166",
+			"address_type": "ROAD_ADDR",
+			"x": "127.110522",
+			"y": "37.395240",
+			"address": {
+				"address_name": "경기 성남시 분당구 백현동 532",
+				"region_1depth_name": "경기",
+				"region_2depth_name": "성남시 분당구",
+				"region_3depth_name": "백현동",
+				"region_3depth_h_name": "백현동"
+			},
+			"road_address": {
+				"address_name": "경기 성남시 분당구 판교역로 166",
+				"region_1depth_name": "경기",
+				"region_2depth_name": "성남시 분당구",
+				"region_3depth_name": "백현동",
+				"road_name": "판교역로",
+				"main_building_no": "166",
+				"sub_building_no": ""
+			}
+		}]
+	}`)
+
+	result, err := p.Geocode(context.Background(), "경기 성남시 분당구 판교역로 166")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, "경기", result.AddressDetail.Sido)
+	assert.Equal(t, "성남시 분당구", result.AddressDetail.Sigungu)
+	assert.Equal(t, "백현동", result.AddressDetail.Dong)
+	assert.Equal(t, "백현동", result.AddressDetail.AdminDong)
+	assert.Equal(t, "판교역로", result.AddressDetail.RoadName)
+	assert.Equal(t, "166", result.AddressDetail.BuildingNo)
+	assert.Equal(t, "12-3", kakaoBuildingNo("12", "3"))
+}
+
+func TestKakaoProvider_Geocode_MatchedAddress(t *testing.T) {
+	p := newTestKakaoProvider(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "서울 중구 세종대로 110",
+			"address_type": "ROAD_ADDR",
+			"x": "126.977969",
+			"y": "37.566535",
+			"road_address": {"address_name": "서울 중구 세종대로 110"}
+		}]
+	}`)
+
+	result, err := p.Geocode(context.Background(), "서울 중구 세종대로110")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, "서울 중구 세종대로 110", result.MatchedAddress)
+}
+
+func TestKakaoProvider_Geocode_StatusErrorCarriesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errorType":"AccessDeniedError","message":"wrong appKey(` + strings.TrimPrefix(r.Header.Get("Authorization"), "KakaoAK ") + `) format"}`))
+	}))
+	t.Cleanup(server.Close)
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	_, err := p.Geocode(context.Background(), "서울 중구 세종대로 110")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeUnauthorized, ce.Type)
+	assert.Equal(t, http.StatusUnauthorized, ce.StatusCode)
+	assert.Equal(t, `{"errorType":"AccessDeniedError","message":"wrong appKey(REDACTED) format"}`, ce.Body)
+}
+
+func TestKakaoProvider_Geocode_HTMLResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body>" + r.Header.Get("Authorization") + " 502 Bad Gateway</body></html>"))
+	}))
+	t.Cleanup(server.Close)
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	_, err := p.Geocode(context.Background(), "서울 중구 세종대로 110")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeSystemFailure, ce.Type)
+	assert.Contains(t, ce.Message, `Content-Type "text/html"`)
+	assert.Equal(t, "<html><body>KakaoAK REDACTED 502 Bad Gateway</body></html>", ce.Body)
+	assert.True(t, ce.Fallback)
+}
+
+func TestKakaoProvider_Geocode_Confidence(t *testing.T) {
+	geocode := func(t *testing.T, body, query string) float64 {
+		t.Helper()
+		result, err := newTestKakaoProvider(t, body).Geocode(context.Background(), query)
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		return result.Confidence
+	}
+
+	// 입력과 글자 그대로 일치하는 도로명 주소
+	exact := geocode(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "서울 중구 세종대로 110",
+			"address_type": "ROAD_ADDR",
+			"x": "126.977969",
+			"y": "37.566535",
+			"road_address": {"address_name": "서울 중구 세종대로 110"}
+		}]
+	}`, "서울 중구 세종대로 110")
+
+	// 유사 검색으로 찾은 다른 번지의 주소 (후보 여러 개)
+	similar := geocode(t, `{
+		"meta": {"total_count": 3},
+		"documents": [{
+			"address_name": "서울 중구 세종대로 10",
+			"address_type": "ROAD_ADDR",
+			"x": "126.975",
+			"y": "37.561",
+			"road_address": {"address_name": "서울 중구 세종대로 10"}
+		}]
+	}`, "서울 중구 세종대로 1100")
+
+	// 도로명만 일치 (번지 없음)
+	roadOnly := geocode(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"address_name": "서울 중구 세종대로",
+			"address_type": "ROAD",
+			"x": "126.976",
+			"y": "37.564"
+		}]
+	}`, "서울 중구 세종대로 9999")
+
+	assert.Greater(t, exact, similar)
+	assert.Greater(t, similar, roadOnly)
+	assert.LessOrEqual(t, exact, 1.0)
+	assert.Greater(t, roadOnly, 0.0)
+}
+
+func TestKakaoProvider_Geocode_SelectsBestDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		query    string
+		wantLat  float64
+		wantRoad string
+	}{
+		{
+			name: "도로명 결과가 지명 결과보다 우선",
+			body: `{
+				"meta": {"total_count": 2},
+				"documents": [
+					{"address_name": "서울 중구", "address_type": "REGION", "x": "126.99", "y": "37.56"},
+					{"address_name": "서울 중구 세종대로", "address_type": "ROAD", "x": "126.97", "y": "37.57",
+					 "road_address": {"address_name": "서울 중구 세종대로"}}
+				]
+			}`,
+			query:    "서울 중구 세종대로",
+			wantLat:  37.57,
+			wantRoad: "서울 중구 세종대로",
+		},
+		{
+			name: "정확 일치가 부분 일치보다 우선",
+			body: `{
+				"meta": {"total_count": 3},
+				"documents": [
+					{"address_name": "서울 중구 세종대로 10", "address_type": "ROAD_ADDR", "x": "126.975", "y": "37.561",
+					 "road_address": {"address_name": "서울 중구 세종대로 10"}},
+					{"address_name": "서울 중구 세종대로 100", "address_type": "ROAD_ADDR", "x": "126.976", "y": "37.565",
+					 "road_address": {"address_name": "서울 중구 세종대로 100"}},
+					{"address_name": "서울 중구 세종대로 110", "address_type": "ROAD_ADDR", "x": "126.977969", "y": "37.566535",
+					 "road_address": {"address_name": "서울 중구 세종대로 110"}}
+				]
+			}`,
+			query:    "서울 중구 세종대로 110",
+			wantLat:  37.566535,
+			wantRoad: "서울 중구 세종대로 110",
+		},
+		{
+			name: "시·도 약칭 차이는 정확 일치로 취급",
+			body: `{
+				"meta": {"total_count": 2},
+				"documents": [
+					{"address_name": "서울 중구 태평로1가", "address_type": "REGION", "x": "126.98", "y": "37.56"},
+					{"address_name": "서울 중구 세종대로 110", "address_type": "ROAD_ADDR", "x": "126.977969", "y": "37.566535",
+					 "road_address": {"address_name": "서울 중구 세종대로 110"}}
+				]
+			}`,
+			query:    "서울특별시 중구 세종대로 110",
+			wantLat:  37.566535,
+			wantRoad: "서울 중구 세종대로 110",
+		},
+		{
+			name: "우열이 없으면 첫 번째 결과",
+			body: `{
+				"meta": {"total_count": 2},
+				"documents": [
+					{"address_name": "서울 중구 세종대로 10", "address_type": "ROAD_ADDR", "x": "126.975", "y": "37.561",
+					 "road_address": {"address_name": "서울 중구 세종대로 10"}},
+					{"address_name": "서울 중구 세종대로 100", "address_type": "ROAD_ADDR", "x": "126.976", "y": "37.565",
+					 "road_address": {"address_name": "서울 중구 세종대로 100"}}
+				]
+			}`,
+			query:    "서울 중구 세종대로 1100",
+			wantLat:  37.561,
+			wantRoad: "서울 중구 세종대로 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := newTestKakaoProvider(t, tt.body).Geocode(context.Background(), tt.query)
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			assert.Equal(t, tt.wantLat, result.Coordinate.Latitude)
+			assert.Equal(t, tt.wantRoad, result.AddressDetail.RoadAddress)
+		})
+	}
+}
+
+func TestKakaoProvider_ReverseGeocode(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"meta": {"total_count": 1},
+			"documents": [{
+				"road_address": {
+					"address_name": "서울특별시 중구 세종대로 110",
+					"building_name": "서울특별시청",
+					"zone_no": "04524"
+				},
+				"address": {
+					"address_name": "서울 중구 태평로1가 31",
+					"mountain_yn": "N",
+					"main_address_no": "31",
+					"sub_address_no": ""
+				}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.reverseURL = server.URL
+
+	result, err := p.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 37.566535, Longitude: 126.977969})
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Contains(t, query, "x=126.977969")
+	assert.Contains(t, query, "y=37.566535")
+	assert.Equal(t, 37.566535, result.Coordinate.Latitude)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", result.AddressDetail.RoadAddress)
+	assert.Equal(t, "서울 중구 태평로1가 31", result.AddressDetail.ParcelAddress)
+	assert.Equal(t, "04524", result.AddressDetail.Zipcode)
+	assert.Equal(t, "31", result.AddressDetail.MainNo)
+}
+
+func TestKakaoProvider_ReverseGeocode_NoResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.reverseURL = server.URL
+
+	result, err := p.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 35.0, Longitude: 130.0})
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrAddressNotFound)
+}
+
+// newKeywordTestKakaoProvider 주소 검색은 결과가 없고 키워드 검색은 keywordBody를 반환하는 Kakao Provider 생성
+func newKeywordTestKakaoProvider(t *testing.T, keywordBody string) *KakaoProvider {
+	emptyAddress := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+	}))
+	t.Cleanup(emptyAddress.Close)
+
+	keyword := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "잠실종합운동장", r.URL.Query().Get("query"))
+		assert.Equal(t, "KakaoAK test-key", r.Header.Get("Authorization"))
+		w.Write([]byte(keywordBody))
+	}))
+	t.Cleanup(keyword.Close)
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = emptyAddress.URL
+	p.keywordURL = keyword.URL
+	return p
+}
+
+func TestKakaoProvider_Geocode_KeywordFallback(t *testing.T) {
+	p := newKeywordTestKakaoProvider(t, `{
+		"meta": {"total_count": 1},
+		"documents": [{
+			"place_name": "잠실종합운동장",
+			"address_name": "서울 송파구 잠실동 10",
+			"road_address_name": "서울 송파구 올림픽로 25",
+			"x": "127.073139",
+			"y": "37.515706"
+		}]
+	}`)
+	p.SetKeywordFallback(true)
+
+	result, err := p.Geocode(context.Background(), "잠실종합운동장")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, model.SourceKeyword, result.Source)
+	assert.Equal(t, kakaoKeywordConfidence, result.Confidence)
+	assert.InDelta(t, 37.515706, result.Coordinate.Latitude, 1e-9)
+	assert.InDelta(t, 127.073139, result.Coordinate.Longitude, 1e-9)
+	assert.Equal(t, "잠실종합운동장", result.AddressDetail.BuildingName)
+	assert.Equal(t, "서울 송파구 올림픽로 25", result.AddressDetail.RoadAddress)
+	assert.Equal(t, "서울 송파구 잠실동 10", result.AddressDetail.ParcelAddress)
+	assert.Contains(t, result.RequestURL, p.keywordURL)
+
+	// 할당량은 요청 단위로 차감되므로 주소 검색과 키워드 검색을 각각 센다
+	stats := p.Stats()
+	assert.Equal(t, int64(1), stats.Calls)
+	assert.Equal(t, int64(2), stats.CallsToday)
+}
+
+func TestKakaoProvider_Geocode_KeywordFallbackDisabled(t *testing.T) {
+	p := newKeywordTestKakaoProvider(t, `{"meta":{"total_count":1},"documents":[{"place_name":"잠실종합운동장","x":"127.073139","y":"37.515706"}]}`)
+
+	result, err := p.Geocode(context.Background(), "잠실종합운동장")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrAddressNotFound)
+	assert.Empty(t, result.Source)
+}
+
+func TestKakaoProvider_Geocode_KeywordFallbackNoResult(t *testing.T) {
+	p := newKeywordTestKakaoProvider(t, `{"meta":{"total_count":0},"documents":[]}`)
+	p.SetKeywordFallback(true)
+
+	result, err := p.Geocode(context.Background(), "잠실종합운동장")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrAddressNotFound)
+}
+
+func TestKakaoProvider_Geocode_KeywordFallbackBias(t *testing.T) {
+	var got url.Values
+	emptyAddress := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("x"), "주소 검색은 위치 힌트를 지원하지 않음")
+		w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+	}))
+	defer emptyAddress.Close()
+	keyword := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"place_name":"시청역","x":"126.977041","y":"37.565643"}]}`))
+	}))
+	defer keyword.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = emptyAddress.URL
+	p.keywordURL = keyword.URL
+	p.SetKeywordFallback(true)
+
+	tests := []struct {
+		name string
+		bias *Bias
+		want map[string]string
+	}{
+		{
+			name: "힌트 없음",
+			want: map[string]string{"x": "", "y": "", "radius": "", "sort": ""},
+		},
+		{
+			name: "반경 제한",
+			bias: &Bias{Center: model.Coordinate{Latitude: 37.5665, Longitude: 126.978}, Radius: 2000},
+			want: map[string]string{"x": "126.978", "y": "37.5665", "radius": "2000", "sort": ""},
+		},
+		{
+			name: "반경 없이 가까운 순",
+			bias: &Bias{Center: model.Coordinate{Latitude: 35.1151, Longitude: 129.0414}},
+			want: map[string]string{"x": "129.0414", "y": "35.1151", "radius": "", "sort": "distance"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			ctx := WithBias(context.Background(), tt.bias)
+
+			result, err := p.Geocode(ctx, "시청역")
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			require.NotNil(t, got)
+			assert.Equal(t, "시청역", got.Get("query"))
+			for param, want := range tt.want {
+				assert.Equal(t, want, got.Get(param), param)
+			}
+		})
+	}
+}
+
+func TestKakaoProvider_GeocodeZipcode(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		if query.Get("query") != "04524" {
+			w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+			return
+		}
+		w.Write([]byte(`{"meta":{"total_count":3},"documents":[
+			{"address_name":"서울 중구 세종대로 110","x":"126.978","y":"37.566","road_address":{"address_name":"서울 중구 세종대로 110","region_1depth_name":"서울","region_2depth_name":"중구","zone_no":"04524"}},
+			{"address_name":"서울 중구 세종대로 124","x":"126.980","y":"37.568","road_address":{"address_name":"서울 중구 세종대로 124","region_1depth_name":"서울","region_2depth_name":"중구","zone_no":"04524"}},
+			{"address_name":"서울 중구 을지로 1","x":"127.500","y":"37.900","road_address":{"address_name":"서울 중구 을지로 1","zone_no":"04500"}}
+		]}`))
+	}))
+	defer server.Close()
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	result, err := p.GeocodeZipcode(context.Background(), "04524")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, "30", query.Get("size"))
+	assert.InDelta(t, 37.567, result.Coordinate.Latitude, 1e-9, "구역번호가 다른 주소는 평균에서 제외")
+	assert.InDelta(t, 126.979, result.Coordinate.Longitude, 1e-9)
+	assert.Equal(t, "04524", result.AddressDetail.Zipcode)
+	assert.Equal(t, "서울", result.AddressDetail.Sido)
+	assert.Equal(t, "중구", result.AddressDetail.Sigungu)
+	assert.Contains(t, result.RequestURL, "query=04524")
+
+	result, err = p.GeocodeZipcode(context.Background(), "63309")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrAddressNotFound)
+}
+
+func TestBias_Validate(t *testing.T) {
+	center := model.Coordinate{Latitude: 37.5665, Longitude: 126.978}
+	assert.NoError(t, Bias{Center: center}.Validate())
+	assert.NoError(t, Bias{Center: center, Radius: MaxBiasRadius}.Validate())
+	assert.Error(t, Bias{Center: center, Radius: -1}.Validate())
+	assert.Error(t, Bias{Center: center, Radius: MaxBiasRadius + 1}.Validate())
+	assert.Error(t, Bias{Center: model.Coordinate{Latitude: 91, Longitude: 126.978}}.Validate())
+}
+
+func TestKakaoProvider_Geocode_AnalyzeType(t *testing.T) {
+	const found = `{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535","road_address":{"address_name":"서울 중구 세종대로 110"}}]}`
+	const empty = `{"meta":{"total_count":0},"documents":[]}`
+
+	// analyze_type별 응답을 지정하고 요청된 analyze_type 순서를 기록하는 Provider
+	newProvider := func(t *testing.T, bodies map[string]string) (*KakaoProvider, *[]string) {
+		t.Helper()
+		var requested []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			analyzeType := r.URL.Query().Get("analyze_type")
+			requested = append(requested, analyzeType)
+			w.Write([]byte(bodies[analyzeType]))
+		}))
+		t.Cleanup(server.Close)
+
+		p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+		p.baseURL = server.URL
+		return p, &requested
+	}
+	bothFound := map[string]string{KakaoAnalyzeSimilar: found, KakaoAnalyzeExact: found}
+	onlySimilar := map[string]string{KakaoAnalyzeSimilar: found, KakaoAnalyzeExact: empty}
+
+	t.Run("default is similar", func(t *testing.T) {
+		p, requested := newProvider(t, bothFound)
+
+		_, err := p.Geocode(context.Background(), "서울 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"similar"}, *requested)
+	})
+
+	t.Run("provider default exact", func(t *testing.T) {
+		p, requested := newProvider(t, bothFound)
+		require.NoError(t, p.SetAnalyzeType("EXACT"))
+
+		result, err := p.Geocode(context.Background(), "서울 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"exact"}, *requested)
+		assert.Contains(t, result.RequestURL, "analyze_type=exact")
+	})
+
+	t.Run("per request override", func(t *testing.T) {
+		p, requested := newProvider(t, bothFound)
+		require.NoError(t, p.SetAnalyzeType(KakaoAnalyzeExact))
+
+		_, err := p.Geocode(WithKakaoAnalyzeType(context.Background(), KakaoAnalyzeSimilar), "서울 중구 세종대로 110")
+		require.NoError(t, err)
+		_, err = p.Geocode(WithKakaoAnalyzeType(context.Background(), ""), "서울 중구 세종대로 110")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"similar", "exact"}, *requested)
+	})
+
+	t.Run("exact without fallback", func(t *testing.T) {
+		p, requested := newProvider(t, onlySimilar)
+
+		result, err := p.Geocode(WithKakaoAnalyzeType(context.Background(), KakaoAnalyzeExact), "서울 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, []string{"exact"}, *requested)
+	})
+
+	t.Run("exact falls back to similar", func(t *testing.T) {
+		p, requested := newProvider(t, onlySimilar)
+		p.SetExactFallback(true)
+
+		result, err := p.Geocode(WithKakaoAnalyzeType(context.Background(), KakaoAnalyzeExact), "서울 중구 세종대로 110")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, []string{"exact", "similar"}, *requested)
+		assert.Contains(t, result.RequestURL, "analyze_type=similar", "URL of the request that produced the result")
+	})
+
+	t.Run("invalid analyze type", func(t *testing.T) {
+		p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+		assert.Error(t, p.SetAnalyzeType("fuzzy"))
+	})
+}
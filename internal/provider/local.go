@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+)
+
+// LocalProvider 미리 가공한 주소→좌표 데이터셋을 메모리 색인으로 올려 외부 호출 없이 찾는 Provider
+//
+// 자주 조회하는 주소나 공공 데이터로 만든 주소 DB를 체인 맨 앞에 두어 API 할당량을 아끼는 용도로 쓴다.
+// 입력 주소와 데이터셋 주소를 같은 방식으로 정규화해 정확히 일치하는 것만 찾으며,
+// 없으면 ErrNotCovered를 반환해 (StopOnNotFound 설정과 관계없이) 다음 Provider로 넘어가게 한다.
+//
+// 데이터셋은 헤더가 있는 CSV 파일이다 (SQLite는 드라이버 의존성이 없어 지원하지 않음).
+// address, latitude, longitude 열은 필수이고 road_address, parcel_address, english_address, zipcode, building_name 열은 선택이다.
+// address 외에 road_address, parcel_address도 색인하므로 같은 위치를 두 가지 주소 형식으로 찾을 수 있다.
+type LocalProvider struct {
+	index         map[string]*model.ProviderResult // 정규화한 주소 키 → 결과
+	disabled      bool
+	disableReason string
+	stats         Stats       // Geocode 호출 통계
+	clock         clock.Clock // 현재 시각 공급자 (SetClock으로 교체)
+	mu            sync.RWMutex
+}
+
+// 데이터셋 CSV 열 이름
+const (
+	localColumnAddress       = "address"
+	localColumnLatitude      = "latitude"
+	localColumnLongitude     = "longitude"
+	localColumnRoadAddress   = "road_address"
+	localColumnParcelAddress = "parcel_address"
+	localColumnEnglish       = "english_address"
+	localColumnZipcode       = "zipcode"
+	localColumnBuildingName  = "building_name"
+)
+
+// localConfidence 데이터셋과 정규화한 주소가 일치한 결과 신뢰도
+const localConfidence = 1.0
+
+// NewLocalProvider 로컬 데이터셋 Provider 생성자
+// path의 CSV 파일을 읽어 색인하며, 파일 형식이 잘못되었거나 좌표가 유효하지 않은 행이 있으면 행 번호와 함께 에러를 반환한다
+func NewLocalProvider(path string) (*LocalProvider, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".db", ".sqlite", ".sqlite3":
+		return nil, fmt.Errorf("local dataset %s: SQLite is not supported, export the dataset to CSV", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("local dataset: %w", err)
+	}
+	defer f.Close()
+
+	index, err := loadLocalIndex(f)
+	if err != nil {
+		return nil, fmt.Errorf("local dataset %s: %w", path, err)
+	}
+	return &LocalProvider{index: index, clock: clock.Real{}}, nil
+}
+
+// loadLocalIndex CSV 데이터셋을 읽어 주소 키 → 결과 색인 생성
+// 같은 키가 여러 행에 있으면 먼저 나온 행을 사용한다
+func loadLocalIndex(r io.Reader) (map[string]*model.ProviderResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{localColumnAddress, localColumnLatitude, localColumnLongitude} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing %q column", required)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	index := make(map[string]*model.ProviderResult)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		address := field(record, localColumnAddress)
+		if address == "" {
+			return nil, fmt.Errorf("line %d: empty address", line)
+		}
+		lat, err := strconv.ParseFloat(field(record, localColumnLatitude), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid latitude: %w", line, err)
+		}
+		lng, err := strconv.ParseFloat(field(record, localColumnLongitude), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid longitude: %w", line, err)
+		}
+		if !utils.ValidateCoordinate(lat, lng) {
+			return nil, fmt.Errorf("line %d: coordinate out of range: %s", line, utils.FormatCoordinate(lat, lng))
+		}
+
+		result := &model.ProviderResult{
+			Coordinate: model.Coordinate{Latitude: lat, Longitude: lng},
+			AddressDetail: model.AddressDetail{
+				RoadAddress:    field(record, localColumnRoadAddress),
+				ParcelAddress:  field(record, localColumnParcelAddress),
+				EnglishAddress: field(record, localColumnEnglish),
+				Zipcode:        field(record, localColumnZipcode),
+				BuildingName:   field(record, localColumnBuildingName),
+			},
+			Success:        true,
+			Confidence:     localConfidence,
+			MatchedAddress: address,
+		}
+		for _, alias := range []string{address, result.AddressDetail.RoadAddress, result.AddressDetail.ParcelAddress} {
+			key := localKey(alias)
+			if key == "" {
+				continue
+			}
+			if _, exists := index[key]; !exists {
+				index[key] = result
+			}
+		}
+	}
+	return index, nil
+}
+
+// localKey 색인과 조회에 쓰는 주소 키
+// 유니코드·공백·행정구역명 정규화 후 괄호 참고항목을 빼고 시·도 약칭을 확장한 뒤 공백을 모두 제거한다
+// (예: "서울 중구 세종대로 110 (태평로1가)" → "서울특별시중구세종대로110")
+func localKey(address string) string {
+	address = utils.NormalizeAddress(address)
+	address = utils.StripParentheses(address)
+	address = utils.ExpandSidoAbbreviations(address)
+	return strings.Join(strings.Fields(address), "")
+}
+
+func (l *LocalProvider) Name() string {
+	return "Local"
+}
+
+// Len 색인한 주소 수 (도로명·지번 주소 별칭 포함)
+func (l *LocalProvider) Len() int {
+	return len(l.index)
+}
+
+func (l *LocalProvider) IsAvailable(ctx context.Context) bool {
+	return !l.IsDisabled()
+}
+
+// Disable Provider를 비활성화
+func (l *LocalProvider) Disable(reason string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.disabled = true
+	l.disableReason = reason
+}
+
+// Enable 비활성화 상태와 사유를 해제
+func (l *LocalProvider) Enable() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.disabled = false
+	l.disableReason = ""
+}
+
+// IsDisabled Provider가 비활성화 되었는지 확인
+func (l *LocalProvider) IsDisabled() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.disabled
+}
+
+// GetDisableReason 비활성화 사유 반환
+func (l *LocalProvider) GetDisableReason() string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.disableReason
+}
+
+// Stats Geocode 호출 통계 스냅샷 반환
+func (l *LocalProvider) Stats() StatsSnapshot {
+	return l.stats.Snapshot()
+}
+
+// SetClock 호출 통계에 쓸 시계 설정 (nil이면 시스템 시계, 사용 전에 호출)
+func (l *LocalProvider) SetClock(c clock.Clock) {
+	l.clock = clock.OrReal(c)
+	l.stats.SetClock(c)
+}
+
+// Geocode 데이터셋에서 주소를 찾아 좌표로 변환 (없으면 Success=false, ErrNotCovered)
+func (l *LocalProvider) Geocode(ctx context.Context, address string) (result *model.ProviderResult, err error) {
+	start := l.clock.Now()
+	defer func() {
+		l.stats.Record(err == nil && result.Success, l.clock.Since(start))
+		l.stats.RecordError(err)
+	}()
+
+	key := localKey(address)
+	if key == "" {
+		return nil, NewClassifiedError(ErrorTypeInvalid, "empty address", ErrInvalidAddress)
+	}
+
+	found, ok := l.index[key]
+	if !ok {
+		return &model.ProviderResult{
+			Success: false,
+			Error:   ErrNotCovered,
+		}, nil
+	}
+	// 호출자가 결과를 고쳐도 색인이 바뀌지 않도록 복사본 반환
+	copied := *found
+	return &copied, nil
+}
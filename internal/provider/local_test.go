@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalProvider_Geocode(t *testing.T) {
+	p, err := NewLocalProvider(filepath.Join("testdata", "local_addresses.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, "Local", p.Name())
+	assert.True(t, p.IsAvailable(context.Background()))
+
+	tests := []struct {
+		name    string
+		address string
+		wantLat float64
+		wantLng float64
+	}{
+		{"정확히 일치", "서울특별시 중구 세종대로 110", 37.5662952, 126.9779451},
+		{"공백·시도 약칭·괄호 차이", "서울  중구 세종대로110 (태평로1가)", 37.5662952, 126.9779451},
+		{"지번 주소로 조회", "서울특별시 중구 태평로1가 31", 37.5662952, 126.9779451},
+		{"도로명 열이 없는 행", "부산 해운대구 APEC로 55", 35.1690, 129.1360},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := p.Geocode(context.Background(), tt.address)
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			assert.Equal(t, tt.wantLat, result.Coordinate.Latitude)
+			assert.Equal(t, tt.wantLng, result.Coordinate.Longitude)
+			assert.Equal(t, localConfidence, result.Confidence)
+		})
+	}
+
+	result, err := p.Geocode(context.Background(), "서울특별시 송파구 올림픽로 25")
+	require.NoError(t, err)
+	assert.Equal(t, "서울특별시 송파구 잠실동 10", result.AddressDetail.ParcelAddress)
+	assert.Equal(t, "05500", result.AddressDetail.Zipcode)
+	assert.Equal(t, "잠실종합운동장", result.AddressDetail.BuildingName)
+	assert.Equal(t, "25 Olympic-ro, Songpa-gu, Seoul", result.AddressDetail.EnglishAddress)
+	assert.Equal(t, "서울특별시 송파구 올림픽로 25", result.MatchedAddress)
+
+	stats := p.Stats()
+	assert.Equal(t, int64(5), stats.Successes)
+}
+
+func TestLocalProvider_Geocode_NotFound(t *testing.T) {
+	p, err := NewLocalProvider(filepath.Join("testdata", "local_addresses.csv"))
+	require.NoError(t, err)
+
+	result, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 111")
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrNotCovered)
+
+	_, err = p.Geocode(context.Background(), "   ")
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeInvalid, ce.Type)
+}
+
+func TestNewLocalProvider_InvalidDataset(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{"파일 없음", filepath.Join(dir, "missing.csv"), "no such file"},
+		{"SQLite", write("addresses.sqlite", ""), "SQLite is not supported"},
+		{"빈 파일", write("empty.csv", ""), "missing header row"},
+		{"필수 열 누락", write("no_lng.csv", "address,latitude\n서울특별시 중구 세종대로 110,37.5\n"), `missing "longitude" column`},
+		{"잘못된 위도", write("bad_lat.csv", "address,latitude,longitude\n서울특별시 중구 세종대로 110,abc,126.97\n"), "line 2: invalid latitude"},
+		{"범위 밖 좌표", write("range.csv", "address,latitude,longitude\n서울특별시 중구 세종대로 110,126.97,37.56\n"), "line 2: coordinate out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLocalProvider(tt.path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
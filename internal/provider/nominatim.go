@@ -0,0 +1,406 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// NominatimProvider OpenStreetMap Nominatim 검색 API 클라이언트
+//
+// 보통 직접 운영하는 Nominatim 인스턴스를 가리키며, 상용 Provider가 모두 제한에 걸렸을 때
+// 마지막 수단으로 사용한다. API 키는 없고, 사용 정책상 요청마다 식별 가능한 User-Agent를 보낸다.
+type NominatimProvider struct {
+	httpClient    *httpclient.Client
+	searchURL     string
+	userAgent     string
+	logger        *zap.Logger
+	disabled      bool
+	disableReason string
+	disabledUntil time.Time   // 비활성화 만료 시각 (zero면 영구 비활성화)
+	clock         clock.Clock // 현재 시각 공급자 (SetClock으로 교체)
+	stats         Stats       // Geocode 호출 통계
+	mu            sync.RWMutex
+}
+
+// NominatimPlace Nominatim 검색 결과 항목 (format=json&addressdetails=1)
+type NominatimPlace struct {
+	PlaceID     int64  `json:"place_id"`
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	Name        string `json:"name"`
+	PlaceRank   int    `json:"place_rank"`  // 결과 단위 (30: 건물, 26~27: 도로, 그 이하: 지역)
+	AddressType string `json:"addresstype"` // 결과 유형 (house, building, road, suburb 등)
+	Address     struct {
+		HouseNumber string `json:"house_number"`
+		Road        string `json:"road"`
+		Quarter     string `json:"quarter"`  // 동
+		Suburb      string `json:"suburb"`   // 동 (지역에 따라 quarter 대신 사용)
+		Town        string `json:"town"`     // 읍/면
+		Borough     string `json:"borough"`  // 자치구
+		County      string `json:"county"`   // 군 또는 일반구
+		City        string `json:"city"`     // 시 (특별시·광역시 포함)
+		Province    string `json:"province"` // 도
+		State       string `json:"state"`    // 도 (지역에 따라 province 대신 사용)
+		Postcode    string `json:"postcode"` // 우편번호
+	} `json:"address"`
+}
+
+const (
+	// NominatimDefaultUserAgent User-Agent를 설정하지 않았을 때 보내는 값
+	// 공개 인스턴스를 사용한다면 연락처가 포함된 값으로 바꿔야 한다 (Nominatim 사용 정책)
+	NominatimDefaultUserAgent = "k-geocode (+https://github.com/oursportsnation/k-geocode)"
+
+	nominatimSearchPath = "/search"
+
+	// nominatimConfidence 건물번호까지 일치한 결과 신뢰도 (OSM 데이터는 상용 Provider보다 부정확할 수 있음)
+	nominatimConfidence = 0.6
+	// nominatimStreetConfidence 건물번호 없이 도로·지역만 일치한 결과 신뢰도
+	nominatimStreetConfidence = 0.4
+)
+
+// NewNominatimProvider Nominatim Provider 생성자
+// baseURL은 필수이며 /search 앞부분(예: "https://nominatim.example.com")을 지정한다
+func NewNominatimProvider(baseURL string, httpClient *httpclient.Client, logger *zap.Logger) (*NominatimProvider, error) {
+	base, err := NormalizeBaseURL(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("nominatim base URL: %w", err)
+	}
+	return &NominatimProvider{
+		httpClient: httpClient,
+		searchURL:  base + nominatimSearchPath,
+		userAgent:  NominatimDefaultUserAgent,
+		logger:     logger,
+		clock:      clock.Real{},
+	}, nil
+}
+
+func (n *NominatimProvider) Name() string {
+	return "Nominatim"
+}
+
+// log 요청 컨텍스트의 상관관계 ID(request_id)를 붙인 로거 반환
+func (n *NominatimProvider) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, n.logger)
+}
+
+// SetUserAgent 요청에 보낼 User-Agent 설정 (Nominatim 사용 정책상 애플리케이션을 식별할 수 있어야 함)
+func (n *NominatimProvider) SetUserAgent(userAgent string) error {
+	userAgent = strings.TrimSpace(userAgent)
+	if userAgent == "" {
+		return errors.New("user agent must not be empty")
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.userAgent = userAgent
+	return nil
+}
+
+func (n *NominatimProvider) IsAvailable(ctx context.Context) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return !disabledAt(n.disabled, n.disabledUntil, n.clock.Now())
+}
+
+// Disable Provider를 비활성화
+func (n *NominatimProvider) Disable(reason string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.disabled = true
+	n.disableReason = reason
+	n.disabledUntil = time.Time{}
+	n.logger.Warn("Nominatim provider disabled",
+		zap.String("reason", reason),
+	)
+}
+
+// DisableFor 지정한 기간 동안 Provider를 비활성화 (만료 시각은 사유에 함께 기록)
+func (n *NominatimProvider) DisableFor(reason string, d time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.disabled = true
+	n.disabledUntil = n.clock.Now().Add(d)
+	n.disableReason = fmt.Sprintf("%s (until %s)", reason, n.disabledUntil.Format(time.RFC3339))
+	n.logger.Warn("Nominatim provider disabled temporarily",
+		zap.String("reason", reason),
+		zap.Time("until", n.disabledUntil),
+	)
+}
+
+// DisabledUntil 비활성화 만료 시각 (비활성화 상태가 아니거나 영구 비활성화면 zero)
+func (n *NominatimProvider) DisabledUntil() time.Time {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if !disabledAt(n.disabled, n.disabledUntil, n.clock.Now()) {
+		return time.Time{}
+	}
+	return n.disabledUntil
+}
+
+// Enable 비활성화 상태와 사유를 해제
+func (n *NominatimProvider) Enable() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.disabled = false
+	n.disableReason = ""
+	n.disabledUntil = time.Time{}
+	n.logger.Info("Nominatim provider enabled")
+}
+
+// IsDisabled Provider가 비활성화 되었는지 확인
+func (n *NominatimProvider) IsDisabled() bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return disabledAt(n.disabled, n.disabledUntil, n.clock.Now())
+}
+
+// GetDisableReason 비활성화 사유 반환
+func (n *NominatimProvider) GetDisableReason() string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if !disabledAt(n.disabled, n.disabledUntil, n.clock.Now()) {
+		return ""
+	}
+	return n.disableReason
+}
+
+// Stats Geocode 호출 통계 스냅샷 반환
+func (n *NominatimProvider) Stats() StatsSnapshot {
+	return n.stats.Snapshot()
+}
+
+// SetClock 비활성화 만료와 호출 통계에 쓸 시계 설정 (nil이면 시스템 시계, 사용 전에 호출)
+func (n *NominatimProvider) SetClock(c clock.Clock) {
+	n.clock = clock.OrReal(c)
+	n.stats.SetClock(c)
+}
+
+// Geocode 주소를 좌표로 변환 (한국 내 결과만 검색)
+func (n *NominatimProvider) Geocode(ctx context.Context, address string) (result *model.ProviderResult, err error) {
+	start := n.clock.Now()
+	defer func() {
+		n.stats.Record(err == nil && result.Success, n.clock.Since(start))
+		n.stats.RecordError(err)
+	}()
+
+	// 주소 전처리
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return nil, NewClassifiedError(ErrorTypeInvalid, "empty address", ErrInvalidAddress)
+	}
+
+	// URL 파라미터
+	params := url.Values{}
+	params.Set("q", address)
+	params.Set("format", "json")
+	params.Set("countrycodes", "kr")
+	params.Set("addressdetails", "1")
+	params.Set("limit", "1")
+
+	requestURL := fmt.Sprintf("%s?%s", n.searchURL, params.Encode())
+	// 디버그용 요청 URL 첨부
+	defer func() {
+		result, err = withRequestURL(result, err, requestURL)
+	}()
+
+	// HTTP 요청 생성
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	n.mu.RLock()
+	req.Header.Set("User-Agent", n.userAgent)
+	n.mu.RUnlock()
+	req.Header.Set("Accept-Language", "ko")
+
+	// HTTP 요청 실행
+	n.stats.RecordRequest()
+	resp, err := n.httpClient.DoWithRetry(req, n.httpClient.MaxRetries)
+	if err != nil {
+		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
+	}
+	defer resp.Body.Close()
+
+	// 상태 코드 확인
+	if resp.StatusCode != http.StatusOK {
+		var ce *ClassifiedError
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+			ce = newRateLimitError(resp, n.clock.Now())
+		case http.StatusForbidden:
+			// 사용 정책 위반(User-Agent 누락 등)으로 차단됨
+			ce = NewClassifiedError(ErrorTypeUnauthorized, "Request blocked by Nominatim usage policy", nil)
+		default:
+			ce = NewClassifiedError(ErrorTypeSystemFailure,
+				fmt.Sprintf("API returned status %d", resp.StatusCode), nil)
+		}
+		return nil, ce.withResponse(resp.StatusCode, readErrorBody(resp))
+	}
+
+	// 응답 파싱
+	var places []NominatimPlace
+	if err := decodeResponse(resp, &places, "Nominatim"); err != nil {
+		return nil, err
+	}
+
+	// 결과 없음
+	if len(places) == 0 {
+		n.log(ctx).Debug("Nominatim returned no results",
+			zap.String("address", address),
+		)
+		return &model.ProviderResult{
+			Success: false,
+			Error:   ErrAddressNotFound,
+		}, nil
+	}
+
+	result, err = parseNominatimPlace(places[0])
+	if err != nil {
+		return nil, err
+	}
+
+	n.log(ctx).Info("Nominatim geocoding succeeded",
+		zap.Float64("latitude", result.Coordinate.Latitude),
+		zap.Float64("longitude", result.Coordinate.Longitude),
+		zap.Int64("place_id", places[0].PlaceID),
+	)
+	return result, nil
+}
+
+// parseNominatimPlace Nominatim 검색 결과를 ProviderResult로 변환
+// OSM에는 지번 정보가 없으므로 도로명 주소와 우편번호, 건물명만 채운다
+func parseNominatimPlace(place NominatimPlace) (*model.ProviderResult, error) {
+	lat, err := strconv.ParseFloat(place.Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude: %w", err)
+	}
+	lng, err := strconv.ParseFloat(place.Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude: %w", err)
+	}
+
+	addr := place.Address
+	province := addr.Province
+	if province == "" {
+		province = addr.State
+	}
+
+	var roadAddr string
+	if addr.Road != "" {
+		roadAddr = joinAddressTokens(province, addr.City, addr.County, addr.Borough, addr.Town, addr.Road, addr.HouseNumber)
+	}
+
+	// 도가 없으면 특별시·광역시가 시·도
+	sido, city := province, addr.City
+	if sido == "" {
+		sido, city = addr.City, ""
+	}
+	if city == sido {
+		city = ""
+	}
+	dong := addr.Quarter
+	if dong == "" {
+		dong = addr.Suburb
+	}
+	if dong == "" {
+		dong = addr.Town
+	}
+
+	level := nominatimMatchLevel(place)
+	confidence := nominatimStreetConfidence
+	if level == utils.MatchLevelRooftop {
+		confidence = nominatimConfidence
+	}
+
+	return &model.ProviderResult{
+		Coordinate: model.Coordinate{
+			Latitude:  lat,
+			Longitude: lng,
+		},
+		AddressDetail: model.AddressDetail{
+			RoadAddress:  roadAddr,
+			Zipcode:      addr.Postcode,
+			BuildingName: place.Name,
+			Sido:         sido,
+			Sigungu:      joinAddressTokens(city, addr.County, addr.Borough),
+			Dong:         dong,
+			RoadName:     addr.Road,
+			BuildingNo:   addr.HouseNumber,
+		},
+		Success:    true,
+		Confidence: confidence,
+		MatchLevel: string(level),
+	}, nil
+}
+
+// nominatimMatchLevel 결과 단위(place_rank, 없으면 addresstype)로 정밀도 판단
+// 둘 다 없는 응답은 건물번호와 도로명 유무로 판단한다
+func nominatimMatchLevel(place NominatimPlace) utils.MatchLevel {
+	switch {
+	case place.PlaceRank >= 28:
+		return utils.MatchLevelRooftop
+	case place.PlaceRank >= 26:
+		return utils.MatchLevelStreet
+	case place.PlaceRank > 0:
+		return utils.MatchLevelRegion
+	}
+
+	switch place.AddressType {
+	case "house", "building":
+		return utils.MatchLevelRooftop
+	case "road":
+		return utils.MatchLevelStreet
+	case "":
+	default:
+		return utils.MatchLevelRegion
+	}
+
+	switch {
+	case place.Address.HouseNumber != "":
+		return utils.MatchLevelRooftop
+	case place.Address.Road != "":
+		return utils.MatchLevelStreet
+	default:
+		return utils.MatchLevelRegion
+	}
+}
+
+// joinAddressTokens 빈 값과 바로 앞 토큰과 같은 값을 건너뛰고 공백으로 연결
+func joinAddressTokens(tokens ...string) string {
+	parts := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token == "" || (len(parts) > 0 && parts[len(parts)-1] == token) {
+			continue
+		}
+		parts = append(parts, token)
+	}
+	return strings.Join(parts, " ")
+}
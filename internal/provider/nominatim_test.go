@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const nominatimSuccessResponse = `[
+  {
+    "place_id": 123456789,
+    "licence": "Data © OpenStreetMap contributors, ODbL 1.0. http://osm.org/copyright",
+    "osm_type": "way",
+    "osm_id": 24386475,
+    "lat": "37.5662952",
+    "lon": "126.9779451",
+    "class": "amenity",
+    "type": "townhall",
+    "place_rank": 30,
+    "importance": 0.52,
+    "addresstype": "amenity",
+    "name": "서울특별시청",
+    "display_name": "서울특별시청, 110, 세종대로, 태평로1가, 중구, 서울특별시, 04524, 대한민국",
+    "address": {
+      "amenity": "서울특별시청",
+      "house_number": "110",
+      "road": "세종대로",
+      "quarter": "태평로1가",
+      "borough": "중구",
+      "city": "서울특별시",
+      "postcode": "04524",
+      "country": "대한민국",
+      "country_code": "kr"
+    },
+    "boundingbox": ["37.5657", "37.5668", "126.9773", "126.9786"]
+  }
+]`
+
+func newTestNominatimProvider(t *testing.T, handler http.HandlerFunc) *NominatimProvider {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	p, err := NewNominatimProvider(server.URL, httpclient.NewClient(0), zap.NewNop())
+	require.NoError(t, err)
+	return p
+}
+
+func TestNewNominatimProvider_RequiresBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "nominatim.example.com", "ftp://nominatim.example.com"} {
+		p, err := NewNominatimProvider(baseURL, httpclient.NewClient(0), zap.NewNop())
+		assert.Error(t, err, baseURL)
+		assert.Nil(t, p)
+	}
+}
+
+func TestNominatimProvider_Geocode_Success(t *testing.T) {
+	p := newTestNominatimProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "서울특별시 중구 세종대로 110", query.Get("q"))
+		assert.Equal(t, "json", query.Get("format"))
+		assert.Equal(t, "kr", query.Get("countrycodes"))
+		assert.Equal(t, "1", query.Get("addressdetails"))
+		assert.Equal(t, NominatimDefaultUserAgent, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(nominatimSuccessResponse))
+	})
+
+	result, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.InDelta(t, 37.5662952, result.Coordinate.Latitude, 1e-7)
+	assert.InDelta(t, 126.9779451, result.Coordinate.Longitude, 1e-7)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", result.AddressDetail.RoadAddress)
+	assert.Empty(t, result.AddressDetail.ParcelAddress)
+	assert.Equal(t, "04524", result.AddressDetail.Zipcode)
+	assert.Equal(t, "서울특별시청", result.AddressDetail.BuildingName)
+	assert.Equal(t, nominatimConfidence, result.Confidence)
+	assert.Contains(t, result.RequestURL, "/search?")
+}
+
+func TestNominatimProvider_Geocode_CustomUserAgent(t *testing.T) {
+	p := newTestNominatimProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-app/1.0 (ops@example.com)", r.Header.Get("User-Agent"))
+		w.Write([]byte(nominatimSuccessResponse))
+	})
+	require.Error(t, p.SetUserAgent("  "))
+	require.NoError(t, p.SetUserAgent("my-app/1.0 (ops@example.com)"))
+
+	_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	require.NoError(t, err)
+}
+
+func TestNominatimProvider_Geocode_NoResults(t *testing.T) {
+	p := newTestNominatimProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+
+	result, err := p.Geocode(context.Background(), "존재하지 않는 주소")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.ErrorIs(t, result.Error, ErrAddressNotFound)
+}
+
+func TestNominatimProvider_Geocode_StatusErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		expected ErrorType
+	}{
+		{"rate limited", http.StatusTooManyRequests, ErrorTypeRateLimitExceeded},
+		{"blocked by usage policy", http.StatusForbidden, ErrorTypeUnauthorized},
+		{"server error", http.StatusInternalServerError, ErrorTypeSystemFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestNominatimProvider(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			})
+
+			result, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+			assert.Nil(t, result)
+			ce, ok := IsClassifiedError(err)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, ce.Type)
+		})
+	}
+}
+
+func TestParseNominatimPlace(t *testing.T) {
+	t.Run("province and county without house number", func(t *testing.T) {
+		var place NominatimPlace
+		place.Lat, place.Lon = "36.5760", "128.5056"
+		place.Address.Province = "경상북도"
+		place.Address.County = "안동시"
+		place.Address.Town = "풍천면"
+		place.Address.Road = "도청대로"
+
+		result, err := parseNominatimPlace(place)
+
+		require.NoError(t, err)
+		assert.Equal(t, "경상북도 안동시 풍천면 도청대로", result.AddressDetail.RoadAddress)
+		assert.Equal(t, nominatimStreetConfidence, result.Confidence)
+		assert.Equal(t, string(utils.MatchLevelStreet), result.MatchLevel)
+		assert.Equal(t, "경상북도", result.AddressDetail.Sido)
+		assert.Equal(t, "안동시", result.AddressDetail.Sigungu)
+		assert.Equal(t, "풍천면", result.AddressDetail.Dong)
+	})
+
+	t.Run("metropolitan city with building", func(t *testing.T) {
+		var place NominatimPlace
+		place.Lat, place.Lon = "37.5663", "126.9779"
+		place.PlaceRank = 30
+		place.Address.City = "서울특별시"
+		place.Address.Borough = "중구"
+		place.Address.Quarter = "태평로1가"
+		place.Address.Road = "세종대로"
+		place.Address.HouseNumber = "110"
+
+		result, err := parseNominatimPlace(place)
+
+		require.NoError(t, err)
+		assert.Equal(t, nominatimConfidence, result.Confidence)
+		assert.Equal(t, string(utils.MatchLevelRooftop), result.MatchLevel)
+		assert.Equal(t, "서울특별시", result.AddressDetail.Sido)
+		assert.Equal(t, "중구", result.AddressDetail.Sigungu)
+		assert.Equal(t, "태평로1가", result.AddressDetail.Dong)
+	})
+
+	t.Run("match level from place rank and address type", func(t *testing.T) {
+		tests := []struct {
+			rank        int
+			addressType string
+			want        utils.MatchLevel
+		}{
+			{30, "", utils.MatchLevelRooftop},
+			{26, "road", utils.MatchLevelStreet},
+			{20, "suburb", utils.MatchLevelRegion},
+			{0, "building", utils.MatchLevelRooftop},
+			{0, "road", utils.MatchLevelStreet},
+			{0, "city", utils.MatchLevelRegion},
+		}
+		for _, tt := range tests {
+			var place NominatimPlace
+			place.PlaceRank, place.AddressType = tt.rank, tt.addressType
+			// 건물번호가 있어도 결과 단위가 우선
+			place.Address.HouseNumber = "1"
+			assert.Equal(t, tt.want, nominatimMatchLevel(place), "rank %d, type %q", tt.rank, tt.addressType)
+		}
+	})
+
+	t.Run("duplicate tokens skipped", func(t *testing.T) {
+		var place NominatimPlace
+		place.Lat, place.Lon = "36.4800", "127.2890"
+		place.Address.State = "세종특별자치시"
+		place.Address.City = "세종특별자치시"
+		place.Address.Road = "한누리대로"
+		place.Address.HouseNumber = "2130"
+
+		result, err := parseNominatimPlace(place)
+
+		require.NoError(t, err)
+		assert.Equal(t, "세종특별자치시 한누리대로 2130", result.AddressDetail.RoadAddress)
+	})
+
+	t.Run("no road", func(t *testing.T) {
+		var place NominatimPlace
+		place.Lat, place.Lon = "37.5665", "126.9780"
+		place.Address.City = "서울특별시"
+
+		result, err := parseNominatimPlace(place)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.AddressDetail.RoadAddress)
+	})
+
+	t.Run("invalid coordinate", func(t *testing.T) {
+		var place NominatimPlace
+		place.Lat, place.Lon = "north", "126.9780"
+
+		_, err := parseNominatimPlace(place)
+
+		assert.Error(t, err)
+	})
+}
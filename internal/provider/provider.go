@@ -22,6 +22,9 @@ type GeocodingProvider interface {
 	// Disable Provider를 비활성화 (인증 실패 등)
 	Disable(reason string)
 
+	// Enable 비활성화 상태와 사유를 해제 (API 키 교체 후 재시작 없이 복구)
+	Enable()
+
 	// IsDisabled Provider가 비활성화 되었는지 확인
 	IsDisabled() bool
 
@@ -29,6 +32,44 @@ type GeocodingProvider interface {
 	GetDisableReason() string
 }
 
+// ReverseGeocoder 역지오코딩(좌표 → 주소) 제공자 인터페이스
+// 역지오코딩을 지원하는 GeocodingProvider가 추가로 구현한다
+type ReverseGeocoder interface {
+	// ReverseGeocode 좌표를 주소로 변환
+	// 결과가 없으면 Success=false 반환, 결과의 Coordinate는 입력 좌표
+	ReverseGeocode(ctx context.Context, coord model.Coordinate) (*model.ProviderResult, error)
+}
+
+// ZipcodeGeocoder 우편번호(구역번호)로 구역의 대표 좌표를 찾는 제공자 인터페이스
+// 우편번호 검색을 지원하는 GeocodingProvider가 추가로 구현한다
+type ZipcodeGeocoder interface {
+	// GeocodeZipcode 5자리 우편번호 구역의 대표 좌표 반환
+	// 구역에 속한 주소가 없으면 Success=false 반환
+	GeocodeZipcode(ctx context.Context, zipcode string) (*model.ProviderResult, error)
+}
+
+// TypedGeocoder 주소 타입(ROAD/PARCEL)을 지정한 지오코딩을 지원하는 제공자 인터페이스
+// 주소 타입이 지정된 요청에서 GeocodingProvider가 추가로 구현하면 Geocode 대신 사용된다
+type TypedGeocoder interface {
+	// GeocodeWithType 지정한 주소 타입으로만 주소를 좌표로 변환
+	GeocodeWithType(ctx context.Context, address string, addrType string) (*model.ProviderResult, error)
+}
+
+// AddressNormalizer 주소 정규화 제공자 인터페이스
+// 좌표 없이 정규화된 주소 정보만 반환하는 Provider (예: 도로명주소 API)가 구현한다.
+// 정규화된 도로명주소를 GeocodingProvider에 다시 넘기는 "정규화 후 지오코딩" 파이프라인에 사용
+type AddressNormalizer interface {
+	// Name Provider의 고유 이름 반환
+	Name() string
+
+	// Normalize 주소를 정규화된 주소 정보로 변환
+	// 결과가 없으면 ErrorTypeNotFound 분류 에러 반환
+	Normalize(ctx context.Context, address string) (*model.AddressDetail, error)
+
+	// IsAvailable Provider 사용 가능 여부 확인
+	IsAvailable(ctx context.Context) bool
+}
+
 // DailyLimits Provider별 일일 할당량
 var DailyLimits = map[string]int{
 	"vWorld": 40000,  // 일 4만건
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRateLimitCooldown 429 응답에 Retry-After 헤더가 없을 때 Provider 비활성화 기간
+const DefaultRateLimitCooldown = 10 * time.Minute
+
+// MinRateLimitCooldown Retry-After가 0이거나 이미 지난 시각일 때 쓰는 최소 비활성화 기간
+// 바로 다시 시도해도 된다는 뜻이므로 헤더가 없을 때의 기본 쿨다운 대신 짧게만 쉰다
+const MinRateLimitCooldown = time.Second
+
+// TemporaryDisabler 일정 기간 동안만 비활성화할 수 있는 Provider
+// 기간이 지나면 IsAvailable이 자동으로 다시 true를 반환한다
+type TemporaryDisabler interface {
+	// DisableFor 지정한 기간 동안 Provider를 비활성화
+	DisableFor(reason string, d time.Duration)
+
+	// DisabledUntil 비활성화 만료 시각 (비활성화 상태가 아니거나 영구 비활성화면 zero)
+	DisabledUntil() time.Time
+}
+
+// ParseRetryAfter Retry-After 헤더 값을 대기 시간으로 변환
+// 초 단위 정수와 HTTP-date 형식을 지원하며, 값이 없거나 형식이 잘못되면 false 반환
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := at.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// newRateLimitError 429 응답을 Retry-After 정보가 담긴 분류된 에러로 변환
+func newRateLimitError(resp *http.Response, now time.Time) *ClassifiedError {
+	ce := NewClassifiedError(ErrorTypeRateLimitExceeded, "Rate limit exceeded", ErrQuotaExceeded)
+	if d, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+		ce.RetryAfter = max(d, MinRateLimitCooldown)
+	}
+	return ce
+}
+
+// disabledAt 비활성화 상태가 now 시점에 유효한지 확인 (until이 zero면 영구 비활성화)
+func disabledAt(disabled bool, until, now time.Time) bool {
+	return disabled && (until.IsZero() || now.Before(until))
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"seconds", "120", 2 * time.Minute, true},
+		{"zero seconds", "0", 0, true},
+		{"http date", "Wed, 01 Jan 2025 09:05:00 GMT", 5 * time.Minute, true},
+		{"past http date", "Wed, 01 Jan 2025 08:00:00 GMT", 0, true},
+		{"empty", "", 0, false},
+		{"negative", "-5", 0, false},
+		{"garbage", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKakaoProvider_Geocode_RateLimitRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "90")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeRateLimitExceeded, ce.Type)
+	assert.Equal(t, 90*time.Second, ce.RetryAfter)
+}
+
+func TestKakaoProvider_Geocode_RateLimitRetryNow(t *testing.T) {
+	// 0초나 지난 시각은 바로 재시도해도 된다는 뜻이므로 기본 쿨다운 대신 최소 기간만 쉰다
+	for _, value := range []string{"0", "Wed, 21 Oct 2015 07:28:00 GMT"} {
+		t.Run(value, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", value)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer server.Close()
+
+			p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+			p.baseURL = server.URL
+
+			_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+			ce, ok := IsClassifiedError(err)
+			require.True(t, ok)
+			assert.Equal(t, MinRateLimitCooldown, ce.RetryAfter)
+		})
+	}
+}
+
+func TestVWorldProvider_Geocode_RateLimitWithoutRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	p := newTestVWorldProvider(server.URL)
+
+	_, err := p.Geocode(context.Background(), "서울특별시 중구 세종대로 110")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeRateLimitExceeded, ce.Type)
+	assert.Zero(t, ce.RetryAfter)
+}
+
+func TestProvider_DisableFor_ReEnablesAfterCooldown(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	vworld := NewVWorldProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	vworld.SetClock(fake)
+	kakao := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	kakao.SetClock(fake)
+
+	providers := map[string]interface {
+		GeocodingProvider
+		TemporaryDisabler
+	}{"vWorld": vworld, "Kakao": kakao}
+
+	for name, p := range providers {
+		t.Run(name, func(t *testing.T) {
+			fake.Set(start)
+			ctx := context.Background()
+
+			p.DisableFor("Rate limit exceeded", time.Minute)
+
+			assert.False(t, p.IsAvailable(ctx))
+			assert.True(t, p.IsDisabled())
+			assert.Equal(t, start.Add(time.Minute), p.DisabledUntil())
+			assert.Contains(t, p.GetDisableReason(), "until 2025-01-01T09:01:00Z")
+
+			fake.Advance(59 * time.Second)
+			assert.False(t, p.IsAvailable(ctx))
+
+			fake.Advance(time.Second)
+			assert.True(t, p.IsAvailable(ctx))
+			assert.False(t, p.IsDisabled())
+			assert.Empty(t, p.GetDisableReason())
+			assert.True(t, p.DisabledUntil().IsZero())
+		})
+	}
+}
+
+func TestProvider_Disable_IsPermanent(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.SetClock(fake)
+
+	p.DisableFor("Rate limit exceeded", time.Minute)
+	p.Disable("Authentication failed")
+	fake.Advance(24 * time.Hour)
+
+	assert.False(t, p.IsAvailable(context.Background()))
+	assert.Equal(t, "Authentication failed", p.GetDisableReason())
+	assert.True(t, p.DisabledUntil().IsZero())
+}
+
+func TestProvider_Enable_ClearsDisable(t *testing.T) {
+	providers := []GeocodingProvider{
+		NewVWorldProvider("test-key", httpclient.NewClient(0), zap.NewNop()),
+		NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop()),
+	}
+
+	for _, p := range providers {
+		t.Run(p.Name(), func(t *testing.T) {
+			p.Disable("Authentication failed")
+			require.True(t, p.IsDisabled())
+
+			p.Enable()
+
+			assert.True(t, p.IsAvailable(context.Background()))
+			assert.False(t, p.IsDisabled())
+			assert.Empty(t, p.GetDisableReason())
+		})
+	}
+
+	t.Run("temporary disable", func(t *testing.T) {
+		p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+		p.DisableFor("Rate limit exceeded", time.Hour)
+
+		p.Enable()
+
+		assert.True(t, p.IsAvailable(context.Background()))
+		assert.True(t, p.DisabledUntil().IsZero())
+	})
+}
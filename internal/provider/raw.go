@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// RawCapture Provider가 반환한 원본 응답 본문(JSON)을 담는 그릇 (Provider 버그 재현용 디버그 기능)
+// 한 번의 Geocode 호출이 여러 요청을 보내면 (주소 검색 후 키워드 검색 등) 마지막 응답이 남는다
+type RawCapture struct {
+	mu   sync.Mutex
+	body json.RawMessage
+}
+
+// rawCaptureKey 요청별 원본 응답 그릇 context 키
+type rawCaptureKey struct{}
+
+// WithRawCapture 이 컨텍스트로 호출하는 Provider의 원본 응답을 담을 그릇을 붙인 컨텍스트 반환
+func WithRawCapture(ctx context.Context) (context.Context, *RawCapture) {
+	capture := &RawCapture{}
+	return context.WithValue(ctx, rawCaptureKey{}, capture), capture
+}
+
+// rawCaptureFor 요청에 붙은 원본 응답 그릇 (없으면 nil)
+func rawCaptureFor(req *http.Request) *RawCapture {
+	if req == nil {
+		return nil
+	}
+	capture, _ := req.Context().Value(rawCaptureKey{}).(*RawCapture)
+	return capture
+}
+
+// Body 마지막으로 담은 원본 응답 본문 (없으면 nil)
+func (c *RawCapture) Body() json.RawMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.body
+}
+
+// set 응답 본문에서 secrets(API 키)를 가려 저장 (올바른 JSON이 아니면 저장하지 않음)
+func (c *RawCapture) set(body []byte, secrets ...string) {
+	if !json.Valid(body) {
+		return
+	}
+	redacted := bytes.Clone(body)
+	for _, secret := range secrets {
+		if secret != "" {
+			redacted = bytes.ReplaceAll(redacted, []byte(secret), []byte("REDACTED"))
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.body = redacted
+}
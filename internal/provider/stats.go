@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+)
+
+// StatsReporter 호출 통계를 제공하는 제공자 인터페이스
+type StatsReporter interface {
+	// Stats 현재까지의 호출 통계 스냅샷 반환
+	Stats() StatsSnapshot
+}
+
+// quotaLocation 일일 할당량 기준 시간대 (국내 API는 한국 시간 자정에 초기화)
+var quotaLocation = time.FixedZone("KST", 9*60*60)
+
+// Stats Provider 호출 통계 카운터 (동시성 안전, 제로 값으로 사용 가능)
+type Stats struct {
+	mu           sync.Mutex
+	calls        int64
+	successes    int64
+	failures     int64
+	totalLatency time.Duration
+	day          string // callsToday 집계 날짜 (한국 시간 YYYY-MM-DD)
+	callsToday   int64  // 오늘 보낸 HTTP 요청 수 (할당량은 호출이 아니라 요청 단위로 차감)
+	lastError    string
+	lastErrorAt  time.Time
+	clock        clock.Clock // nil이면 시스템 시계
+}
+
+// StatsSnapshot 특정 시점의 호출 통계
+type StatsSnapshot struct {
+	Calls        int64         // 전체 호출 수
+	Successes    int64         // 결과를 찾은 호출 수
+	Failures     int64         // 결과 없음 또는 에러로 끝난 호출 수
+	TotalLatency time.Duration // 누적 응답 시간
+	CallsToday   int64         // 오늘(한국 시간) 보낸 HTTP 요청 수, 일일 할당량 잔여량 추정용
+	LastError    string        // 마지막 에러 메시지 (에러가 없었으면 빈 값)
+	LastErrorAt  time.Time     // 마지막 에러 발생 시각
+}
+
+// SetClock 일일 호출 수 집계에 쓸 시계 설정 (nil이면 시스템 시계)
+func (s *Stats) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = c
+}
+
+// now 설정된 시계 기준 현재 시각
+func (s *Stats) now() time.Time {
+	s.mu.Lock()
+	c := s.clock
+	s.mu.Unlock()
+	return clock.OrReal(c).Now()
+}
+
+// Record 호출 1건 기록
+func (s *Stats) Record(success bool, latency time.Duration) {
+	s.record(success, latency, s.now())
+}
+
+func (s *Stats) record(success bool, latency time.Duration, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if success {
+		s.successes++
+	} else {
+		s.failures++
+	}
+	s.totalLatency += latency
+}
+
+// RecordRequest Provider API로 보낸 HTTP 요청 1건 기록 (일일 할당량 집계용)
+// 주소 검색 후 키워드 검색처럼 호출 1건이 요청 여러 건을 보낼 수 있으므로 Record와 따로 센다.
+func (s *Stats) RecordRequest() {
+	s.recordRequest(s.now())
+}
+
+func (s *Stats) recordRequest(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if day := quotaDay(now); day != s.day {
+		s.day = day
+		s.callsToday = 0
+	}
+	s.callsToday++
+}
+
+// RecordError 호출 중 발생한 에러 기록 (결과 없음은 에러가 아님)
+func (s *Stats) RecordError(err error) {
+	if err == nil {
+		return
+	}
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastError = err.Error()
+	s.lastErrorAt = now
+}
+
+// Snapshot 현재 통계 복사본 반환 (Calls == Successes + Failures 항상 성립)
+func (s *Stats) Snapshot() StatsSnapshot {
+	return s.snapshot(s.now())
+}
+
+func (s *Stats) snapshot(now time.Time) StatsSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap := StatsSnapshot{
+		Calls:        s.calls,
+		Successes:    s.successes,
+		Failures:     s.failures,
+		TotalLatency: s.totalLatency,
+		LastError:    s.lastError,
+		LastErrorAt:  s.lastErrorAt,
+	}
+	if s.day == quotaDay(now) {
+		snap.CallsToday = s.callsToday
+	}
+	return snap
+}
+
+// quotaDay 일일 할당량 집계 날짜
+func quotaDay(t time.Time) string {
+	return t.In(quotaLocation).Format("2006-01-02")
+}
+
+// AvgLatency 호출당 평균 응답 시간 (호출이 없으면 0)
+func (s StatsSnapshot) AvgLatency() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Calls)
+}
+
+// Add 두 스냅샷 합산 (같은 이름의 Provider가 여러 개인 경우, 예: vWorld 다중 키)
+// 마지막 에러는 더 최근 것을 유지
+func (s StatsSnapshot) Add(other StatsSnapshot) StatsSnapshot {
+	sum := StatsSnapshot{
+		Calls:        s.Calls + other.Calls,
+		Successes:    s.Successes + other.Successes,
+		Failures:     s.Failures + other.Failures,
+		TotalLatency: s.TotalLatency + other.TotalLatency,
+		CallsToday:   s.CallsToday + other.CallsToday,
+		LastError:    s.LastError,
+		LastErrorAt:  s.LastErrorAt,
+	}
+	if other.LastErrorAt.After(s.LastErrorAt) {
+		sum.LastError = other.LastError
+		sum.LastErrorAt = other.LastErrorAt
+	}
+	return sum
+}
+
+// RemainingQuota 일일 할당량 중 남은 호출 수 추정 (0 미만이면 0)
+func (s StatsSnapshot) RemainingQuota(dailyLimit int) int {
+	remaining := int64(dailyLimit) - s.CallsToday
+	if remaining < 0 {
+		return 0
+	}
+	return int(remaining)
+}
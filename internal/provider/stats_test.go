@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestStats_Record(t *testing.T) {
+	var s Stats
+	assert.Equal(t, StatsSnapshot{}, s.Snapshot())
+	assert.Zero(t, s.Snapshot().AvgLatency())
+
+	s.Record(true, 10*time.Millisecond)
+	s.Record(false, 30*time.Millisecond)
+
+	snap := s.Snapshot()
+	assert.Equal(t, int64(2), snap.Calls)
+	assert.Equal(t, int64(1), snap.Successes)
+	assert.Equal(t, int64(1), snap.Failures)
+	assert.Equal(t, 20*time.Millisecond, snap.AvgLatency())
+
+	sum := snap.Add(StatsSnapshot{Calls: 2, Successes: 2, TotalLatency: 40 * time.Millisecond})
+	assert.Equal(t, int64(4), sum.Calls)
+	assert.Equal(t, int64(3), sum.Successes)
+	assert.Equal(t, 20*time.Millisecond, sum.AvgLatency())
+}
+
+func TestStats_CallsTodayAndLastError(t *testing.T) {
+	var s Stats
+	day := time.Date(2026, 3, 1, 23, 30, 0, 0, quotaLocation)
+
+	s.recordRequest(day)
+	s.record(true, time.Millisecond, day)
+	s.recordRequest(day.Add(10 * time.Minute))
+	s.record(false, time.Millisecond, day.Add(10*time.Minute))
+	assert.Equal(t, int64(2), s.snapshot(day.Add(20*time.Minute)).CallsToday)
+	assert.Equal(t, 39998, s.snapshot(day).RemainingQuota(40000))
+
+	// 한국 시간 자정이 지나면 오늘 호출 수는 초기화되고 누적 호출 수는 유지
+	nextDay := day.Add(time.Hour)
+	assert.Zero(t, s.snapshot(nextDay).CallsToday)
+	s.recordRequest(nextDay)
+	s.record(true, time.Millisecond, nextDay)
+	snap := s.snapshot(nextDay)
+	assert.Equal(t, int64(1), snap.CallsToday)
+	assert.Equal(t, int64(3), snap.Calls)
+	assert.Zero(t, StatsSnapshot{CallsToday: 5}.RemainingQuota(3))
+
+	s.RecordError(nil)
+	assert.Empty(t, s.Snapshot().LastError)
+	s.RecordError(errors.New("HTTP request failed"))
+	snap = s.Snapshot()
+	assert.Equal(t, "HTTP request failed", snap.LastError)
+	assert.WithinDuration(t, time.Now(), snap.LastErrorAt, time.Second)
+
+	older := StatsSnapshot{LastError: "older", LastErrorAt: snap.LastErrorAt.Add(-time.Minute)}
+	assert.Equal(t, "HTTP request failed", older.Add(snap).LastError)
+	assert.Equal(t, "HTTP request failed", snap.Add(older).LastError)
+}
+
+func TestKakaoProvider_Stats_Concurrent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("query") == "없는주소" {
+			w.Write([]byte(`{"meta":{"total_count":0},"documents":[]}`))
+			return
+		}
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+
+	const workers, perWorker = 8, 25
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				address := "서울 중구 세종대로 110"
+				if j%5 == 0 {
+					address = "없는주소"
+				}
+				p.Geocode(context.Background(), address)
+				// 진행 중 스냅샷도 항상 일관적이어야 함
+				snap := p.Stats()
+				assert.Equal(t, snap.Calls, snap.Successes+snap.Failures)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	snap := p.Stats()
+	assert.Equal(t, int64(workers*perWorker), snap.Calls)
+	assert.Equal(t, int64(workers*perWorker/5), snap.Failures)
+	assert.Equal(t, int64(workers*perWorker*4/5), snap.Successes)
+	assert.Positive(t, snap.AvgLatency())
+}
+
+func TestKakaoProvider_Stats_QuotaResetsAtKSTMidnight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	fake := clock.NewFake(time.Date(2026, 3, 1, 23, 58, 0, 0, quotaLocation))
+	p := NewKakaoProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = server.URL
+	p.SetClock(fake)
+
+	for i := 0; i < 3; i++ {
+		p.Geocode(context.Background(), "서울 중구 세종대로 110")
+	}
+	assert.Equal(t, int64(3), p.Stats().CallsToday)
+
+	fake.Advance(time.Minute)
+	assert.Equal(t, int64(3), p.Stats().CallsToday)
+
+	// 한국 시간 자정이 지나면 호출 없이도 오늘 호출 수는 0
+	fake.Advance(time.Minute)
+	snap := p.Stats()
+	assert.Zero(t, snap.CallsToday)
+	assert.Equal(t, int64(3), snap.Calls)
+
+	p.Geocode(context.Background(), "서울 중구 세종대로 110")
+	assert.Equal(t, int64(1), p.Stats().CallsToday)
+}
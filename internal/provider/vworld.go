@@ -16,28 +16,35 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
 	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
 
 	"go.uber.org/zap"
 )
 
 // VWorldProvider vWorld API 클라이언트
 type VWorldProvider struct {
-	apiKey        string
+	apiKey        string // mu로 보호 (SetAPIKey로 실행 중 교체 가능)
 	httpClient    *httpclient.Client
-	baseURL       string
+	baseURL       string   // mu로 보호
+	addressTypes  []string // 시도할 주소 타입 (비어 있으면 ROAD, PARCEL 모두)
 	logger        *zap.Logger
 	disabled      bool
 	disableReason string
+	disabledUntil time.Time   // 비활성화 만료 시각 (zero면 영구 비활성화)
+	clock         clock.Clock // 현재 시각 공급자 (SetClock으로 교체)
+	stats         Stats       // Geocode 호출 통계
 	mu            sync.RWMutex
 }
 
@@ -58,18 +65,7 @@ type VWorldResponse struct {
 		} `json:"input"`
 		Refined struct {
 			Text string `json:"text"`
-			Structure struct {
-				Level0   string `json:"level0"`
-				Level1   string `json:"level1"`
-				Level2   string `json:"level2"`
-				Level3   string `json:"level3"`
-				Level4L  string `json:"level4L"`
-				Level4LC string `json:"level4LC"`
-				Level4A  string `json:"level4A"`
-				Level4AC string `json:"level4AC"`
-				Level5   string `json:"level5"`
-				Detail   string `json:"detail"`
-			} `json:"structure"`
+			Structure VWorldStructure `json:"structure"`
 		} `json:"refined"`
 		Error struct {
 			Level string `json:"level"`
@@ -83,13 +79,38 @@ type VWorldResponse struct {
 	} `json:"response"`
 }
 
+// VWorldStructure vWorld 정제 주소(refined.structure) 구성 요소
+type VWorldStructure struct {
+	Level0   string `json:"level0"`   // 국가 (대한민국)
+	Level1   string `json:"level1"`   // 시·도
+	Level2   string `json:"level2"`   // 시·군·구
+	Level3   string `json:"level3"`   // 일반구 또는 법정 읍·면·동
+	Level4L  string `json:"level4L"`  // 도로명
+	Level4LC string `json:"level4LC"` // 도로명코드
+	Level4A  string `json:"level4A"`  // 행정동
+	Level4AC string `json:"level4AC"` // 행정동코드
+	Level5   string `json:"level5"`   // 건물번호 (도로명) 또는 번지 (지번)
+	Detail   string `json:"detail"`   // 상세주소 (건물명 등)
+}
+
+const (
+	// VWorldDefaultBaseURL vWorld API 기본 URL
+	VWorldDefaultBaseURL = "https://api.vworld.kr"
+
+	vworldAddressPath = "/req/address"
+
+	// vworldConfidence vWorld 결과 신뢰도 (getcoord는 유사 검색 없이 정확히 일치하는 주소만 반환)
+	vworldConfidence = 0.9
+)
+
 // NewVWorldProvider vWorld Provider 생성자
 func NewVWorldProvider(apiKey string, httpClient *httpclient.Client, logger *zap.Logger) *VWorldProvider {
 	return &VWorldProvider{
 		apiKey:     apiKey,
 		httpClient: httpClient,
-		baseURL:    "https://api.vworld.kr/req/address",
+		baseURL:    VWorldDefaultBaseURL + vworldAddressPath,
 		logger:     logger,
+		clock:      clock.Real{},
 	}
 }
 
@@ -97,10 +118,15 @@ func (v *VWorldProvider) Name() string {
 	return "vWorld"
 }
 
+// log 요청 컨텍스트의 상관관계 ID(request_id)를 붙인 로거 반환
+func (v *VWorldProvider) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, v.logger)
+}
+
 func (v *VWorldProvider) IsAvailable(ctx context.Context) bool {
 	v.mu.RLock()
 	defer v.mu.RUnlock()
-	return !v.disabled
+	return !disabledAt(v.disabled, v.disabledUntil, v.clock.Now())
 }
 
 // Disable Provider를 비활성화
@@ -109,22 +135,59 @@ func (v *VWorldProvider) Disable(reason string) {
 	defer v.mu.Unlock()
 	v.disabled = true
 	v.disableReason = reason
+	v.disabledUntil = time.Time{}
 	v.logger.Warn("vWorld provider disabled",
 		zap.String("reason", reason),
 	)
 }
 
+// DisableFor 지정한 기간 동안 Provider를 비활성화 (만료 시각은 사유에 함께 기록)
+func (v *VWorldProvider) DisableFor(reason string, d time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.disabled = true
+	v.disabledUntil = v.clock.Now().Add(d)
+	v.disableReason = fmt.Sprintf("%s (until %s)", reason, v.disabledUntil.Format(time.RFC3339))
+	v.logger.Warn("vWorld provider disabled temporarily",
+		zap.String("reason", reason),
+		zap.Time("until", v.disabledUntil),
+	)
+}
+
+// DisabledUntil 비활성화 만료 시각 (비활성화 상태가 아니거나 영구 비활성화면 zero)
+func (v *VWorldProvider) DisabledUntil() time.Time {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if !disabledAt(v.disabled, v.disabledUntil, v.clock.Now()) {
+		return time.Time{}
+	}
+	return v.disabledUntil
+}
+
+// Enable 비활성화 상태와 사유를 해제
+func (v *VWorldProvider) Enable() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.disabled = false
+	v.disableReason = ""
+	v.disabledUntil = time.Time{}
+	v.logger.Info("vWorld provider enabled")
+}
+
 // IsDisabled Provider가 비활성화 되었는지 확인
 func (v *VWorldProvider) IsDisabled() bool {
 	v.mu.RLock()
 	defer v.mu.RUnlock()
-	return v.disabled
+	return disabledAt(v.disabled, v.disabledUntil, v.clock.Now())
 }
 
 // GetDisableReason 비활성화 사유 반환
 func (v *VWorldProvider) GetDisableReason() string {
 	v.mu.RLock()
 	defer v.mu.RUnlock()
+	if !disabledAt(v.disabled, v.disabledUntil, v.clock.Now()) {
+		return ""
+	}
 	return v.disableReason
 }
 
@@ -133,7 +196,13 @@ func (v *VWorldProvider) Geocode(ctx context.Context, address string) (*model.Pr
 }
 
 // GeocodeWithType 특정 주소 타입으로 지오코딩 (타입이 빈 문자열이면 자동 폴백)
-func (v *VWorldProvider) GeocodeWithType(ctx context.Context, address string, addrType string) (*model.ProviderResult, error) {
+func (v *VWorldProvider) GeocodeWithType(ctx context.Context, address string, addrType string) (result *model.ProviderResult, err error) {
+	start := v.clock.Now()
+	defer func() {
+		v.stats.Record(err == nil && result.Success, v.clock.Since(start))
+		v.stats.RecordError(err)
+	}()
+
 	// 주소 전처리
 	address = strings.TrimSpace(address)
 	if address == "" {
@@ -146,8 +215,17 @@ func (v *VWorldProvider) GeocodeWithType(ctx context.Context, address string, ad
 	// 주소 타입 정규화 (소문자 -> 대문자)
 	addrType = strings.ToUpper(addrType)
 
-	// 특정 타입이 지정된 경우 해당 타입만 시도
-	if addrType == "ROAD" || addrType == "PARCEL" {
+	allowed := addressTypesFor(ctx, v.addressTypes)
+
+	// 특정 타입이 지정된 경우 해당 타입만 시도 (허용되지 않은 타입이면 호출하지 않음)
+	// 허용하지 않은 타입은 찾지 못한 것이 아니라 다루지 않는 주소이므로 StopOnNotFound에서도 다음 Provider로 폴백
+	if addrType == AddressTypeRoad || addrType == AddressTypeParcel {
+		if !addressTypeAllowed(allowed, addrType) {
+			return &model.ProviderResult{
+				Success: false,
+				Error:   fmt.Errorf("%w: address type %s not allowed", ErrNotCovered, addrType),
+			}, nil
+		}
 		result, err := v.geocodeWithType(ctx, address, addrType)
 		if err != nil {
 			return nil, err
@@ -156,25 +234,38 @@ func (v *VWorldProvider) GeocodeWithType(ctx context.Context, address string, ad
 	}
 
 	// 타입이 지정되지 않은 경우 자동 폴백
-	// 1단계: 도로명 주소로 시도
-	result, err := v.geocodeWithType(ctx, address, "ROAD")
+	// 주소 형태로 유형을 추정해 가능성이 높은 타입부터 시도 (판별 불가 시 도로명 우선)
+	first, second := AddressTypeRoad, AddressTypeParcel
+	if utils.DetectAddressType(address) == utils.AddressTypeParcel {
+		first, second = AddressTypeParcel, AddressTypeRoad
+	}
+
+	// 한 가지 타입만 허용되면 그 타입으로 한 번만 시도 (다른 타입 재시도 호출 절약)
+	if len(allowed) == 1 {
+		return v.geocodeWithType(ctx, address, allowed[0])
+	}
+
+	// 1단계: 추정된 주소 타입으로 시도
+	result, err = v.geocodeWithType(ctx, address, first)
 	if err == nil && result.Success {
-		v.logger.Debug("vWorld geocoding succeeded with road address",
+		v.log(ctx).Debug("vWorld geocoding succeeded with detected address type",
 			zap.String("address", address),
-			zap.String("type", "ROAD"),
+			zap.String("type", first),
 		)
 		return result, nil
 	}
 
-	// 2단계: 지번 주소로 재시도
-	v.logger.Debug("Retrying with parcel address type",
+	// 2단계: 다른 주소 타입으로 재시도
+	v.log(ctx).Debug("Retrying with alternate address type",
 		zap.String("address", address),
+		zap.String("type", second),
 	)
-	result, err = v.geocodeWithType(ctx, address, "PARCEL")
+	firstErr := err
+	result, err = v.geocodeWithType(ctx, address, second)
 	if err == nil && result.Success {
-		v.logger.Debug("vWorld geocoding succeeded with parcel address",
+		v.log(ctx).Debug("vWorld geocoding succeeded with alternate address type",
 			zap.String("address", address),
-			zap.String("type", "PARCEL"),
+			zap.String("type", second),
 		)
 		return result, nil
 	}
@@ -184,24 +275,89 @@ func (v *VWorldProvider) GeocodeWithType(ctx context.Context, address string, ad
 		return nil, err
 	}
 
+	// 다른 타입에서 결과가 없으면 첫 번째 타입의 부분 일치 사유를 유지
+	if ce, ok := IsClassifiedError(firstErr); ok && ce.Type == ErrorTypeNotFound {
+		return nil, firstErr
+	}
+
 	return &model.ProviderResult{
-		Success: false,
-		Error:   ErrAddressNotFound,
+		Success:    false,
+		Error:      ErrAddressNotFound,
+		RequestURL: result.RequestURL,
 	}, nil
 }
 
-func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType string) (*model.ProviderResult, error) {
+// SetBaseURL API 기본 URL 변경 (모의 서버, 미러, 프록시용)
+// 예: "http://localhost:8081" → "http://localhost:8081/req/address"
+func (v *VWorldProvider) SetBaseURL(baseURL string) error {
+	base, err := NormalizeBaseURL(baseURL)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.baseURL = base + vworldAddressPath
+	return nil
+}
+
+// SetAddressTypes 자동 폴백에서 시도할 주소 타입 제한 (예: 도로명 전용 데이터는 ["ROAD"])
+// 빈 목록이면 두 타입 모두 시도하며, 요청별로는 WithAddressTypes로 바꿀 수 있다 (사용 전에 호출)
+func (v *VWorldProvider) SetAddressTypes(types []string) error {
+	parsed, err := ParseAddressTypes(types)
+	if err != nil {
+		return err
+	}
+	v.addressTypes = parsed
+	return nil
+}
+
+// SetAPIKey API 키 교체 (실행 중 호출 가능, 진행 중인 요청은 이전 키로 완료된다)
+func (v *VWorldProvider) SetAPIKey(key string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.apiKey = key
+}
+
+// endpoint 요청에 사용할 기본 URL과 API 키
+func (v *VWorldProvider) endpoint() (baseURL, apiKey string) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.baseURL, v.apiKey
+}
+
+// Stats Geocode 호출 통계 스냅샷 반환
+func (v *VWorldProvider) Stats() StatsSnapshot {
+	return v.stats.Snapshot()
+}
+
+// SetClock 비활성화 만료와 호출 통계에 쓸 시계 설정 (nil이면 시스템 시계, 사용 전에 호출)
+func (v *VWorldProvider) SetClock(c clock.Clock) {
+	v.clock = clock.OrReal(c)
+	v.stats.SetClock(c)
+}
+
+func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType string) (result *model.ProviderResult, err error) {
+	baseURL, apiKey := v.endpoint()
+	crs := crsFor(ctx)
+
 	// URL 파라미터 구성
 	params := url.Values{}
 	params.Set("service", "address")
 	params.Set("request", "getcoord")
-	params.Set("crs", "epsg:4326")     // WGS84 좌표계
+	params.Set("crs", strings.ToLower(crs)) // 출력 좌표계 (기본 WGS84)
 	params.Set("address", address)
 	params.Set("format", "json")
 	params.Set("type", addrType)        // road 또는 parcel
-	params.Set("key", v.apiKey)
+	params.Set("refine", "true")        // 정제 주소(refined) 포함
+	params.Set("simple", "false")       // refined.structure 포함 (simple 응답은 생략)
+	params.Set("key", apiKey)
 	
-	requestURL := fmt.Sprintf("%s?%s", v.baseURL, params.Encode())
+	requestURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
+
+	// 디버그용 요청 URL 첨부 (key 파라미터 제거)
+	defer func() {
+		result, err = withRequestURL(result, err, RedactURL(requestURL))
+	}()
 	
 	// HTTP 요청 생성
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
@@ -210,35 +366,46 @@ func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType
 	}
 	
 	// HTTP 요청 실행
-	resp, err := v.httpClient.Do(req)
+	v.stats.RecordRequest()
+	resp, err := v.httpClient.DoWithRetry(req, v.httpClient.MaxRetries)
 	if err != nil {
 		return nil, NewClassifiedError(ErrorTypeSystemFailure, "HTTP request failed", err)
 	}
 	defer resp.Body.Close()
+
+	// 상태 코드나 본문이 잘못된 응답도 기록되도록 반환 시점에 기록 (요약은 파싱에 성공한 경우만)
+	var summary []zap.Field
+	defer func() { logExchange(v.log(ctx), v.Name(), requestURL, resp.StatusCode, summary...) }()
 	
 	// 상태 코드 확인
 	if resp.StatusCode != http.StatusOK {
+		var ce *ClassifiedError
 		switch resp.StatusCode {
 		case http.StatusUnauthorized:
-			return nil, NewClassifiedError(ErrorTypeUnauthorized, "Invalid API key", ErrAPIKeyInvalid)
+			ce = NewClassifiedError(ErrorTypeUnauthorized, "Invalid API key", ErrAPIKeyInvalid)
 		case http.StatusTooManyRequests:
-			return nil, NewClassifiedError(ErrorTypeRateLimitExceeded, "Rate limit exceeded", ErrQuotaExceeded)
+			ce = newRateLimitError(resp, v.clock.Now())
 		default:
-			return nil, NewClassifiedError(ErrorTypeSystemFailure, 
+			ce = NewClassifiedError(ErrorTypeSystemFailure, 
 				fmt.Sprintf("API returned status %d", resp.StatusCode), nil)
 		}
+		return nil, ce.withResponse(resp.StatusCode, readErrorBody(resp), apiKey)
 	}
 	
 	// 응답 파싱
 	var vwResp VWorldResponse
-	if err := json.NewDecoder(resp.Body).Decode(&vwResp); err != nil {
-		return nil, fmt.Errorf("failed to decode vWorld response: %w", err)
+	if err := decodeResponse(resp, &vwResp, "vWorld", apiKey); err != nil {
+		return nil, err
+	}
+	summary = []zap.Field{
+		zap.String("status", vwResp.Response.Status),
+		zap.Bool("has_point", vwResp.Response.Result.Point.X != "" && vwResp.Response.Result.Point.Y != ""),
 	}
 	
 	// 에러 체크
 	if vwResp.Response.Status == "ERROR" {
 		errText := vwResp.Response.Error.Text
-		v.logger.Warn("vWorld API error",
+		v.log(ctx).Warn("vWorld API error",
 			zap.String("error_code", vwResp.Response.Error.Code),
 			zap.String("error_text", errText),
 		)
@@ -255,12 +422,12 @@ func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType
 	}
 	
 	// 결과 확인
-	if vwResp.Response.Status != "OK" || vwResp.Response.Result.Point.X == "" || vwResp.Response.Result.Point.Y == "" {
+	if vwResp.Response.Status != "OK" {
 		// 실제 API 에러 메시지 사용
 		errorMsg := "address not found"
 		if vwResp.Response.Status == "NOT_FOUND" {
 			errorMsg = "NOT_FOUND: 검색 결과가 없습니다"
-		} else if vwResp.Response.Status != "OK" {
+		} else {
 			errorMsg = fmt.Sprintf("%s: %s", vwResp.Response.Status, vwResp.Response.Error.Text)
 		}
 
@@ -270,6 +437,18 @@ func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType
 		}, nil
 	}
 
+	// 부분 일치: 주소는 인식했지만 좌표가 비어 있음 (다음 타입/Provider로 폴백)
+	if vwResp.Response.Result.Point.X == "" || vwResp.Response.Result.Point.Y == "" {
+		v.log(ctx).Warn("vWorld partial match without coordinates",
+			zap.String("address", address),
+			zap.String("address_type", addrType),
+			zap.String("refined", vwResp.Response.Refined.Text),
+		)
+		return nil, NewClassifiedError(ErrorTypeNotFound,
+			fmt.Sprintf("partial match: vWorld recognized the %s address but returned no coordinate", strings.ToLower(addrType)),
+			ErrAddressNotFound)
+	}
+
 	// 좌표 파싱
 	lng, err := strconv.ParseFloat(vwResp.Response.Result.Point.X, 64)
 	if err != nil {
@@ -293,22 +472,80 @@ func (v *VWorldProvider) geocodeWithType(ctx context.Context, address, addrType
 		parcelAddr = vwResp.Response.Input.Address
 	}
 
-	v.logger.Info("vWorld geocoding succeeded",
+	v.log(ctx).Info("vWorld geocoding succeeded",
 		zap.String("address_type", addrType),
 		zap.Float64("latitude", lat),
 		zap.Float64("longitude", lng),
 	)
 	
+	detail := model.AddressDetail{
+		RoadAddress:    roadAddr,
+		ParcelAddress:  parcelAddr,
+		BuildingName:   vwResp.Response.Refined.Structure.Detail,
+		RefinedAddress: vwResp.Response.Refined.Text,
+	}
+	applyVWorldStructure(&detail, vwResp.Response.Refined.Structure, vwResp.Response.Input.Type)
+
 	return &model.ProviderResult{
 		Coordinate: model.Coordinate{
 			Latitude:  lat,
 			Longitude: lng,
 		},
-		AddressDetail: model.AddressDetail{
-			RoadAddress:   roadAddr,
-			ParcelAddress: parcelAddr,
-			BuildingName:  vwResp.Response.Refined.Structure.Detail,
-		},
-		Success: true,
+		AddressDetail:  detail,
+		Success:        true,
+		Confidence:     vworldConfidence,
+		MatchedAddress: vwResp.Response.Refined.Text,
+		CRS:            resultCRS(vwResp.Response.Result.CRS, crs),
 	}, nil
+}
+
+// resultCRS 응답 좌표의 좌표계 (응답의 result.crs, 없으면 요청한 좌표계)
+// WGS84면 빈 값을 반환한다 (model.ProviderResult.CRS 규칙)
+func resultCRS(responded, requested string) string {
+	crs := strings.ToUpper(strings.TrimSpace(responded))
+	if crs == "" {
+		crs = requested
+	}
+	if crs == CRSWGS84 {
+		return ""
+	}
+	return crs
+}
+
+// applyVWorldStructure 정제 주소 구성 요소를 AddressDetail 지역 필드로 변환
+// level3은 일반구(예: 분당구)면 시·군·구에 붙이고, 아니면 법정 읍·면·동으로 본다
+// level5는 도로명 주소면 건물번호, 지번 주소면 번지(산 여부, 본번, 부번)로 나눈다
+func applyVWorldStructure(detail *model.AddressDetail, s VWorldStructure, inputType string) {
+	detail.Sido = s.Level1
+	detail.Sigungu = s.Level2
+	if level3 := strings.TrimSpace(s.Level3); level3 != "" {
+		if strings.HasSuffix(level3, "구") {
+			detail.Sigungu = strings.TrimSpace(detail.Sigungu + " " + level3)
+		} else {
+			detail.Dong = level3
+		}
+	}
+	detail.RoadName = s.Level4L
+	detail.AdminDong = s.Level4A
+	if detail.AdminDongCode == "" {
+		detail.AdminDongCode = s.Level4AC
+	}
+
+	level5 := strings.TrimSpace(s.Level5)
+	if strings.EqualFold(inputType, "ROAD") {
+		detail.BuildingNo = level5
+		return
+	}
+	detail.IsMountain, detail.MainNo, detail.SubNo = splitLotNumber(level5)
+}
+
+// splitLotNumber 번지 문자열을 산 여부, 본번, 부번으로 분리 (예: "산31-1" → true, "31", "1")
+func splitLotNumber(lot string) (mountain bool, main, sub string) {
+	lot = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(lot), "번지"))
+	if rest, ok := strings.CutPrefix(lot, "산"); ok {
+		mountain = true
+		lot = strings.TrimSpace(rest)
+	}
+	main, sub, _ = strings.Cut(lot, "-")
+	return mountain, main, sub
 }
\ No newline at end of file
@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const vworldSuccessResponse = `{
+  "response": {
+    "status": "OK",
+    "input": {"type": "PARCEL", "address": "서울특별시 강남구 역삼동 737"},
+    "refined": {"text": "서울특별시 강남구 역삼동 737", "structure": {"detail": ""}},
+    "result": {"crs": "EPSG:4326", "point": {"x": "127.036508", "y": "37.500088"}}
+  }
+}`
+
+const vworldNotFoundResponse = `{"response": {"status": "NOT_FOUND"}}`
+
+// vworldPartialMatchResponse 주소는 인식했지만 좌표가 비어 있는 응답
+const vworldPartialMatchResponse = `{
+  "response": {
+    "status": "OK",
+    "input": {"type": "ROAD", "address": "서울특별시 강남구 테헤란로"},
+    "refined": {"text": "서울특별시 강남구 테헤란로", "structure": {"detail": ""}},
+    "result": {"crs": "EPSG:4326", "point": {"x": "", "y": ""}}
+  }
+}`
+
+// vworldTestServer 요청된 주소 타입을 기록하는 vWorld 테스트 서버
+type vworldTestServer struct {
+	*httptest.Server
+	mu    sync.Mutex
+	types []string
+}
+
+func (s *vworldTestServer) requestedTypes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.types...)
+}
+
+// newVWorldTestServer handler는 요청된 주소 타입에 대한 응답 본문을 반환
+func newVWorldTestServer(t *testing.T, handler func(addrType string) string) *vworldTestServer {
+	t.Helper()
+
+	s := &vworldTestServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addrType := r.URL.Query().Get("type")
+		s.mu.Lock()
+		s.types = append(s.types, addrType)
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(handler(addrType)))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestVWorldProvider(serverURL string) *VWorldProvider {
+	p := NewVWorldProvider("test-key", httpclient.NewClient(0), zap.NewNop())
+	p.baseURL = serverURL
+	return p
+}
+
+func TestVWorldProvider_Geocode_DetectedParcelTriedFirst(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		if addrType == "PARCEL" {
+			return vworldSuccessResponse
+		}
+		return vworldNotFoundResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.Geocode(context.Background(), "서울특별시 강남구 역삼동 737")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, []string{"PARCEL"}, server.requestedTypes())
+}
+
+func TestVWorldProvider_Geocode_DetectedRoadTriedFirst(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		if addrType == "ROAD" {
+			return vworldSuccessResponse
+		}
+		return vworldNotFoundResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.Geocode(context.Background(), "서울특별시 강남구 테헤란로 152")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, []string{"ROAD"}, server.requestedTypes())
+}
+
+func TestVWorldProvider_Geocode_FallsBackToOtherType(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		if addrType == "ROAD" {
+			return vworldSuccessResponse
+		}
+		return vworldNotFoundResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	// 지번으로 추정되지만 실제로는 도로명으로만 검색되는 경우
+	result, err := p.Geocode(context.Background(), "서울특별시 강남구 역삼동 737")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, []string{"PARCEL", "ROAD"}, server.requestedTypes())
+}
+
+func TestVWorldProvider_Geocode_AddressTypesRestricted(t *testing.T) {
+	notFound := func(addrType string) string { return vworldNotFoundResponse }
+
+	t.Run("road only skips parcel retry", func(t *testing.T) {
+		server := newVWorldTestServer(t, notFound)
+		p := newTestVWorldProvider(server.URL)
+		require.NoError(t, p.SetAddressTypes([]string{"road"}))
+
+		// 지번으로 추정되는 주소도 도로명으로 한 번만 호출
+		result, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 역삼동 737", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, []string{"ROAD"}, server.requestedTypes())
+	})
+
+	t.Run("disallowed explicit type is not requested", func(t *testing.T) {
+		server := newVWorldTestServer(t, notFound)
+		p := newTestVWorldProvider(server.URL)
+		require.NoError(t, p.SetAddressTypes([]string{"ROAD"}))
+
+		result, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 역삼동 737", "PARCEL")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.ErrorIs(t, result.Error, ErrNotCovered)
+		assert.Empty(t, server.requestedTypes())
+	})
+
+	t.Run("request overrides provider setting", func(t *testing.T) {
+		server := newVWorldTestServer(t, notFound)
+		p := newTestVWorldProvider(server.URL)
+		require.NoError(t, p.SetAddressTypes([]string{"ROAD"}))
+		ctx := WithAddressTypes(context.Background(), []string{"PARCEL"})
+
+		_, err := p.GeocodeWithType(ctx, "서울특별시 강남구 테헤란로 152", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"PARCEL"}, server.requestedTypes())
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		p := newTestVWorldProvider("http://localhost")
+		assert.Error(t, p.SetAddressTypes([]string{"ROAD", "BUILDING"}))
+	})
+}
+
+func TestVWorldProvider_GeocodeWithType_ExplicitType(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		return vworldNotFoundResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 역삼동 737", "road")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, []string{"ROAD"}, server.requestedTypes())
+}
+
+func TestVWorldProvider_GeocodeWithType_PartialMatch(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		return vworldPartialMatchResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 테헤란로", "ROAD")
+
+	assert.Nil(t, result)
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeNotFound, ce.Type)
+	assert.True(t, ce.Fallback)
+	assert.Contains(t, ce.Message, "partial match")
+}
+
+func TestVWorldProvider_Geocode_PartialMatchTriesOtherType(t *testing.T) {
+	server := newVWorldTestServer(t, func(addrType string) string {
+		if addrType == "ROAD" {
+			return vworldPartialMatchResponse
+		}
+		return vworldNotFoundResponse
+	})
+	p := newTestVWorldProvider(server.URL)
+
+	_, err := p.Geocode(context.Background(), "서울특별시 강남구 테헤란로 999")
+
+	// 다른 타입에서도 찾지 못하면 부분 일치 사유를 유지
+	assert.Equal(t, []string{"ROAD", "PARCEL"}, server.requestedTypes())
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeNotFound, ce.Type)
+	assert.Contains(t, ce.Message, "partial match")
+}
+
+// vworldRefinedRoadResponse 일반구가 있는 도로명 주소 응답 (refined.structure 전체 포함)
+const vworldRefinedRoadResponse = `{
+  "response": {
+    "status": "OK",
+    "input": {"type": "ROAD", "address": "경기도 성남시 분당구 판교역로 166"},
+    "refined": {
+      "text": "경기도 성남시 분당구 판교역로 166 (백현동)",
+      "structure": {
+        "level0": "대한민국", "level1": "경기도", "level2": "성남시", "level3": "분당구",
+        "level4L": "판교역로", "level4LC": "411353180012", "level4A": "백현동", "level4AC": "4113565500",
+        "level5": "166", "detail": "카카오 판교아지트"
+      }
+    },
+    "result": {"crs": "EPSG:4326", "point": {"x": "127.110522", "y": "37.395240"}}
+  }
+}`
+
+// vworldRefinedParcelResponse 산 번지 지번 주소 응답
+const vworldRefinedParcelResponse = `{
+  "response": {
+    "status": "OK",
+    "input": {"type": "PARCEL", "address": "서울특별시 종로구 부암동 산2-1"},
+    "refined": {
+      "text": "서울특별시 종로구 부암동 산2-1",
+      "structure": {
+        "level0": "대한민국", "level1": "서울특별시", "level2": "종로구", "level3": "부암동",
+        "level4L": "", "level4LC": "", "level4A": "부암동", "level4AC": "1111060000",
+        "level5": "산2-1", "detail": ""
+      }
+    },
+    "result": {"crs": "EPSG:4326", "point": {"x": "126.962185", "y": "37.592721"}}
+  }
+}`
+
+func TestVWorldProvider_GeocodeWithType_RefinedStructure(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		addrType string
+		address  string
+		want     model.AddressDetail
+	}{
+		{
+			name:     "road with non-autonomous district",
+			response: vworldRefinedRoadResponse,
+			addrType: "ROAD",
+			address:  "경기도 성남시 분당구 판교역로 166",
+			want: model.AddressDetail{
+				RoadAddress:    "경기도 성남시 분당구 판교역로 166",
+				ParcelAddress:  "경기도 성남시 분당구 판교역로 166 (백현동)",
+				BuildingName:   "카카오 판교아지트",
+				AdminDongCode:  "4113565500",
+				Sido:           "경기도",
+				Sigungu:        "성남시 분당구",
+				AdminDong:      "백현동",
+				RoadName:       "판교역로",
+				BuildingNo:     "166",
+				RefinedAddress: "경기도 성남시 분당구 판교역로 166 (백현동)",
+			},
+		},
+		{
+			name:     "mountain parcel",
+			response: vworldRefinedParcelResponse,
+			addrType: "PARCEL",
+			address:  "서울특별시 종로구 부암동 산2-1",
+			want: model.AddressDetail{
+				ParcelAddress:  "서울특별시 종로구 부암동 산2-1",
+				AdminDongCode:  "1111060000",
+				Sido:           "서울특별시",
+				Sigungu:        "종로구",
+				Dong:           "부암동",
+				AdminDong:      "부암동",
+				RefinedAddress: "서울특별시 종로구 부암동 산2-1",
+				IsMountain:     true,
+				MainNo:         "2",
+				SubNo:          "1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query url.Values
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+			p := newTestVWorldProvider(server.URL)
+
+			result, err := p.GeocodeWithType(context.Background(), tt.address, tt.addrType)
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			assert.Equal(t, tt.want, result.AddressDetail)
+			assert.Equal(t, "true", query.Get("refine"))
+			assert.Equal(t, "false", query.Get("simple"))
+		})
+	}
+}
+
+func TestVWorldProvider_Geocode_MatchedAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+  "response": {
+    "status": "OK",
+    "input": {"type": "ROAD", "address": "서울 중구 세종대로110"},
+    "refined": {"text": "서울특별시 중구 세종대로 110 (태평로1가)"},
+    "result": {"point": {"x": "126.977969", "y": "37.566535"}}
+  }
+}`))
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.GeocodeWithType(context.Background(), "서울 중구 세종대로110", "ROAD")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, "서울특별시 중구 세종대로 110 (태평로1가)", result.MatchedAddress)
+}
+
+func TestVWorldProvider_GeocodeWithType_StatusErrorCarriesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 요청 URL을 그대로 되돌려주는 게이트웨이 에러 (API 키 포함)
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("upstream error for " + r.URL.String()))
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+
+	_, err := p.GeocodeWithType(context.Background(), "서울특별시 중구 세종대로 110", "ROAD")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusBadGateway, ce.StatusCode)
+	assert.Contains(t, ce.Body, "upstream error for")
+	assert.Contains(t, ce.Body, "key=REDACTED")
+	assert.NotContains(t, ce.Body, "test-key")
+	assert.NotContains(t, ce.RequestURL, "test-key")
+	assert.NotContains(t, err.Error(), "test-key")
+}
+
+func TestVWorldProvider_GeocodeWithType_CRS(t *testing.T) {
+	var gotCRS string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCRS = r.URL.Query().Get("crs")
+		if gotCRS == "epsg:4326" {
+			w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:4326", "point": {"x": "126.977969", "y": "37.566535"}}}}`))
+			return
+		}
+		w.Write([]byte(`{"response": {"status": "OK", "result": {"crs": "EPSG:5179", "point": {"x": "953901.12", "y": "1952032.85"}}}}`))
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+
+	result, err := p.GeocodeWithType(context.Background(), "서울특별시 중구 세종대로 110", "ROAD")
+	require.NoError(t, err)
+	assert.Equal(t, "epsg:4326", gotCRS)
+	assert.Empty(t, result.CRS, "WGS84는 빈 값")
+
+	ctx := WithCRS(context.Background(), "EPSG:5179")
+	result, err = p.GeocodeWithType(ctx, "서울특별시 중구 세종대로 110", "ROAD")
+	require.NoError(t, err)
+	assert.Equal(t, "epsg:5179", gotCRS)
+	assert.Equal(t, "EPSG:5179", result.CRS)
+	assert.Equal(t, 1952032.85, result.Coordinate.Latitude)
+	assert.Equal(t, 953901.12, result.Coordinate.Longitude)
+}
+
+func TestParseVWorldCRS(t *testing.T) {
+	for input, want := range map[string]string{
+		"":           CRSWGS84,
+		"epsg:4326":  CRSWGS84,
+		"EPSG:5179":  "EPSG:5179",
+		" epsg:5186": "EPSG:5186",
+	} {
+		got, err := ParseVWorldCRS(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	for _, input := range []string{"EPSG:9999", "5179", "WGS84"} {
+		_, err := ParseVWorldCRS(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestVWorldProvider_GeocodeWithType_HTMLResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 200 상태로 HTML 에러 페이지를 돌려주는 게이트웨이 (API 키 포함)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><body>Service Unavailable: " + r.URL.String() + "</body></html>"))
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+
+	_, err := p.GeocodeWithType(context.Background(), "서울특별시 중구 세종대로 110", "ROAD")
+
+	ce, ok := IsClassifiedError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrorTypeSystemFailure, ce.Type)
+	assert.Contains(t, ce.Message, `Content-Type "text/html; charset=utf-8"`)
+	assert.Equal(t, http.StatusOK, ce.StatusCode)
+	assert.Contains(t, ce.Body, "<html><body>Service Unavailable")
+	assert.Contains(t, ce.Body, "key=REDACTED")
+	assert.NotContains(t, ce.Body, "test-key")
+	assert.True(t, ce.Fallback)
+}
+
+// go test -race로 실행하면 키 교체와 요청 간 데이터 경쟁을 검출한다
+func TestVWorldProvider_SetAPIKey_ConcurrentRotation(t *testing.T) {
+	var mu sync.Mutex
+	seen := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.URL.Query().Get("key")]++
+		mu.Unlock()
+		w.Write([]byte(vworldSuccessResponse))
+	}))
+	defer server.Close()
+	p := newTestVWorldProvider(server.URL)
+	keys := []string{"test-key", "rotated-1", "rotated-2"}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				result, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 역삼동 737", "PARCEL")
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+		}()
+	}
+	for i := range 50 {
+		p.SetAPIKey(keys[i%len(keys)])
+	}
+	wg.Wait()
+
+	p.SetAPIKey("final-key")
+	_, err := p.GeocodeWithType(context.Background(), "서울특별시 강남구 역삼동 737", "PARCEL")
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, seen["final-key"])
+	total := 0
+	for key, n := range seen {
+		assert.Contains(t, append(keys, "final-key"), key)
+		total += n
+	}
+	assert.Equal(t, 81, total)
+}
+
+func TestSplitLotNumber(t *testing.T) {
+	tests := []struct {
+		lot      string
+		mountain bool
+		main     string
+		sub      string
+	}{
+		{"737", false, "737", ""},
+		{"31-1", false, "31", "1"},
+		{"산2-1", true, "2", "1"},
+		{"산 12번지", true, "12", ""},
+		{"", false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lot, func(t *testing.T) {
+			mountain, main, sub := splitLotNumber(tt.lot)
+			assert.Equal(t, tt.mountain, mountain)
+			assert.Equal(t, tt.main, main)
+			assert.Equal(t, tt.sub, sub)
+		})
+	}
+}
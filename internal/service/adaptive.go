@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+)
+
+// defaultAdaptiveWindow StrategyAdaptive에서 Provider별로 기억하는 최근 호출 수 기본값
+const defaultAdaptiveWindow = 100
+
+// successTracker Provider별 최근 호출 성공률 집계 (StrategyAdaptive)
+// 단건·배치 호출이 동시에 기록하고 정렬하므로 mutex로 보호한다
+type successTracker struct {
+	mu      sync.Mutex
+	size    int
+	windows map[string]*successWindow
+}
+
+// successWindow 최근 size번 호출의 성공 여부를 담는 원형 버퍼
+type successWindow struct {
+	results   []bool
+	next      int
+	successes int
+}
+
+// newSuccessTracker size개 호출 단위로 성공률을 계산하는 집계기 생성 (0 이하면 기본값)
+func newSuccessTracker(size int) *successTracker {
+	if size <= 0 {
+		size = defaultAdaptiveWindow
+	}
+	return &successTracker{
+		size:    size,
+		windows: make(map[string]*successWindow),
+	}
+}
+
+// record Provider 호출 결과 기록 (윈도가 가득 차면 가장 오래된 결과를 밀어냄)
+func (t *successTracker) record(name string, success bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w, ok := t.windows[name]
+	if !ok {
+		w = &successWindow{results: make([]bool, 0, t.size)}
+		t.windows[name] = w
+	}
+
+	if len(w.results) < t.size {
+		w.results = append(w.results, success)
+	} else {
+		if w.results[w.next] {
+			w.successes--
+		}
+		w.results[w.next] = success
+		w.next = (w.next + 1) % t.size
+	}
+	if success {
+		w.successes++
+	}
+}
+
+// rate 최근 윈도의 성공률 (기록이 없으면 ok=false)
+func (t *successTracker) rate(name string) (float64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rateLocked(name)
+}
+
+func (t *successTracker) rateLocked(name string) (float64, bool) {
+	w, ok := t.windows[name]
+	if !ok || len(w.results) == 0 {
+		return 0, false
+	}
+	return float64(w.successes) / float64(len(w.results)), true
+}
+
+// order 성공률이 높은 순으로 정렬한 Provider 목록 복사본 반환
+// 기록이 없는 Provider는 성공률 1로 보고 먼저 기회를 주며, 같은 성공률이면 등록 순서를 유지한다
+func (t *successTracker) order(providers []provider.GeocodingProvider) []provider.GeocodingProvider {
+	t.mu.Lock()
+	rates := make([]float64, len(providers))
+	for i, p := range providers {
+		rate, ok := t.rateLocked(p.Name())
+		if !ok {
+			rate = 1
+		}
+		rates[i] = rate
+	}
+	t.mu.Unlock()
+
+	indexes := make([]int, len(providers))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return rates[indexes[a]] > rates[indexes[b]]
+	})
+
+	ordered := make([]provider.GeocodingProvider, len(providers))
+	for i, index := range indexes {
+		ordered[i] = providers[index]
+	}
+	return ordered
+}
+
+// track 실제 Provider 호출 결과를 집계기에 기록하도록 call을 감싼다
+// 사용 불가·속도 제한 대기 실패처럼 호출하지 않은 시도는 성공률에 반영하지 않는다
+func (t *successTracker) track(call providerCall) providerCall {
+	return func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error) {
+		result, err := call(ctx, p)
+		// 다른 Provider가 먼저 성공해 취소된 호출은 제외
+		if ctx.Err() == nil {
+			t.record(p.Name(), err == nil && result != nil && result.Success)
+		}
+		return result, err
+	}
+}
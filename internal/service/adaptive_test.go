@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSuccessTracker_RollingWindow(t *testing.T) {
+	tracker := newSuccessTracker(4)
+
+	_, ok := tracker.rate("vWorld")
+	assert.False(t, ok, "no history yet")
+
+	for _, success := range []bool{true, true, false, true} {
+		tracker.record("vWorld", success)
+	}
+	rate, ok := tracker.rate("vWorld")
+	require.True(t, ok)
+	assert.Equal(t, 0.75, rate)
+
+	// 오래된 성공 두 건이 밀려남
+	tracker.record("vWorld", false)
+	tracker.record("vWorld", false)
+	rate, _ = tracker.rate("vWorld")
+	assert.Equal(t, 0.25, rate)
+}
+
+func TestSuccessTracker_Order(t *testing.T) {
+	vworld := &mockProvider{name: "vWorld", available: true}
+	kakao := &mockProvider{name: "Kakao", available: true}
+	nominatim := &mockProvider{name: "Nominatim", available: true}
+	providers := []provider.GeocodingProvider{vworld, kakao, nominatim}
+
+	tracker := newSuccessTracker(10)
+	assert.Equal(t, providers, tracker.order(providers), "registration order without history")
+
+	tracker.record("vWorld", false)
+	tracker.record("Kakao", true)
+
+	// 기록 없는 Nominatim은 성공률 1로 간주하되 같은 성공률의 Kakao 뒤에 유지
+	assert.Equal(t, []provider.GeocodingProvider{kakao, nominatim, vworld}, tracker.order(providers))
+	assert.Equal(t, "vWorld", providers[0].Name(), "input slice untouched")
+}
+
+func TestGeocodingService_Geocode_AdaptiveStrategy(t *testing.T) {
+	vworld := &mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: false}}
+	kakao := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+	}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+		Strategy:       StrategyAdaptive,
+		AdaptiveWindow: 4,
+	})
+	geocode := func() *model.GeocodingResponse {
+		t.Helper()
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		return result
+	}
+
+	// 처음에는 등록 순서대로 vWorld 실패 후 Kakao 성공
+	first := geocode()
+	require.Len(t, first.Attempts, 2)
+	assert.Equal(t, "vWorld", first.Attempts[0].Provider)
+
+	// 성공률이 높은 Kakao를 먼저 시도
+	for i := 0; i < 4; i++ {
+		result := geocode()
+		require.Len(t, result.Attempts, 1)
+		assert.Equal(t, "Kakao", result.Attempts[0].Provider)
+	}
+
+	// Kakao가 계속 실패하기 시작하면 윈도가 밀려나면서 다시 vWorld가 앞으로
+	vworld.result = kakao.result
+	kakao.result = &model.ProviderResult{Success: false}
+	var providers []string
+	for i := 0; i < 4; i++ {
+		providers = append(providers, geocode().Attempts[0].Provider)
+	}
+	assert.Equal(t, "Kakao", providers[0])
+	assert.Equal(t, "vWorld", providers[len(providers)-1])
+}
+
+func TestGeocodingService_Geocode_AdaptiveStrategyConcurrent(t *testing.T) {
+	vworld := &mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: false}}
+	kakao := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+	}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+		Strategy: StrategyAdaptive,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		}()
+	}
+	wg.Wait()
+
+	kakaoRate, ok := svc.tracker.rate("Kakao")
+	require.True(t, ok)
+	assert.Equal(t, 1.0, kakaoRate)
+	assert.Equal(t, "Kakao", svc.tracker.order(svc.providers)[0].Name())
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+)
+
+// defaultDisableBackoffReset 한도 초과 단계를 초기화하기 위해 필요한 연속 정상 응답 기간 기본값
+const defaultDisableBackoffReset = 30 * time.Minute
+
+// disableBackoff Provider별 연속 한도 초과 횟수에 따라 비활성화 기간을 늘려가는 집계기
+// 단건·배치 호출이 동시에 기록하므로 mutex로 보호한다
+type disableBackoff struct {
+	mu      sync.Mutex
+	steps   []time.Duration
+	reset   time.Duration
+	clock   clock.Clock
+	entries map[string]*backoffEntry
+}
+
+// backoffEntry Provider 하나의 한도 초과 이력
+type backoffEntry struct {
+	strikes     int       // 초기화 이후 연속 한도 초과 횟수
+	recoveredAt time.Time // 마지막 한도 초과 이후 첫 정상 응답 시각 (zero면 아직 없음)
+}
+
+// newDisableBackoff steps 순서로 비활성화 기간을 늘리는 집계기 생성
+// reset이 0 이하면 기본값, clk이 nil이면 시스템 시계를 사용한다
+func newDisableBackoff(steps []time.Duration, reset time.Duration, clk clock.Clock) *disableBackoff {
+	if reset <= 0 {
+		reset = defaultDisableBackoffReset
+	}
+	return &disableBackoff{
+		steps:   steps,
+		reset:   reset,
+		clock:   clock.OrReal(clk),
+		entries: make(map[string]*backoffEntry),
+	}
+}
+
+// next 한도 초과를 기록하고 이번 비활성화 기간 반환
+// 단계를 모두 지나면 마지막 단계를 유지하며, Provider가 알려준 retryAfter가 더 길면 그것을 사용한다
+func (b *disableBackoff) next(name string, retryAfter time.Duration) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[name]
+	if !ok {
+		e = &backoffEntry{}
+		b.entries[name] = e
+	}
+	e.strikes++
+	e.recoveredAt = time.Time{}
+
+	cooldown := b.steps[min(e.strikes, len(b.steps))-1]
+	return max(cooldown, retryAfter)
+}
+
+// recordSuccess 정상 응답을 기록
+// 한도 초과 이후 reset 기간 동안 다시 한도를 넘지 않았으면 단계를 초기화한다
+func (b *disableBackoff) recordSuccess(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[name]
+	if !ok || e.strikes == 0 {
+		return
+	}
+	now := b.clock.Now()
+	if e.recoveredAt.IsZero() {
+		e.recoveredAt = now
+		return
+	}
+	if now.Sub(e.recoveredAt) >= b.reset {
+		delete(b.entries, name)
+	}
+}
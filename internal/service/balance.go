@@ -0,0 +1,104 @@
+package service
+
+import (
+	"math/rand/v2"
+
+	"github.com/oursportsnation/k-geocode/internal/provider"
+)
+
+// quotaBalancer 같은 이름의 Provider 인스턴스(여러 API 키) 사이에서 시도 순서를 정하는 부하 분산기 (StrategyBalanced)
+// 남은 일일 할당량에 비례한 확률로 먼저 시도할 인스턴스를 뽑으므로 첫 번째 키에 호출이 몰리지 않는다
+// 이름이 다른 Provider 사이의 순서(vWorld → Kakao 등)는 바꾸지 않아 종류 간 폴백은 그대로 동작한다
+type quotaBalancer struct {
+	limits map[string]int // Provider 이름별 일일 할당량 (없는 이름은 provider.DailyLimits)
+	random func() float64 // [0, 1) 난수 (테스트에서 교체)
+}
+
+// newQuotaBalancer limits를 일일 할당량으로 쓰는 부하 분산기 생성
+func newQuotaBalancer(limits map[string]int) *quotaBalancer {
+	return &quotaBalancer{limits: limits, random: rand.Float64}
+}
+
+// order 같은 이름의 인스턴스끼리 자리를 섞은 Provider 목록 복사본 반환
+// 인스턴스가 하나뿐인 이름은 그대로 두며, 섞인 인스턴스는 원래 그 이름이 차지하던 자리들에 들어간다
+func (b *quotaBalancer) order(providers []provider.GeocodingProvider) []provider.GeocodingProvider {
+	slots := make(map[string][]int)
+	var names []string
+	for i, p := range providers {
+		name := p.Name()
+		if _, ok := slots[name]; !ok {
+			names = append(names, name)
+		}
+		slots[name] = append(slots[name], i)
+	}
+
+	ordered := make([]provider.GeocodingProvider, len(providers))
+	copy(ordered, providers)
+	for _, name := range names {
+		indexes := slots[name]
+		if len(indexes) < 2 {
+			continue
+		}
+		group := make([]provider.GeocodingProvider, len(indexes))
+		for i, index := range indexes {
+			group[i] = providers[index]
+		}
+		for i, p := range b.shuffle(group) {
+			ordered[indexes[i]] = p
+		}
+	}
+	return ordered
+}
+
+// shuffle 가중치(남은 할당량)에 비례한 확률로 하나씩 뽑는 비복원 추출 순서 반환
+// 남은 인스턴스의 가중치가 모두 0이면 (할당량 소진) 남은 순서대로 뒤에 붙인다
+func (b *quotaBalancer) shuffle(group []provider.GeocodingProvider) []provider.GeocodingProvider {
+	weights := make([]float64, len(group))
+	for i, p := range group {
+		weights[i] = b.weight(p)
+	}
+
+	shuffled := make([]provider.GeocodingProvider, 0, len(group))
+	for len(group) > 0 {
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+		if total <= 0 {
+			return append(shuffled, group...)
+		}
+
+		pick := len(group) - 1
+		r := b.random() * total
+		for i, w := range weights {
+			if r < w {
+				pick = i
+				break
+			}
+			r -= w
+		}
+
+		shuffled = append(shuffled, group[pick])
+		group = append(group[:pick:pick], group[pick+1:]...)
+		weights = append(weights[:pick:pick], weights[pick+1:]...)
+	}
+	return shuffled
+}
+
+// weight 인스턴스의 남은 일일 할당량 (할당량이나 호출 통계를 알 수 없으면 모든 인스턴스에 같은 가중치 1)
+func (b *quotaBalancer) weight(p provider.GeocodingProvider) float64 {
+	limit, ok := b.limits[p.Name()]
+	if !ok {
+		limit = provider.DailyLimits[p.Name()]
+	}
+	reporter, isReporter := p.(provider.StatsReporter)
+	if limit <= 0 || !isReporter {
+		return 1
+	}
+
+	remaining := int64(limit) - reporter.Stats().CallsToday
+	if remaining <= 0 {
+		return 0
+	}
+	return float64(remaining)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// quotaProvider 오늘 호출 수를 보고하는 Provider (provider.StatsReporter)
+type quotaProvider struct {
+	mockProvider
+	calls atomic.Int64
+}
+
+func (p *quotaProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.calls.Add(1)
+	return p.mockProvider.Geocode(ctx, address)
+}
+
+func (p *quotaProvider) Stats() provider.StatsSnapshot {
+	return provider.StatsSnapshot{CallsToday: p.calls.Load()}
+}
+
+func newQuotaProvider(name string, success bool) *quotaProvider {
+	result := &model.ProviderResult{Success: false, Error: provider.ErrAddressNotFound}
+	if success {
+		result = &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780},
+		}
+	}
+	return &quotaProvider{mockProvider: mockProvider{name: name, available: true, result: result}}
+}
+
+func TestStrategyBalanced_SpreadsLoadAcrossInstances(t *testing.T) {
+	keys := []*quotaProvider{newQuotaProvider("vWorld", true), newQuotaProvider("vWorld", true), newQuotaProvider("vWorld", true)}
+	kakao := newQuotaProvider("Kakao", true)
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{keys[0], keys[1], keys[2], kakao}, zap.NewNop(), Options{
+		Strategy: StrategyBalanced,
+	})
+
+	const requests = 3000
+	for i := 0; i < requests; i++ {
+		resp, err := svc.Geocode(context.Background(), fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1), "")
+		require.NoError(t, err)
+		require.True(t, resp.Success)
+	}
+
+	for i, key := range keys {
+		assert.InDelta(t, requests/3, key.calls.Load(), requests/3*0.15, "vWorld 키 #%d에 고르게 분산", i+1)
+	}
+	assert.Zero(t, kakao.calls.Load(), "vWorld가 성공하면 Kakao로 넘어가지 않음")
+}
+
+func TestStrategyBalanced_FallsBackAcrossTypes(t *testing.T) {
+	keys := []*quotaProvider{newQuotaProvider("vWorld", false), newQuotaProvider("vWorld", false)}
+	kakao := newQuotaProvider("Kakao", true)
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{keys[0], keys[1], kakao}, zap.NewNop(), Options{
+		Strategy: StrategyBalanced,
+	})
+
+	resp, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+	require.NoError(t, err)
+
+	assert.True(t, resp.Success)
+	assert.Equal(t, "Kakao", resp.Provider)
+	require.Len(t, resp.Attempts, 3, "vWorld 인스턴스를 모두 시도한 뒤 Kakao로 폴백")
+	assert.Equal(t, "Kakao", resp.Attempts[2].Provider)
+}
+
+func TestQuotaBalancer_Order(t *testing.T) {
+	a, b, c := newQuotaProvider("vWorld", true), newQuotaProvider("vWorld", true), newQuotaProvider("vWorld", true)
+	kakao := newQuotaProvider("Kakao", true)
+	a.calls.Store(100) // 할당량 소진
+	b.calls.Store(70)  // 남은 할당량 30
+	c.calls.Store(40)  // 남은 할당량 60
+
+	balancer := newQuotaBalancer(map[string]int{"vWorld": 100})
+	providers := []provider.GeocodingProvider{a, kakao, b, c}
+
+	balancer.random = func() float64 { return 0 }
+	assert.Equal(t, []provider.GeocodingProvider{b, kakao, c, a}, balancer.order(providers),
+		"가중치 순서대로 뽑고 할당량이 소진된 인스턴스는 마지막, Kakao 자리는 그대로")
+
+	balancer.random = func() float64 { return 0.5 } // 90 × 0.5 = 45 → 두 번째 구간(c)
+	assert.Equal(t, []provider.GeocodingProvider{c, kakao, b, a}, balancer.order(providers))
+
+	assert.Equal(t, []provider.GeocodingProvider{a, kakao, b, c}, providers, "원본 목록은 바꾸지 않음")
+}
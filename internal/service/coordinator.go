@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
+	"github.com/oursportsnation/k-geocode/internal/cache"
 	"github.com/oursportsnation/k-geocode/internal/config"
 	"github.com/oursportsnation/k-geocode/internal/provider"
 	"github.com/oursportsnation/k-geocode/pkg/httpclient"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 // CoordinatorInterface 코디네이터 인터페이스
@@ -21,9 +24,23 @@ type Coordinator struct {
 	config           *config.Config
 	geocodingService *GeocodingService
 	providers        []provider.GeocodingProvider
+	normalizer       provider.AddressNormalizer // 도로명주소 API 정규화 (설정하지 않으면 nil)
+	cache            cache.Cache                // 결과 캐시 (cache.ttl이 0이면 nil)
+	httpClient       *httpclient.Client
+	lifecycle        *Lifecycle
 	logger           *zap.Logger
+	healthTimeout    time.Duration // Provider 헬스 체크 전체 제한 시간 (0이면 providerHealthTimeout)
 }
 
+// redisHealthTimeout 헬스 체크에서 Redis PING을 기다리는 최대 시간
+const redisHealthTimeout = time.Second
+
+// providerHealthTimeout 헬스 체크에서 모든 Provider의 가용성 확인을 기다리는 최대 시간
+const providerHealthTimeout = 2 * time.Second
+
+// healthCheckConcurrency 헬스 체크에서 동시에 확인하는 Provider 수
+const healthCheckConcurrency = 4
+
 // NewCoordinator 조율자 생성자
 func NewCoordinator(cfg *config.Config, logger *zap.Logger) (*Coordinator, error) {
 	coord := &Coordinator{
@@ -46,8 +63,9 @@ func NewCoordinator(cfg *config.Config, logger *zap.Logger) (*Coordinator, error
 func (c *Coordinator) initProviders() error {
 	c.providers = make([]provider.GeocodingProvider, 0)
 	
-	// HTTP 클라이언트 생성
+	// HTTP 클라이언트 생성 (Provider 공용)
 	httpClient := httpclient.DefaultClient()
+	c.httpClient = httpClient
 	
 	// vWorld Provider
 	if c.config.Providers.VWorld.Enabled {
@@ -59,6 +77,14 @@ func (c *Coordinator) initProviders() error {
 				httpClient,
 				c.logger.Named("vworld"),
 			)
+			if baseURL := c.config.Providers.VWorld.BaseURL; baseURL != "" {
+				if err := vworldProvider.SetBaseURL(baseURL); err != nil {
+					return fmt.Errorf("vWorld provider: %w", err)
+				}
+			}
+			if err := vworldProvider.SetAddressTypes(c.config.Providers.VWorld.AddressTypes); err != nil {
+				return fmt.Errorf("vWorld provider: %w", err)
+			}
 			c.providers = append(c.providers, vworldProvider)
 			c.logger.Info("vWorld provider initialized")
 		}
@@ -74,11 +100,50 @@ func (c *Coordinator) initProviders() error {
 				httpClient,
 				c.logger.Named("kakao"),
 			)
+			kakaoProvider.SetKeywordFallback(c.config.Providers.Kakao.KeywordFallback)
+			kakaoProvider.SetExactFallback(c.config.Providers.Kakao.ExactFallback)
+			if err := kakaoProvider.SetAnalyzeType(c.config.Providers.Kakao.AnalyzeType); err != nil {
+				return fmt.Errorf("Kakao provider: %w", err)
+			}
+			if baseURL := c.config.Providers.Kakao.BaseURL; baseURL != "" {
+				if err := kakaoProvider.SetBaseURL(baseURL); err != nil {
+					return fmt.Errorf("Kakao provider: %w", err)
+				}
+			}
 			c.providers = append(c.providers, kakaoProvider)
 			c.logger.Info("Kakao provider initialized")
 		}
 	}
 	
+	// Nominatim Provider (선택 사항, 항상 마지막 순서)
+	if c.config.Providers.Nominatim.Enabled {
+		if c.config.Providers.Nominatim.BaseURL == "" {
+			c.logger.Warn("Nominatim provider is enabled but base URL is missing")
+		} else {
+			nominatimProvider, err := provider.NewNominatimProvider(
+				c.config.Providers.Nominatim.BaseURL,
+				httpClient,
+				c.logger.Named("nominatim"),
+			)
+			if err != nil {
+				return fmt.Errorf("Nominatim provider: %w", err)
+			}
+			if userAgent := c.config.Providers.Nominatim.UserAgent; userAgent != "" {
+				if err := nominatimProvider.SetUserAgent(userAgent); err != nil {
+					return fmt.Errorf("Nominatim provider: %w", err)
+				}
+			}
+			c.providers = append(c.providers, nominatimProvider)
+			c.logger.Info("Nominatim provider initialized")
+		}
+	}
+
+	// 도로명주소 API (선택 사항, 좌표가 없으므로 체인이 아닌 정규화 단계로 사용)
+	if c.config.Providers.Juso.Enabled {
+		c.normalizer = provider.NewJusoProvider(c.config.Providers.Juso.APIKey, httpClient, c.logger.Named("juso"))
+		c.logger.Info("Juso normalizer initialized")
+	}
+	
 	// 최소 하나의 Provider는 필요
 	if len(c.providers) == 0 {
 		return fmt.Errorf("no providers available - check API keys")
@@ -93,8 +158,19 @@ func (c *Coordinator) initProviders() error {
 
 // initServices 서비스들을 초기화
 func (c *Coordinator) initServices() {
+	// 결과 캐시 (cache.ttl이 0이면 사용 안 함)
+	if c.config.Cache.TTL > 0 {
+		c.cache = cache.NewMemory(c.config.Cache.TTL, c.config.Cache.MaxEntries)
+	}
+
 	// 지오코딩 서비스 초기화
-	c.geocodingService = NewGeocodingService(c.providers, c.logger.Named("geocoding"))
+	// debug 로그 레벨에서는 시도 내역에 요청 URL 포함
+	c.geocodingService = NewGeocodingServiceWithOptions(c.providers, c.logger.Named("geocoding"), Options{
+		Debug:      c.config.Logging.Level == "debug",
+		Normalizer: c.normalizer,
+		Cache:      c.cache,
+	})
+	c.lifecycle = NewLifecycle(c.geocodingService, c.providers, c.cache, c.httpClient)
 	
 	c.logger.Info("Services initialized")
 }
@@ -104,6 +180,11 @@ func (c *Coordinator) GetGeocodingService() *GeocodingService {
 	return c.geocodingService
 }
 
+// GetNormalizer 주소 정규화 Provider 반환 (설정하지 않았으면 nil)
+func (c *Coordinator) GetNormalizer() provider.AddressNormalizer {
+	return c.normalizer
+}
+
 // GetProviders Provider 목록 반환
 func (c *Coordinator) GetProviders() []provider.GeocodingProvider {
 	return c.providers
@@ -113,25 +194,22 @@ func (c *Coordinator) GetProviders() []provider.GeocodingProvider {
 func (c *Coordinator) HealthCheck(ctx context.Context) HealthStatus {
 	status := HealthStatus{
 		Healthy:   true,
-		Providers: make([]ProviderStatus, 0),
+		Providers: make([]ProviderStatus, 0, len(c.providers)),
 	}
 	
-	// 각 Provider의 가용성 확인
-	for _, p := range c.providers {
-		providerStatus := ProviderStatus{
-			Name:      p.Name(),
-			Available: p.IsAvailable(ctx),
+	// 각 Provider의 가용성 확인 (동시에 확인하고 결과는 Provider 순서대로)
+	available, checked := c.probeProviders(ctx)
+	for i, p := range c.providers {
+		providerStatus := c.providerStatus(p)
+		providerStatus.Available = available[i]
+		if !checked[i] {
+			providerStatus.CheckError = "health check timed out"
 		}
 		
 		status.Providers = append(status.Providers, providerStatus)
-		
-		// 하나라도 사용 가능하면 시스템은 healthy
-		if providerStatus.Available {
-			status.Healthy = true
-		}
 	}
 	
-	// 모든 Provider가 사용 불가능하면 unhealthy
+	// 모든 Provider가 사용 불가능하면 unhealthy (하나라도 사용 가능하면 healthy)
 	allUnavailable := true
 	for _, ps := range status.Providers {
 		if ps.Available {
@@ -143,27 +221,158 @@ func (c *Coordinator) HealthCheck(ctx context.Context) HealthStatus {
 		status.Healthy = false
 	}
 	
+	// 선택 구성 요소 (캐시는 선택 사항이므로 장애여도 Healthy에 반영하지 않음)
+	if c.config != nil && c.config.Redis.Enabled {
+		status.Components = append(status.Components, c.checkRedis(ctx))
+	}
+	
 	return status
 }
 
+// probeProviders 최대 healthCheckConcurrency개씩 동시에 Provider 가용성(IsAvailable) 확인
+// 전체 확인은 healthTimeout(0이면 providerHealthTimeout) 안에 끝나며, 그때까지 응답하지 않은 Provider는
+// 기다리지 않고 사용 불가(checked=false)로 보고한다. 결과는 c.providers와 같은 순서다
+func (c *Coordinator) probeProviders(ctx context.Context) (available, checked []bool) {
+	timeout := c.healthTimeout
+	if timeout <= 0 {
+		timeout = providerHealthTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type probe struct {
+		index     int
+		available bool
+	}
+	results := make(chan probe, len(c.providers))
+	go func() {
+		var g errgroup.Group
+		g.SetLimit(healthCheckConcurrency)
+		for i, p := range c.providers {
+			g.Go(func() error {
+				results <- probe{index: i, available: p.IsAvailable(ctx)}
+				return nil
+			})
+		}
+		g.Wait()
+	}()
+
+	available = make([]bool, len(c.providers))
+	checked = make([]bool, len(c.providers))
+	for range c.providers {
+		select {
+		case r := <-results:
+			available[r.index] = r.available
+			checked[r.index] = true
+		case <-ctx.Done():
+			c.logger.Warn("Provider health check timed out", zap.Duration("timeout", timeout))
+			return available, checked
+		}
+	}
+	return available, checked
+}
+
+// providerStatus 가용성(Available)을 제외한 Provider 상태 (비활성화, 할당량, 최근 에러)
+func (c *Coordinator) providerStatus(p provider.GeocodingProvider) ProviderStatus {
+	providerStatus := ProviderStatus{
+		Name:          p.Name(),
+		Disabled:      p.IsDisabled(),
+		DisableReason: p.GetDisableReason(),
+		DailyLimit:    c.dailyLimit(p.Name()),
+	}
+	if td, ok := p.(provider.TemporaryDisabler); ok && providerStatus.Disabled {
+		if until := td.DisabledUntil(); !until.IsZero() {
+			providerStatus.DisabledUntil = &until
+		}
+	}
+	if sr, ok := p.(provider.StatsReporter); ok {
+		stats := sr.Stats()
+		providerStatus.CallsToday = stats.CallsToday
+		if providerStatus.DailyLimit > 0 {
+			remaining := stats.RemainingQuota(providerStatus.DailyLimit)
+			providerStatus.RemainingQuota = &remaining
+		}
+		if stats.LastError != "" {
+			providerStatus.LastError = stats.LastError
+			providerStatus.LastErrorAt = &stats.LastErrorAt
+		}
+	}
+	return providerStatus
+}
+
+// checkRedis Redis에 PING을 보내 구성 요소 상태 반환
+func (c *Coordinator) checkRedis(ctx context.Context) ComponentStatus {
+	redis := c.config.Redis
+	timeout := redisHealthTimeout
+	if redis.Timeout > 0 && redis.Timeout < timeout {
+		timeout = redis.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	
+	component := ComponentStatus{Name: "redis", Healthy: true}
+	if err := cache.PingRedis(ctx, redis.Addr, redis.Password, redis.DB); err != nil {
+		component.Healthy = false
+		component.Error = err.Error()
+		c.logger.Warn("Redis health check failed", zap.String("addr", redis.Addr), zap.Error(err))
+	}
+	return component
+}
+
+// dailyLimit Provider 일일 할당량 (설정값이 없으면 provider.DailyLimits 기본값)
+func (c *Coordinator) dailyLimit(name string) int {
+	if c.config != nil {
+		switch name {
+		case "vWorld":
+			if c.config.Providers.VWorld.DailyLimit > 0 {
+				return c.config.Providers.VWorld.DailyLimit
+			}
+		case "Kakao":
+			if c.config.Providers.Kakao.DailyLimit > 0 {
+				return c.config.Providers.Kakao.DailyLimit
+			}
+		case "Nominatim":
+			if c.config.Providers.Nominatim.DailyLimit > 0 {
+				return c.config.Providers.Nominatim.DailyLimit
+			}
+		}
+	}
+	return provider.DailyLimits[name]
+}
+
 // Shutdown 조율자 종료
-func (c *Coordinator) Shutdown() error {
+// 진행 중인 요청을 기다린 뒤 Provider, HTTP 클라이언트 순으로 닫으며 ctx가 제한 시간이 된다
+// 구성 요소별 에러를 모아 반환하고, 두 번째 호출부터는 아무것도 하지 않는다
+func (c *Coordinator) Shutdown(ctx context.Context) error {
 	c.logger.Info("Shutting down coordinator")
-	
-	// 필요한 정리 작업 수행
-	// 예: Provider 연결 종료, 리소스 해제 등
-	
-	return nil
+	return c.lifecycle.Shutdown(ctx)
 }
 
 // HealthStatus 헬스 체크 상태
 type HealthStatus struct {
-	Healthy   bool             `json:"healthy"`
-	Providers []ProviderStatus `json:"providers"`
+	Healthy    bool              `json:"healthy"`
+	Providers  []ProviderStatus  `json:"providers"`
+	Components []ComponentStatus `json:"components,omitempty"` // 선택 구성 요소 (Redis 등, Healthy에 반영하지 않음)
+}
+
+// ComponentStatus Provider 외 구성 요소 상태
+type ComponentStatus struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
 }
 
 // ProviderStatus Provider 상태
 type ProviderStatus struct {
-	Name      string `json:"name"`
-	Available bool   `json:"available"`
+	Name           string     `json:"name"`
+	Available      bool       `json:"available"`
+	Disabled       bool       `json:"disabled"`
+	DisableReason  string     `json:"disable_reason,omitempty"`
+	DisabledUntil  *time.Time `json:"disabled_until,omitempty"`  // 일시 비활성화 만료 시각 (영구 비활성화면 nil)
+	DailyLimit     int        `json:"daily_limit,omitempty"`     // 일일 할당량 (알 수 없으면 0)
+	CallsToday     int64      `json:"calls_today"`               // 오늘(한국 시간) 보낸 HTTP 요청 수
+	RemainingQuota *int       `json:"remaining_quota,omitempty"` // 남은 일일 할당량 추정치 (할당량을 모르면 nil)
+	LastError      string     `json:"last_error,omitempty"`
+	LastErrorAt    *time.Time `json:"last_error_at,omitempty"`
+	CheckError     string     `json:"check_error,omitempty"` // 가용성 확인 실패 사유 (제한 시간 초과 등)
 }
\ No newline at end of file
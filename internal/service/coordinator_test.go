@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/config"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCoordinator_AllProvidersDisabled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		Providers: config.ProvidersConfig{
+			VWorld: config.ProviderConfig{Enabled: true, APIKey: "vworld-key", BaseURL: server.URL},
+			Kakao:  config.ProviderConfig{Enabled: true, APIKey: "kakao-key", BaseURL: server.URL},
+		},
+	}
+	coord, err := NewCoordinator(cfg, zap.NewNop())
+	require.NoError(t, err)
+	require.True(t, coord.HealthCheck(context.Background()).Healthy)
+
+	// 두 API 키가 모두 만료된 상황
+	for _, p := range coord.providers {
+		p.Disable("API key expired")
+	}
+
+	status := coord.HealthCheck(context.Background())
+	assert.False(t, status.Healthy)
+	require.Len(t, status.Providers, 2)
+	for _, ps := range status.Providers {
+		assert.False(t, ps.Available, ps.Name)
+	}
+
+	result, err := coord.GetGeocodingService().Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, result)
+	assert.Zero(t, requests.Load())
+}
+
+func TestCoordinator_Cache(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"meta":{"total_count":1},"documents":[{"address_name":"서울 중구 세종대로 110","address_type":"ROAD_ADDR","x":"126.977969","y":"37.566535"}]}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		Providers: config.ProvidersConfig{
+			Kakao: config.ProviderConfig{Enabled: true, APIKey: "kakao-key", BaseURL: server.URL},
+		},
+		Cache: config.CacheConfig{TTL: time.Minute},
+	}
+	coord, err := NewCoordinator(cfg, zap.NewNop())
+	require.NoError(t, err)
+	svc := coord.GetGeocodingService()
+
+	for i := 0; i < 2; i++ {
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		require.NoError(t, err)
+		require.True(t, result.Success)
+	}
+	assert.EqualValues(t, 1, requests.Load(), "second lookup is served from the cache")
+
+	// no-cache 요청은 캐시를 건너뛴다
+	_, err = svc.Geocode(WithSkipCache(context.Background()), "서울특별시 중구 세종대로 110", "")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestCoordinator_Shutdown(t *testing.T) {
+	cfg := &config.Config{
+		Providers: config.ProvidersConfig{
+			Kakao: config.ProviderConfig{Enabled: true, APIKey: "kakao-key"},
+		},
+	}
+	coord, err := NewCoordinator(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, coord.Shutdown(context.Background()))
+	require.NoError(t, coord.Shutdown(context.Background()))
+}
+
+func TestCoordinator_HealthCheck_ProviderDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		Providers: config.ProvidersConfig{
+			VWorld: config.ProviderConfig{Enabled: true, APIKey: "vworld-key", BaseURL: server.URL},
+			Kakao:  config.ProviderConfig{Enabled: true, APIKey: "kakao-key", BaseURL: server.URL, DailyLimit: 10},
+		},
+	}
+	coord, err := NewCoordinator(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	coord.GetGeocodingService().Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+	coord.providers[0].Disable("API key expired")
+
+	status := coord.HealthCheck(context.Background())
+	require.Len(t, status.Providers, 2)
+
+	vworld := status.Providers[0]
+	assert.True(t, vworld.Disabled)
+	assert.Equal(t, "API key expired", vworld.DisableReason)
+	assert.Equal(t, 40000, vworld.DailyLimit, "default limit")
+
+	kakao := status.Providers[1]
+	assert.True(t, kakao.Available)
+	assert.Equal(t, 10, kakao.DailyLimit)
+	assert.Equal(t, int64(1), kakao.CallsToday)
+	require.NotNil(t, kakao.RemainingQuota)
+	assert.Equal(t, 9, *kakao.RemainingQuota)
+	assert.NotEmpty(t, kakao.LastError)
+	assert.NotNil(t, kakao.LastErrorAt)
+}
+
+func TestCoordinator_HealthCheck_Redis(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// PING에 PONG으로만 응답하는 Redis 대역
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 64)
+				for {
+					if _, err := conn.Read(buf); err != nil {
+						return
+					}
+					conn.Write([]byte("+PONG\r\n"))
+				}
+			}()
+		}
+	}()
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachable := closed.Addr().String()
+	closed.Close()
+
+	newCoordinator := func(redis config.RedisConfig) *Coordinator {
+		cfg := &config.Config{
+			Providers: config.ProvidersConfig{
+				Kakao: config.ProviderConfig{Enabled: true, APIKey: "kakao-key", BaseURL: server.URL},
+			},
+			Redis: redis,
+		}
+		coord, err := NewCoordinator(cfg, zap.NewNop())
+		require.NoError(t, err)
+		return coord
+	}
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		status := newCoordinator(config.RedisConfig{Addr: unreachable}).HealthCheck(ctx)
+		assert.True(t, status.Healthy)
+		assert.Empty(t, status.Components)
+	})
+
+	t.Run("reachable", func(t *testing.T) {
+		status := newCoordinator(config.RedisConfig{Enabled: true, Addr: ln.Addr().String()}).HealthCheck(ctx)
+		assert.True(t, status.Healthy)
+		assert.Equal(t, []ComponentStatus{{Name: "redis", Healthy: true}}, status.Components)
+	})
+
+	t.Run("unreachable does not fail health", func(t *testing.T) {
+		status := newCoordinator(config.RedisConfig{Enabled: true, Addr: unreachable}).HealthCheck(ctx)
+		assert.True(t, status.Healthy)
+		require.Len(t, status.Components, 1)
+		assert.Equal(t, "redis", status.Components[0].Name)
+		assert.False(t, status.Components[0].Healthy)
+		assert.Contains(t, status.Components[0].Error, "redis connect")
+	})
+}
+
+// probingProvider 가용성 확인에 delay만큼 걸리는 Provider (ctx를 무시하는 느린 네트워크 확인 대역)
+type probingProvider struct {
+	mockProvider
+	delay time.Duration
+}
+
+func (p *probingProvider) IsAvailable(ctx context.Context) bool {
+	time.Sleep(p.delay)
+	return p.mockProvider.IsAvailable(ctx)
+}
+
+func TestCoordinator_HealthCheck_ConcurrentProbes(t *testing.T) {
+	newProvider := func(name string, delay time.Duration, available bool) *probingProvider {
+		return &probingProvider{mockProvider: mockProvider{name: name, available: available}, delay: delay}
+	}
+
+	t.Run("probes run concurrently in provider order", func(t *testing.T) {
+		coord := &Coordinator{
+			providers: []provider.GeocodingProvider{
+				newProvider("slow", 100*time.Millisecond, true),
+				newProvider("fast", 0, false),
+				newProvider("medium", 50*time.Millisecond, true),
+				newProvider("slow2", 100*time.Millisecond, false),
+			},
+			logger: zap.NewNop(),
+		}
+
+		start := time.Now()
+		status := coord.HealthCheck(context.Background())
+
+		assert.Less(t, time.Since(start), 300*time.Millisecond, "직렬이면 250ms 이상")
+		assert.True(t, status.Healthy)
+		require.Len(t, status.Providers, 4)
+		names := make([]string, len(status.Providers))
+		for i, ps := range status.Providers {
+			names[i] = ps.Name
+			assert.Empty(t, ps.CheckError, ps.Name)
+		}
+		assert.Equal(t, []string{"slow", "fast", "medium", "slow2"}, names)
+		assert.True(t, status.Providers[0].Available)
+		assert.False(t, status.Providers[1].Available)
+		assert.True(t, status.Providers[2].Available)
+		assert.False(t, status.Providers[3].Available)
+	})
+
+	t.Run("returns within the deadline", func(t *testing.T) {
+		coord := &Coordinator{
+			providers: []provider.GeocodingProvider{
+				newProvider("hung", 2*time.Second, true),
+				newProvider("fast", 0, true),
+			},
+			logger:        zap.NewNop(),
+			healthTimeout: 50 * time.Millisecond,
+		}
+
+		start := time.Now()
+		status := coord.HealthCheck(context.Background())
+
+		assert.Less(t, time.Since(start), time.Second)
+		assert.True(t, status.Healthy, "응답한 Provider가 사용 가능")
+		require.Len(t, status.Providers, 2)
+		assert.Equal(t, "hung", status.Providers[0].Name)
+		assert.False(t, status.Providers[0].Available)
+		assert.Equal(t, "health check timed out", status.Providers[0].CheckError)
+		assert.True(t, status.Providers[1].Available)
+		assert.Empty(t, status.Providers[1].CheckError)
+	})
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+)
+
+// flightGroup 같은 key로 동시에 들어온 지오코딩을 호출 한 번으로 묶는 그룹
+// 결과를 기다리는 호출자 수를 세어 모두 떠나면 공유 호출을 취소한다
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight 진행 중인 공유 호출 하나
+type flight struct {
+	done    chan struct{} // 호출이 끝나면 닫힘
+	resp    *model.GeocodingResponse
+	err     error
+	waiters int                // 결과를 기다리는 호출자 수
+	shared  bool               // 먼저 시작한 호출자 외에 합류한 호출자가 있었는지
+	cancel  context.CancelFunc // 호출자가 모두 떠나면 공유 호출 취소
+}
+
+// do key로 진행 중인 호출이 있으면 합류해 결과를 기다리고, 없으면 fn을 새로 시작
+// 공유 호출은 먼저 시작한 호출자의 값과 마감을 물려받지만 그 호출자의 취소와는 분리된다
+// 다른 호출자가 남아 있는 동안 ctx가 끝난 호출자는 기다리지 않고 ctx 에러를 반환하며,
+// 마지막 호출자가 떠나면 공유 호출을 취소하고 그 결과를 반환한다
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*model.GeocodingResponse, error)) (*model.GeocodingResponse, bool, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*flight)
+	}
+	f, ok := g.flights[key]
+	if ok {
+		f.waiters++
+		f.shared = true
+	} else {
+		flightCtx, cancel := detachedContext(ctx)
+		f = &flight{done: make(chan struct{}), waiters: 1, cancel: cancel}
+		g.flights[key] = f
+		go g.run(flightCtx, key, f, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.resp, f.shared, f.err
+	case <-ctx.Done():
+	}
+
+	g.mu.Lock()
+	f.waiters--
+	last := f.waiters == 0
+	if last {
+		// 취소된 호출에 새 호출자가 합류하지 않도록 먼저 목록에서 뺀다
+		if g.flights[key] == f {
+			delete(g.flights, key)
+		}
+		f.cancel()
+	}
+	g.mu.Unlock()
+
+	if !last {
+		return nil, false, ctx.Err()
+	}
+	<-f.done
+	return f.resp, f.shared, f.err
+}
+
+// run fn을 실행하고 결과를 기록한 뒤 기다리는 호출자에게 알림
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, fn func(ctx context.Context) (*model.GeocodingResponse, error)) {
+	defer f.cancel()
+	f.resp, f.err = fn(ctx)
+
+	g.mu.Lock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+	close(f.done)
+}
+
+// detachedContext ctx의 값과 마감은 유지하고 취소와는 분리한 컨텍스트 생성
+func detachedContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return context.WithCancel(detached)
+}
@@ -18,215 +18,1155 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/oursportsnation/k-geocode/internal/cache"
 	"github.com/oursportsnation/k-geocode/internal/model"
 	"github.com/oursportsnation/k-geocode/internal/provider"
 	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
 
 	"go.uber.org/zap"
 )
 
+// ErrNoProvidersAvailable 사용 가능한 Provider가 하나도 없음 (시스템 장애)
+// 주소를 찾지 못한 경우와 구분하기 위해 응답이 아닌 에러로 반환한다
+var ErrNoProvidersAvailable = errors.New("no geocoding providers available")
+
+// ErrOutsideKorea 한국 영역을 벗어난 좌표 (EnforceKoreanBounds 사용 시)
+var ErrOutsideKorea = errors.New("coordinates outside Korea")
+
+// ErrOutsideBounds 호출자가 지정한 경계 상자를 벗어난 좌표 (BatchOptions.Bounds 사용 시)
+var ErrOutsideBounds = errors.New("coordinates outside requested bounds")
+
 // GeocodingServiceInterface 지오코딩 서비스 인터페이스
 type GeocodingServiceInterface interface {
 	Geocode(ctx context.Context, address string, addressType string) (*model.GeocodingResponse, error)
-	GeocodeBatch(ctx context.Context, addresses []string) (*model.BulkResponse, error)
+	GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error)
+	GeocodeBatchStream(ctx context.Context, addresses []string, opts BatchOptions, send func(index int, result *model.GeocodingResponse) error) error
 }
 
 // GeocodingService 지오코딩 서비스
 type GeocodingService struct {
 	providers []provider.GeocodingProvider
 	logger    *zap.Logger
+	options   Options
+	tracker   *successTracker // StrategyAdaptive에서만 사용 (그 외 nil)
+	balancer  *quotaBalancer  // StrategyBalanced에서만 사용 (그 외 nil)
+	backoff   *disableBackoff // DisableBackoff 설정 시에만 사용 (그 외 nil)
+	flights   flightGroup
+	inFlight  inFlight // 진행 중인 요청 (Drain용)
+
+	regionProviders map[string]string // 시·도 공식 명칭 → 우선 Provider (Options.RegionProviders)
+}
+
+// Options 지오코딩 서비스 동작 옵션
+type Options struct {
+	// AdminCodeLength 법정동/행정동 코드 출력 자릿수 (10, 8, 5). 0이면 원본(10자리) 유지
+	AdminCodeLength int
+
+	// CoordinatePrecision 출력 좌표의 소수점 자릿수 (1~9, 0은 미설정으로 보고 6자리)
+	CoordinatePrecision int
+
+	// Limiter 모든 Provider 호출에 공통 적용되는 속도 제한 (nil이면 제한 없음)
+	Limiter Limiter
+
+	// ProviderLimiters Provider 이름별 속도 제한
+	ProviderLimiters map[string]Limiter
+
+	// EnforceKoreanBounds true면 한국 영역 밖 좌표를 실패로 처리하고 다음 Provider로 폴백
+	// false면 경고 로그만 남긴다
+	EnforceKoreanBounds bool
+
+	// KoreanBounds 한국 영역 판정 경계 (nil이면 utils.KoreanBounds)
+	KoreanBounds *utils.Bounds
+
+	// Debug true면 시도 내역에 Provider 요청 URL(API 키 제거)을 포함
+	Debug bool
+
+	// SequentialBatchThreshold 이 값보다 적은 주소의 배치는 고루틴 없이 순차 처리 (0이면 항상 동시 처리)
+	SequentialBatchThreshold int
+
+	// ConcurrentLimit 정방향 배치 처리 시 최대 동시 처리 수 (0이면 10)
+	ConcurrentLimit int
+
+	// ReverseConcurrentLimit 역방향 배치 처리 시 최대 동시 처리 수 (0이면 ConcurrentLimit)
+	ReverseConcurrentLimit int
+
+	// BatchItemTimeout 배치 항목별 처리 제한 시간 (0이면 배치 컨텍스트만 적용)
+	BatchItemTimeout time.Duration
+
+	// Cache 성공한 단건 지오코딩 결과 캐시 (nil이면 캐시 사용 안 함)
+	Cache cache.Cache
+
+	// FallbackAfter 현재 Provider가 이 시간 안에 응답하지 않으면 실패를 기다리지 않고 다음 Provider를 함께 시작
+	// 먼저 성공한 결과를 사용한다 (0이면 순차 폴백)
+	FallbackAfter time.Duration
+
+	// Strategy Provider 호출 전략 (빈 값이면 StrategyFallback)
+	// StrategyParallel이면 FallbackAfter는 무시된다
+	Strategy Strategy
+
+	// AdaptiveWindow StrategyAdaptive에서 성공률 계산에 사용할 Provider별 최근 호출 수 (0이면 100)
+	AdaptiveWindow int
+
+	// DailyLimits StrategyBalanced에서 남은 할당량 계산에 쓸 Provider 이름별 일일 할당량 (없는 이름은 provider.DailyLimits)
+	DailyLimits map[string]int
+
+	// RegionFallback true면 전체 주소 지오코딩이 실패했을 때 건물번호, 동/리, 구 순으로 주소를 줄여 재시도
+	// 성공한 결과의 MatchLevel은 STREET 또는 REGION
+	RegionFallback bool
+
+	// RegionProviders 시·도별 우선 Provider (키: 시·도 이름 또는 약칭, 값: Provider 이름)
+	// 주소 첫 토큰의 시·도가 일치하면 해당 Provider를 맨 앞에 두고 나머지는 기본 순서를 따른다
+	// StrategyAdaptive에서는 최근 성공률 순서가 우선한다
+	RegionProviders map[string]string
+
+	// BuildingNameFallback true면 주소를 찾지 못했을 때 건물번호/번지 뒤의 건물명을 떼고 한 번 더 시도
+	// 성공하면 뗀 건물명을 AddressDetail.BuildingName에 기록한다 (Provider가 건물명을 주지 않은 경우)
+	BuildingNameFallback bool
+
+	// Preprocessors 기본 정규화 후 입력 검증 전에 순서대로 적용할 주소 전처리 함수 (예: utils.StripParentheses)
+	Preprocessors []Preprocessor
+
+	// StopOnNotFound true면 Provider가 주소를 찾지 못했을 때(NOT_FOUND) 다음 Provider로 폴백하지 않고 즉시 실패
+	// 시스템 오류, 타임아웃, 한도 초과는 이 설정과 관계없이 폴백한다 (false면 NOT_FOUND도 폴백)
+	StopOnNotFound bool
+
+	// MaxAddressLength 정규화된 주소의 최대 길이 (문자 수, 0이면 utils.DefaultMaxAddressLength)
+	// 이보다 긴 주소는 Provider를 호출하지 않고 INVALID_ADDRESS로 거부한다
+	MaxAddressLength int
+
+	// MinConfidence 이보다 신뢰도가 낮은 결과는 실패로 보고 다음 Provider로 폴백 (0이면 사용 안 함)
+	// 기준을 넘는 결과가 없으면 낮은 결과 중 신뢰도가 가장 높은 것을 LowConfidence로 표시해 반환한다
+	MinConfidence float64
+
+	// DisableBackoff 한도 초과로 연속해서 비활성화될 때마다 적용할 비활성화 기간 (예: 1분, 5분, 15분)
+	// 단계를 모두 지나면 마지막 값을 유지하고, Retry-After가 더 길면 Retry-After를 따른다
+	// 비어 있으면 Retry-After(없으면 provider.DefaultRateLimitCooldown)만큼 비활성화
+	DisableBackoff []time.Duration
+
+	// DisableBackoffReset 한도 초과 후 이 기간 동안 다시 한도를 넘지 않으면 DisableBackoff 단계를 처음으로 되돌림 (0이면 30분)
+	DisableBackoffReset time.Duration
+
+	// Clock DisableBackoff 초기화 판단에 사용할 시계 (nil이면 시스템 시계)
+	Clock clock.Clock
+
+	// Timeout 단건 지오코딩/리버스 지오코딩 한 건의 전체 제한 시간 (폴백 체인 포함, 0이면 컨텍스트만 적용)
+	// 배치에서는 항목마다 적용된다
+	Timeout time.Duration
+
+	// ProviderTimeout Provider 한 번 호출의 제한 시간 (0이면 Timeout과 컨텍스트만 적용)
+	// 시간 안에 응답하지 않으면 타임아웃으로 기록하고 남은 시간 안에서 다음 Provider로 폴백한다
+	ProviderTimeout time.Duration
+
+	// MinAttemptBudget 순차 폴백에서 다음 Provider를 시도하는 데 필요한 최소 남은 시간 (0이면 검사 안 함)
+	// 컨텍스트 마감까지 남은 시간이 이보다 짧으면 호출하지 않고 "skipped: insufficient time budget" 시도로 기록한다
+	// 첫 번째 Provider는 남은 시간과 관계없이 시도한다
+	MinAttemptBudget time.Duration
+
+	// Normalizer 캐시에 없는 주소를 지오코딩하기 전에 공식 도로명주소로 바꾸는 정규화 Provider (예: 도로명주소 API, nil이면 사용 안 함)
+	// 정규화에 실패하면 입력 주소 그대로 지오코딩하며, 정규화 결과의 건물관리번호·우편번호·영문 주소 등은 결과의 빈 항목에 채운다
+	Normalizer provider.AddressNormalizer
 }
 
+// Preprocessor 주소 전처리 함수 (데이터 출처별 정리 규칙)
+type Preprocessor func(address string) string
+
+// Strategy Provider 호출 전략
+type Strategy string
+
+const (
+	StrategyFallback Strategy = "fallback" // 등록 순서대로 하나씩 시도하고 실패 시 다음 Provider로 (기본값)
+	StrategyParallel Strategy = "parallel" // 모든 Provider를 동시에 호출하고 가장 먼저 성공한 결과 사용, 나머지는 취소
+	StrategyAdaptive Strategy = "adaptive" // 최근 성공률이 높은 Provider부터 순서대로 시도 (AdaptiveWindow 단위로 집계)
+	StrategyBalanced Strategy = "balanced" // 같은 이름의 Provider(여러 API 키) 중 남은 할당량에 비례한 확률로 먼저 시도할 인스턴스를 고르고, 종류 간에는 등록 순서대로 폴백
+)
+
 // NewGeocodingService 지오코딩 서비스 생성자
 func NewGeocodingService(providers []provider.GeocodingProvider, logger *zap.Logger) *GeocodingService {
-	return &GeocodingService{
+	return NewGeocodingServiceWithOptions(providers, logger, Options{})
+}
+
+// NewGeocodingServiceWithOptions 옵션을 지정한 지오코딩 서비스 생성자
+func NewGeocodingServiceWithOptions(providers []provider.GeocodingProvider, logger *zap.Logger, opts Options) *GeocodingService {
+	s := &GeocodingService{
 		providers: providers,
 		logger:    logger,
+		options:   opts,
+	}
+	switch opts.Strategy {
+	case StrategyAdaptive:
+		s.tracker = newSuccessTracker(opts.AdaptiveWindow)
+	case StrategyBalanced:
+		s.balancer = newQuotaBalancer(opts.DailyLimits)
+	}
+	if len(opts.DisableBackoff) > 0 {
+		s.backoff = newDisableBackoff(opts.DisableBackoff, opts.DisableBackoffReset, opts.Clock)
 	}
+	if len(opts.RegionProviders) > 0 {
+		// 약칭 키("서울", "강원도")도 주소에서 읽은 공식 명칭과 비교할 수 있도록 정규화
+		s.regionProviders = make(map[string]string, len(opts.RegionProviders))
+		for sido, name := range opts.RegionProviders {
+			if canonical := utils.LeadingSido(sido); canonical != "" {
+				s.regionProviders[canonical] = name
+			}
+		}
+	}
+	return s
+}
+
+// log 요청 컨텍스트의 상관관계 ID(request_id)를 붙인 로거 반환
+func (s *GeocodingService) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
+
+// GeocodeOptions 단건 지오코딩 호출별 옵션
+type GeocodeOptions struct {
+	// Providers 이번 호출에 사용할 Provider 이름 (대소문자 무시, 비어 있으면 전체)
+	// 시도 순서는 서비스에 등록된 순서를 따른다 (StrategyAdaptive면 최근 성공률 순)
+	Providers []string
+
+	// SkipCache true면 캐시를 조회하지 않고 Provider를 호출 (성공 결과는 다시 캐시에 저장)
+	SkipCache bool
+
+	// KakaoAnalyzeType 이번 호출의 Kakao 주소 검색 analyze_type (similar, exact). 비어 있으면 Provider 기본값
+	KakaoAnalyzeType string
+
+	// AddressTypes 이번 호출에서 주소 타입 지원 Provider(vWorld)가 시도할 주소 타입 (ROAD, PARCEL)
+	// Provider 설정보다 우선하며, 비어 있으면 Provider 설정을 따른다
+	AddressTypes []string
+
+	// BothTypes true면 도로명(ROAD)과 지번(PARCEL)으로 각각 검색해 응답의 RoadCoordinate, ParcelCoordinate를 채움
+	// 주소 타입 지정을 지원하는 Provider(provider.TypedGeocoder)만 사용하며, 대표 좌표는 addressType(비어 있으면 ROAD) 결과
+	// 두 조회는 Options.Timeout 하나를 나눠 쓰고, 정확히 일치(ROOFTOP)한 좌표만 채운다
+	BothTypes bool
+
+	// CRS 이번 호출의 출력 좌표계 (provider.ParseVWorldCRS로 정규화된 값, 비어 있으면 WGS84)
+	// 좌표계를 지원하는 Provider(vWorld)만 적용하므로 호출자가 Providers를 제한해야 한다
+	CRS string
+
+	// Bias 이번 호출의 위치 힌트 (Bias.Validate로 검증된 값, nil이면 힌트 없음)
+	// 힌트를 지원하는 검색(Kakao 키워드 검색)만 적용하고 나머지 Provider는 무시한다
+	Bias *provider.Bias
+
+	// IncludeRaw true면 결과를 반환한 Provider의 원본 응답(API 키 제거)을 응답의 Raw에 담음 (디버그용)
+	// 원본 응답은 캐시에 저장하지 않으므로 캐시를 조회하지 않고 항상 Provider를 호출한다
+	IncludeRaw bool
+}
+
+// includeRawKey GeocodeOptions.IncludeRaw 컨텍스트 키 (Provider 호출 시 원본 응답을 담을지 여부)
+type includeRawKey struct{}
+
+// includeRaw 요청 컨텍스트가 Provider 원본 응답을 요청했는지 확인
+func includeRaw(ctx context.Context) bool {
+	include, _ := ctx.Value(includeRawKey{}).(bool)
+	return include
+}
+
+// skipCacheKey WithSkipCache 컨텍스트 키
+type skipCacheKey struct{}
+
+// WithSkipCache 이 컨텍스트로 호출하는 지오코딩(배치 포함)은 GeocodeOptions.SkipCache처럼 캐시를 조회하지 않는다
+// 성공 결과는 캐시에 다시 저장하므로 오래된 캐시 항목을 새 결과로 덮어쓰는 데 사용한다
+func WithSkipCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCacheKey{}, true)
+}
+
+// skipCache 요청 컨텍스트가 캐시 조회를 건너뛰도록 지정되었는지 확인
+func skipCache(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipCacheKey{}).(bool)
+	return skip
+}
+
+// BatchOptions 배치 호출별 옵션
+type BatchOptions struct {
+	// AddressType 모든 주소에 적용할 주소 타입 (ROAD, PARCEL). 비어 있으면 자동 폴백
+	AddressType string
+
+	// Bounds 결과 좌표를 제한할 경계 상자 (nil이면 제한 없음)
+	// 경계 밖 결과는 ErrOutsideBounds 실패로 처리한다
+	Bounds *utils.Bounds
 }
 
 // Geocode 주소를 좌표로 변환 (단건)
 func (s *GeocodingService) Geocode(ctx context.Context, address string, addressType string) (*model.GeocodingResponse, error) {
+	return s.GeocodeWithOptions(ctx, address, addressType, GeocodeOptions{})
+}
+
+// GeocodeWithOptions 호출별 옵션을 적용해 주소를 좌표로 변환 (단건)
+func (s *GeocodingService) GeocodeWithOptions(ctx context.Context, address string, addressType string, opts GeocodeOptions) (*model.GeocodingResponse, error) {
+	defer s.inFlight.track()()
+
+	// 도로명/지번 동시 검색도 두 조회를 합쳐 Timeout 안에 끝나도록 분기 전에 적용
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	if opts.BothTypes {
+		return s.geocodeBothTypes(ctx, address, addressType, opts)
+	}
+
 	start := time.Now()
 
 	// 1. 입력 검증
-	address = utils.NormalizeAddress(address)
-	if !utils.IsValidAddress(address) {
-		s.logger.Warn("Invalid address format",
-			zap.String("address", address),
+	normalizedType, err := utils.NormalizeAddressType(addressType)
+	if err != nil {
+		return &model.GeocodingResponse{
+			Success:        false,
+			Error:          err.Error(),
+			ErrorCode:      model.ErrorCodeInvalidRequest,
+			ProcessedAt:    time.Now(),
+			ProcessingTime: time.Since(start),
+		}, nil
+	}
+	addressType = string(normalizedType)
+
+	address = s.NormalizeAddress(address)
+	if err := utils.CheckAddress(address, s.options.MaxAddressLength); err != nil {
+		s.log(ctx).Warn("Invalid address format",
+			zap.String("address", truncateForLog(address)),
+			zap.String("reason", err.Error()),
 		)
 		return &model.GeocodingResponse{
 			Success:        false,
 			Error:          "invalid address format",
+			ErrorCode:      model.ErrorCodeInvalidAddress,
 			ProcessedAt:    time.Now(),
 			ProcessingTime: time.Since(start),
 		}, nil
 	}
 
-	s.logger.Info("Starting geocoding",
+	// 공동주택 동/호는 Provider 호출 전에 분리하고 결과에 다시 붙임 (Provider는 건물 단위로만 검색)
+	address, dong, unit := utils.SplitBuildingUnit(address)
+
+	providers := s.preferRegionProvider(address, s.selectProviders(opts.Providers))
+
+	// 캐시 조회 (허용된 Provider의 결과만 사용)
+	ctx = provider.WithKakaoAnalyzeType(ctx, opts.KakaoAnalyzeType)
+	ctx = provider.WithAddressTypes(ctx, opts.AddressTypes)
+	ctx = provider.WithCRS(ctx, opts.CRS)
+	ctx = provider.WithBias(ctx, opts.Bias)
+	cacheKey := cache.Key(address, addressType, s.coordinatePrecision(), strings.ToLower(opts.KakaoAnalyzeType), addressTypesVariant(opts.AddressTypes), crsVariant(opts.CRS), biasVariant(opts.Bias))
+	if opts.IncludeRaw {
+		ctx = context.WithValue(ctx, includeRawKey{}, true)
+	}
+	if s.options.Cache != nil && !opts.SkipCache && !opts.IncludeRaw && !skipCache(ctx) {
+		if cached, ok := s.options.Cache.Get(ctx, cacheKey); ok && containsProvider(providers, cached.Provider) {
+			s.log(ctx).Debug("Geocoding cache hit",
+				zap.String("address", address),
+				zap.String("provider", cached.Provider),
+			)
+			resp := *cached
+			resp.ProcessedAt = time.Now()
+			resp.ProcessingTime = time.Since(start)
+			return withBuildingUnit(&resp, dong, unit), nil
+		}
+	}
+
+	// 같은 주소를 동시에 지오코딩하는 요청은 Provider 호출 한 번을 공유 (캐시도 한 번만 저장)
+	flight := flightKey(cacheKey, providers)
+	if opts.IncludeRaw {
+		flight += "|raw"
+	}
+	final, err := s.coalesce(ctx, flight, func(ctx context.Context) (*model.GeocodingResponse, error) {
+		return s.geocodeUncached(ctx, providers, address, addressType, start, cacheKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return withBuildingUnit(final, dong, unit), nil
+}
+
+// geocodeUncached 캐시를 거치지 않고 Provider 체인으로 지오코딩하고 성공 결과를 캐시에 저장
+func (s *GeocodingService) geocodeUncached(ctx context.Context, providers []provider.GeocodingProvider, address, addressType string, start time.Time, cacheKey string) (*model.GeocodingResponse, error) {
+	// 사용 가능한 Provider가 없으면 시도 없이 즉시 실패
+	if !anyAvailable(ctx, providers) {
+		s.log(ctx).Error("No providers available",
+			zap.String("address", address),
+		)
+		return nil, ErrNoProvidersAvailable
+	}
+
+	s.log(ctx).Info("Starting geocoding",
 		zap.String("address", address),
 		zap.String("address_type", addressType),
-		zap.Int("providers", len(s.providers)),
+		zap.Int("providers", len(providers)),
 	)
 
-	// Provider 시도 내역 추적
-	var attempts []model.ProviderAttempt
+	// 공식 주소 정규화 (정규화 후 지오코딩)
+	canonical := s.normalizeWithProvider(ctx, address)
+	if canonical != nil {
+		address, addressType = utils.StripParentheses(canonical.RoadAddress), string(utils.AddressTypeRoad)
+	}
 
 	// 2. Provider 순회 (폴백)
-	for i, p := range s.providers {
-		if !p.IsAvailable(ctx) {
-			s.logger.Debug("Provider not available",
+	final := s.runChain(ctx, providers, start, geocodeCall(address, addressType))
+
+	if !final.Success && final.ErrorCode == model.ErrorCodeAddressNotFound && s.options.BuildingNameFallback {
+		final = s.geocodeWithoutBuildingName(ctx, providers, address, addressType, start, final)
+	}
+
+	// 모든 Provider가 실패했거나 StopOnNotFound로 첫 NOT_FOUND에서 멈춘 경우 (폴백 불가 에러는 제외)
+	if !final.Success && (final.Provider == noProvider || final.ErrorCode == model.ErrorCodeAddressNotFound) && s.options.RegionFallback {
+		final = s.geocodeCoarser(ctx, providers, address, start, final)
+	}
+
+	if final.Success {
+		if final.MatchLevel == "" {
+			final.MatchLevel = string(utils.MatchLevelRooftop)
+		}
+		if canonical != nil {
+			final.AddressDetail = mergeAddressDetail(final.AddressDetail, canonical)
+		}
+		s.log(ctx).Info("Geocoding succeeded",
+			zap.String("provider", final.Provider),
+			zap.Float64("latitude", final.Coordinate.Latitude),
+			zap.Float64("longitude", final.Coordinate.Longitude),
+			zap.Duration("processing_time", final.ProcessingTime),
+		)
+
+		// 성공 결과 캐시 저장 (호출자가 수정하지 않도록 복사본 저장)
+		if s.options.Cache != nil {
+			cached := *final
+			cached.Raw = nil
+			s.options.Cache.Set(ctx, cacheKey, &cached)
+		}
+	} else if final.Provider == noProvider {
+		s.log(ctx).Warn("All providers failed to geocode",
+			zap.String("address", address),
+			zap.Duration("total_time", final.ProcessingTime),
+		)
+	}
+
+	return final, nil
+}
+
+// normalizeWithProvider Options.Normalizer로 주소를 공식 주소로 정규화
+// Normalizer가 없거나 사용할 수 없거나, 정규화에 실패했거나, 도로명주소가 없으면 nil (입력 주소 그대로 지오코딩)
+func (s *GeocodingService) normalizeWithProvider(ctx context.Context, address string) *model.AddressDetail {
+	n := s.options.Normalizer
+	if n == nil || !n.IsAvailable(ctx) {
+		return nil
+	}
+	detail, err := n.Normalize(ctx, address)
+	if err != nil {
+		s.log(ctx).Debug("Address normalization failed, geocoding the input address",
+			zap.String("normalizer", n.Name()),
+			zap.Error(err),
+		)
+		return nil
+	}
+	if detail == nil || detail.RoadAddress == "" {
+		return nil
+	}
+	return detail
+}
+
+// mergeAddressDetail Provider 결과의 빈 항목을 정규화 결과로 채운 복사본 반환
+func mergeAddressDetail(detail, canonical *model.AddressDetail) *model.AddressDetail {
+	merged := model.AddressDetail{}
+	if detail != nil {
+		merged = *detail
+	}
+	fill := func(dst *string, src string) {
+		if *dst == "" {
+			*dst = src
+		}
+	}
+	fill(&merged.RoadAddress, canonical.RoadAddress)
+	fill(&merged.ParcelAddress, canonical.ParcelAddress)
+	fill(&merged.Zipcode, canonical.Zipcode)
+	fill(&merged.BuildingName, canonical.BuildingName)
+	fill(&merged.EnglishAddress, canonical.EnglishAddress)
+	fill(&merged.BuildingManagementNumber, canonical.BuildingManagementNumber)
+	return &merged
+}
+
+// flightKey 동시 요청 공유 키 (캐시 키 + 시도할 Provider 목록)
+func flightKey(cacheKey string, providers []provider.GeocodingProvider) string {
+	names := make([]string, len(providers))
+	for i, p := range providers {
+		names[i] = p.Name()
+	}
+	return cacheKey + "|" + strings.Join(names, ",")
+}
+
+// coalesce 같은 key로 진행 중인 지오코딩이 있으면 그 결과를 기다려 공유하고, 없으면 fn 실행
+// 공유된 응답은 호출자마다 복사해 반환한다 (호출자가 수정해도 서로 영향 없음)
+// 공유 호출은 먼저 시작한 요청의 마감과 Timeout 안에서 실행되며, 기다리는 호출자가 모두 떠나야 취소된다
+// 한 호출자가 취소해도 남은 호출자는 결과를 받고, 떠난 호출자는 공유 호출을 기다리지 않고 ctx 에러를 반환한다
+func (s *GeocodingService) coalesce(ctx context.Context, key string, fn func(ctx context.Context) (*model.GeocodingResponse, error)) (*model.GeocodingResponse, error) {
+	resp, shared, err := s.flights.do(ctx, key, func(flightCtx context.Context) (*model.GeocodingResponse, error) {
+		// 먼저 시작한 호출자가 떠나도 Drain이 공유 호출을 기다리도록 별도로 기록
+		defer s.inFlight.track()()
+		flightCtx, cancel := s.withTimeout(flightCtx)
+		defer cancel()
+		return fn(flightCtx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		copied := *resp
+		resp = &copied
+	}
+	return resp, nil
+}
+
+// geocodeBothTypes 도로명과 지번으로 각각 지오코딩하고 두 좌표를 한 응답으로 합침
+// 대표 결과는 addressType(비어 있으면 ROAD) 결과이며, 실패하면 다른 타입 결과를 사용한다
+// 두 번째 조회는 첫 결과의 상세 주소에 있는 상대 타입 주소로 하고, 첫 조회가 실패했을 때만 입력 주소를 그대로 쓴다
+// 정확히 일치(ROOFTOP)한 결과의 좌표만 RoadCoordinate, ParcelCoordinate에 담는다
+// 주소 타입 지정을 지원하는 Provider가 없으면 일반 지오코딩 결과를 그대로 반환한다 (RoadCoordinate, ParcelCoordinate 없음)
+func (s *GeocodingService) geocodeBothTypes(ctx context.Context, address string, addressType string, opts GeocodeOptions) (*model.GeocodingResponse, error) {
+	start := time.Now()
+	opts.BothTypes = false
+
+	// 타입을 지정할 수 없는 Provider는 두 번 호출해도 같은 좌표를 돌려주므로 제외
+	var typed []string
+	for _, p := range s.selectProviders(opts.Providers) {
+		if _, ok := p.(provider.TypedGeocoder); ok {
+			typed = append(typed, p.Name())
+		}
+	}
+	if len(typed) == 0 {
+		return s.GeocodeWithOptions(ctx, address, addressType, opts)
+	}
+	opts.Providers = typed
+
+	primary, secondary := "ROAD", "PARCEL"
+	if strings.EqualFold(addressType, "PARCEL") {
+		primary, secondary = secondary, primary
+	}
+
+	first, err := s.GeocodeWithOptions(ctx, address, primary, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 같은 입력을 다른 타입으로 다시 찾으면 엉뚱한 주소와 일치할 수 있으므로 첫 결과가 알려 준 상대 주소를 사용
+	secondAddress := address
+	if first.Success {
+		secondAddress = counterpartAddress(first.AddressDetail, secondary)
+	}
+	var second *model.GeocodingResponse
+	if secondAddress != "" {
+		second, err = s.GeocodeWithOptions(ctx, secondAddress, secondary, opts)
+		if err != nil {
+			// 시간이 다 된 경우 첫 결과만으로 응답
+			if ctx.Err() == nil || !first.Success {
+				return nil, err
+			}
+			second = nil
+		}
+	}
+
+	// 캐시된 응답을 수정하지 않도록 복사본에 결합
+	combined := *first
+	combined.Attempts = append([]model.ProviderAttempt{}, first.Attempts...)
+	if second != nil {
+		if !first.Success && second.Success {
+			combined = *second
+		}
+		combined.Attempts = append(append([]model.ProviderAttempt{}, first.Attempts...), second.Attempts...)
+	}
+	combined.ProcessingTime = time.Since(start)
+
+	road, parcel := first, second
+	if primary == "PARCEL" {
+		road, parcel = second, first
+	}
+	if isExactMatch(road) {
+		combined.RoadCoordinate = road.Coordinate
+	}
+	if isExactMatch(parcel) {
+		combined.ParcelCoordinate = parcel.Coordinate
+	}
+	return &combined, nil
+}
+
+// counterpartAddress 상세 주소에서 addressType(ROAD 또는 PARCEL)에 해당하는 주소를 반환 (괄호 참고항목 제외)
+func counterpartAddress(detail *model.AddressDetail, addressType string) string {
+	if detail == nil {
+		return ""
+	}
+	if addressType == "ROAD" {
+		return utils.StripParentheses(detail.RoadAddress)
+	}
+	return utils.StripParentheses(detail.ParcelAddress)
+}
+
+// isExactMatch 응답이 성공했고 정확히 일치(ROOFTOP)한 결과인지 확인
+func isExactMatch(resp *model.GeocodingResponse) bool {
+	return resp != nil && resp.Success && !resp.LowConfidence &&
+		resp.MatchLevel == string(utils.MatchLevelRooftop)
+}
+
+// withBuildingUnit 응답의 상세 주소에 입력 주소에서 분리한 동/호를 붙임
+// 캐시된 응답과 상세 주소를 공유하지 않도록 복사본을 수정한다
+func withBuildingUnit(resp *model.GeocodingResponse, dong, unit string) *model.GeocodingResponse {
+	if resp.AddressDetail == nil || (dong == "" && unit == "") {
+		return resp
+	}
+	detail := *resp.AddressDetail
+	detail.BuildingDong = dong
+	detail.BuildingUnit = unit
+	resp.AddressDetail = &detail
+	return resp
+}
+
+// geocodeCall address를 지오코딩하는 Provider 호출 (주소 타입 지정을 지원하는 Provider에는 addressType 전달)
+func geocodeCall(address, addressType string) providerCall {
+	return func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error) {
+		// 주소 타입 지정을 지원하는 Provider이고 주소 타입이 지정된 경우
+		if typed, ok := p.(provider.TypedGeocoder); ok && addressType != "" {
+			return typed.GeocodeWithType(ctx, address, addressType)
+		}
+		return p.Geocode(ctx, address)
+	}
+}
+
+// geocodeWithoutBuildingName 주소 끝의 건물명을 떼고 한 번 더 지오코딩
+// 성공하면 뗀 건물명을 상세 주소에 기록해 반환하고, 뗄 건물명이 없거나 실패하면 failed를 반환한다
+// 시도 내역은 원래 주소의 시도부터 누적된다
+func (s *GeocodingService) geocodeWithoutBuildingName(ctx context.Context, providers []provider.GeocodingProvider, address, addressType string, start time.Time, failed *model.GeocodingResponse) *model.GeocodingResponse {
+	base, name := utils.SplitTrailingBuildingName(address)
+	if name == "" || ctx.Err() != nil {
+		return failed
+	}
+
+	s.log(ctx).Debug("Retrying without trailing building name",
+		zap.String("address", address),
+		zap.String("building_name", name),
+	)
+
+	resp := s.runChain(ctx, providers, start, geocodeCall(base, addressType))
+	resp.Attempts = append(failed.Attempts, resp.Attempts...)
+	if !resp.Success {
+		failed.Attempts = resp.Attempts
+		return failed
+	}
+
+	if resp.AddressDetail == nil {
+		resp.AddressDetail = &model.AddressDetail{}
+	}
+	if resp.AddressDetail.BuildingName == "" {
+		detail := *resp.AddressDetail
+		detail.BuildingName = name
+		resp.AddressDetail = &detail
+	}
+	return resp
+}
+
+// geocodeCoarser 전체 주소 지오코딩 실패 시 점점 덜 구체적인 주소로 재시도
+// 가장 먼저 성공한(가장 구체적인) 결과에 MatchLevel을 표시해 반환하고, 모두 실패하면 failed를 반환한다
+// 시도 내역은 원래 주소의 시도부터 누적된다
+func (s *GeocodingService) geocodeCoarser(ctx context.Context, providers []provider.GeocodingProvider, address string, start time.Time, failed *model.GeocodingResponse) *model.GeocodingResponse {
+	attempts := failed.Attempts
+	for _, coarse := range utils.CoarserAddresses(address) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		s.log(ctx).Debug("Retrying with coarser address",
+			zap.String("address", address),
+			zap.String("coarse_address", coarse.Address),
+			zap.String("match_level", string(coarse.Level)),
+		)
+
+		resp := s.runChain(ctx, providers, start, func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error) {
+			return p.Geocode(ctx, coarse.Address)
+		})
+		attempts = append(attempts, resp.Attempts...)
+		if resp.Success {
+			resp.MatchLevel = string(coarse.Level)
+			resp.Confidence = utils.RoundToDecimal(resp.Confidence*coarseConfidenceFactor[coarse.Level], 2)
+			resp.Attempts = attempts
+			return resp
+		}
+	}
+
+	failed.Attempts = attempts
+	failed.ProcessingTime = time.Since(start)
+	return failed
+}
+
+// coarseConfidenceFactor 축약 주소로 찾은 결과의 신뢰도 배율 (번지·지역 단위 좌표는 입력 주소 위치와 멀 수 있음)
+var coarseConfidenceFactor = map[utils.MatchLevel]float64{
+	utils.MatchLevelStreet: 0.6,
+	utils.MatchLevelRegion: 0.3,
+}
+
+// noProvider 모든 Provider가 실패했을 때 응답의 Provider 값
+const noProvider = "none"
+
+// providerCall Provider 하나에 대한 실제 호출 (정방향/역방향 지오코딩 공용)
+type providerCall func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error)
+
+// runChain Provider 체인을 실행하고 최종 응답 반환 (FallbackAfter 설정 시 느린 Provider를 기다리지 않고 다음 Provider를 병행 시작,
+// StrategyParallel이면 모든 Provider를 동시에 시작, StrategyAdaptive면 최근 성공률 순, StrategyBalanced면 같은 이름끼리 할당량 가중 무작위 순으로 시도)
+// 모든 Provider가 실패하면 Provider가 "none"인 실패 응답을 반환한다
+func (s *GeocodingService) runChain(ctx context.Context, providers []provider.GeocodingProvider, start time.Time, call providerCall) *model.GeocodingResponse {
+	var (
+		final        *model.GeocodingResponse
+		attempts     []model.ProviderAttempt
+		outsideKorea bool
+		best         *model.GeocodingResponse
+		invalid      *model.GeocodingResponse
+	)
+
+	// 적응형 전략은 최근 성공률 순으로 시도 순서를 바꾸고 이번 호출 결과를 다시 집계
+	if s.tracker != nil {
+		providers = s.tracker.order(providers)
+		call = s.tracker.track(call)
+	}
+	// 부하 분산 전략은 같은 이름의 인스턴스 사이에서 먼저 시도할 인스턴스를 남은 할당량 가중치로 고름
+	if s.balancer != nil {
+		providers = s.balancer.order(providers)
+	}
+
+	switch {
+	case s.options.Strategy == StrategyParallel:
+		final, attempts, outsideKorea, best, invalid = s.geocodeHedged(ctx, providers, 0, call)
+	case s.options.FallbackAfter > 0:
+		final, attempts, outsideKorea, best, invalid = s.geocodeHedged(ctx, providers, s.options.FallbackAfter, call)
+	default:
+		final, attempts, outsideKorea, best, invalid = s.geocodeSequential(ctx, providers, call)
+	}
+
+	// 기준 신뢰도를 넘는 결과가 없으면 가장 나은 저신뢰 결과 사용 (뒤 Provider의 폴백 불가 실패보다 우선)
+	if best != nil && (final == nil || !final.Success) {
+		best.LowConfidence = true
+		final = best
+	}
+
+	// 모든 Provider가 실패했고 좌표가 유효하지 않은 응답만 있었다면 상세 주소를 담은 그 응답 사용
+	if final == nil {
+		final = invalid
+	}
+
+	if final == nil {
+		// 한국 영역 밖 결과만 있었다면 원인을 그대로 전달
+		errMsg, errCode := "all providers failed to geocode the address", model.ErrorCodeAddressNotFound
+		if outsideKorea {
+			errMsg, errCode = ErrOutsideKorea.Error(), model.ErrorCodeOutsideKorea
+		}
+		final = &model.GeocodingResponse{
+			Success:   false,
+			Provider:  noProvider,
+			Error:     errMsg,
+			ErrorCode: errCode,
+		}
+	}
+
+	final.Attempts = attempts
+	final.ProcessedAt = time.Now()
+	final.ProcessingTime = time.Since(start)
+	return final
+}
+
+// providerOutcome 단일 Provider 시도 결과
+type providerOutcome struct {
+	attempt       model.ProviderAttempt
+	response      *model.GeocodingResponse // 성공 또는 폴백 불가 실패 시 최종 응답 (nil이면 다음 Provider로 폴백)
+	outsideKorea  bool
+	lowConfidence *model.GeocodingResponse // MinConfidence 미만 성공 결과 (다른 Provider가 기준을 넘지 못하면 사용)
+	invalid       *model.GeocodingResponse // 좌표가 유효하지 않은 실패 응답 (모든 Provider가 실패하면 사용)
+}
+
+// moreConfident 신뢰도가 더 높은 저신뢰 결과 선택 (같으면 먼저 받은 결과 유지)
+func moreConfident(best, candidate *model.GeocodingResponse) *model.GeocodingResponse {
+	if candidate == nil || (best != nil && best.Confidence >= candidate.Confidence) {
+		return best
+	}
+	return candidate
+}
+
+// geocodeSequential Provider를 순서대로 하나씩 시도
+// 최종 응답, 시도 내역, 한국 영역 밖 결과 여부, 가장 나은 저신뢰 결과, 처음 받은 좌표 무효 응답을 반환한다
+func (s *GeocodingService) geocodeSequential(ctx context.Context, providers []provider.GeocodingProvider, call providerCall) (*model.GeocodingResponse, []model.ProviderAttempt, bool, *model.GeocodingResponse, *model.GeocodingResponse) {
+	var attempts []model.ProviderAttempt
+	var best, invalid *model.GeocodingResponse
+	outsideKorea := false
+
+	for i, p := range providers {
+		// 남은 시간으로는 응답을 받기 어려운 폴백은 호출하지 않음
+		if i > 0 && !s.hasAttemptBudget(ctx) {
+			s.log(ctx).Debug("Skipping provider: insufficient time budget",
 				zap.String("provider", p.Name()),
 			)
-			// 사용 불가능한 Provider도 기록
 			attempts = append(attempts, model.ProviderAttempt{
 				Provider: p.Name(),
 				Success:  false,
-				Error:    "provider not available",
+				Error:    errInsufficientBudget.Error(),
 			})
 			continue
 		}
 
-		s.logger.Debug("Trying provider",
-			zap.String("provider", p.Name()),
-			zap.Int("attempt", i+1),
-		)
+		out := s.tryProvider(ctx, p, i, call)
+		attempts = append(attempts, out.attempt)
+		outsideKorea = outsideKorea || out.outsideKorea
+		best = moreConfident(best, out.lowConfidence)
+		if invalid == nil {
+			invalid = out.invalid
+		}
+		if out.response != nil {
+			return out.response, attempts, outsideKorea, best, invalid
+		}
+	}
+
+	return nil, attempts, outsideKorea, best, invalid
+}
+
+// errInsufficientBudget 남은 시간이 MinAttemptBudget보다 짧아 건너뛴 시도의 에러
+var errInsufficientBudget = errors.New("skipped: insufficient time budget")
 
-		// Provider 호출
-		var result *model.ProviderResult
-		var err error
+// hasAttemptBudget 컨텍스트 마감까지 Provider 한 번을 시도할 시간(MinAttemptBudget)이 남았는지 확인
+// MinAttemptBudget이 0이거나 마감이 없으면 항상 true
+func (s *GeocodingService) hasAttemptBudget(ctx context.Context) bool {
+	if s.options.MinAttemptBudget <= 0 {
+		return true
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return true
+	}
+	return time.Until(deadline) >= s.options.MinAttemptBudget
+}
 
-		// vWorld Provider이고 주소 타입이 지정된 경우
-		if vworldProvider, ok := p.(*provider.VWorldProvider); ok && addressType != "" {
-			result, err = vworldProvider.GeocodeWithType(ctx, address, addressType)
-		} else {
-			result, err = p.Geocode(ctx, address)
+// geocodeHedged Provider를 순서대로 시작하되, 진행 중인 Provider가 fallbackAfter 안에
+// 끝나지 않으면 기다리지 않고 다음 Provider를 함께 시작한다. fallbackAfter가 0이면 모든 Provider를 한 번에 시작한다.
+// 가장 먼저 도착한 성공 응답을 사용하고 나머지 요청은 취소한다. 시도 내역은 완료 순서로 기록된다
+// 폴백 불가 실패(INVALID 등)는 새 Provider를 시작하지 않게 할 뿐, 진행 중인 Provider가 모두 끝날 때까지
+// 기다렸다가 성공이 없을 때만 (처음 받은 실패를) 반환한다. 빠른 실패가 느린 성공을 취소하지 않도록 하기 위함이다
+func (s *GeocodingService) geocodeHedged(ctx context.Context, providers []provider.GeocodingProvider, fallbackAfter time.Duration, call providerCall) (*model.GeocodingResponse, []model.ProviderAttempt, bool, *model.GeocodingResponse, *model.GeocodingResponse) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type indexedOutcome struct {
+		index   int
+		outcome providerOutcome
+	}
+
+	// 버퍼를 Provider 수만큼 두어 취소된 고루틴이 블로킹되지 않도록 함
+	results := make(chan indexedOutcome, len(providers))
+	next, running := 0, 0
+	launch := func() bool {
+		if next >= len(providers) {
+			return false
 		}
+		i, p := next, providers[next]
+		next++
+		running++
+		go func() {
+			results <- indexedOutcome{index: i, outcome: s.tryProvider(ctx, p, i, call)}
+		}()
+		return true
+	}
 
-		// 시스템 에러 처리
-		if err != nil {
-			// 분류된 에러인 경우
-			if ce, ok := provider.IsClassifiedError(err); ok {
-				s.logger.Warn("Provider error",
-					zap.String("provider", p.Name()),
-					zap.String("error_type", ce.Type.String()),
-					zap.Error(err),
-				)
+	// 병렬 전략은 타이머 없이 전부 시작 (timeout이 nil이면 select에서 선택되지 않음)
+	var (
+		timer   *time.Timer
+		timeout <-chan time.Time
+	)
+	if fallbackAfter > 0 {
+		timer = time.NewTimer(fallbackAfter)
+		defer timer.Stop()
+		timeout = timer.C
+		launch()
+	} else {
+		for launch() {
+		}
+	}
 
-				// 시도 내역 기록
-				attempts = append(attempts, model.ProviderAttempt{
-					Provider: p.Name(),
-					Success:  false,
-					Error:    err.Error(),
-				})
-
-				// 인증 실패 또는 한도 초과 시 Provider 비활성화 후 폴백
-				if ce.Type == provider.ErrorTypeUnauthorized {
-					p.Disable(fmt.Sprintf("Authentication failed: %s", err.Error()))
-					s.logger.Error("Provider disabled due to authentication failure",
-						zap.String("provider", p.Name()),
-						zap.String("reason", err.Error()),
-					)
-					continue
-				}
-				if ce.Type == provider.ErrorTypeRateLimitExceeded {
-					p.Disable(fmt.Sprintf("Rate limit exceeded: %s", err.Error()))
-					s.logger.Warn("Provider disabled due to rate limit",
-						zap.String("provider", p.Name()),
-						zap.String("reason", err.Error()),
-					)
-					continue
-				}
+	var attempts []model.ProviderAttempt
+	var best, failure, invalid *model.GeocodingResponse
+	outsideKorea := false
 
-				// 기타 폴백 불가능한 에러는 즉시 반환
-				if !ce.Fallback {
-					return &model.GeocodingResponse{
-						Success:        false,
-						Provider:       p.Name(),
-						Attempts:       attempts,
-						Error:          err.Error(),
-						ProcessedAt:    time.Now(),
-						ProcessingTime: time.Since(start),
-					}, nil
+	for running > 0 {
+		select {
+		case r := <-results:
+			running--
+			attempts = append(attempts, r.outcome.attempt)
+			outsideKorea = outsideKorea || r.outcome.outsideKorea
+			best = moreConfident(best, r.outcome.lowConfidence)
+			if invalid == nil {
+				invalid = r.outcome.invalid
+			}
+			if resp := r.outcome.response; resp != nil {
+				if resp.Success {
+					return resp, attempts, outsideKorea, best, invalid
 				}
+				if failure == nil {
+					failure = resp
+				}
+			}
 
-				// 폴백 가능한 에러는 다음 Provider로
+			// 진행 중인 Provider가 없으면 대기 없이 다음 Provider 시작 (병렬 전략은 이미 모두 시작됨)
+			if running == 0 && failure == nil && launch() {
+				timer.Reset(fallbackAfter)
+			}
+		case <-timeout:
+			if failure != nil {
 				continue
 			}
+			s.log(ctx).Debug("Provider slower than fallback delay, starting next provider",
+				zap.Duration("fallback_after", fallbackAfter),
+				zap.Int("next", next+1),
+			)
+			if launch() {
+				timer.Reset(fallbackAfter)
+			}
+		}
+	}
+
+	return failure, attempts, outsideKorea, best, invalid
+}
 
-			// 기타 에러
-			s.logger.Error("Provider unexpected error",
+// tryProvider Provider 하나로 지오코딩을 시도하고 시도 내역을 반환
+func (s *GeocodingService) tryProvider(ctx context.Context, p provider.GeocodingProvider, i int, call providerCall) providerOutcome {
+	if !p.IsAvailable(ctx) {
+		s.log(ctx).Debug("Provider not available",
+			zap.String("provider", p.Name()),
+		)
+		// 사용 불가능한 Provider도 기록
+		return providerOutcome{attempt: model.ProviderAttempt{
+			Provider: p.Name(),
+			Success:  false,
+			Error:    "provider not available",
+		}}
+	}
+
+	s.log(ctx).Debug("Trying provider",
+		zap.String("provider", p.Name()),
+		zap.Int("attempt", i+1),
+	)
+
+	// 속도 제한 토큰 대기 (컨텍스트 만료 시 실패로 기록)
+	if err := s.waitForToken(ctx, p.Name()); err != nil {
+		s.log(ctx).Warn("Rate limiter wait aborted",
+			zap.String("provider", p.Name()),
+			zap.Error(err),
+		)
+		return providerOutcome{attempt: model.ProviderAttempt{
+			Provider: p.Name(),
+			Success:  false,
+			Error:    fmt.Sprintf("rate limiter: %v", err),
+		}}
+	}
+
+	// Provider 호출 (ProviderTimeout은 이 시도에만 적용)
+	callCtx := ctx
+	if s.options.ProviderTimeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, s.options.ProviderTimeout)
+		defer cancel()
+	}
+	var raw *provider.RawCapture
+	if includeRaw(ctx) {
+		callCtx, raw = provider.WithRawCapture(callCtx)
+	}
+	result, err := call(callCtx, p)
+	if s.backoff != nil && err == nil {
+		s.backoff.recordSuccess(p.Name())
+	}
+
+	// 시스템 에러 처리
+	if err != nil {
+		// 분류된 에러인 경우
+		if ce, ok := provider.IsClassifiedError(err); ok {
+			s.log(ctx).Warn("Provider error",
 				zap.String("provider", p.Name()),
+				zap.String("error_type", ce.Type.String()),
 				zap.Error(err),
 			)
 
 			// 시도 내역 기록
-			attempts = append(attempts, model.ProviderAttempt{
-				Provider: p.Name(),
-				Success:  false,
-				Error:    err.Error(),
-			})
-			continue
-		}
+			out := providerOutcome{attempt: model.ProviderAttempt{
+				Provider:   p.Name(),
+				Success:    false,
+				Error:      err.Error(),
+				RequestURL: s.debugURL(ce.RequestURL),
+			}}
 
-		// 결과가 있는 경우
-		if result != nil && result.Success {
-			// 성공 시도 기록
-			attempts = append(attempts, model.ProviderAttempt{
-				Provider: p.Name(),
-				Success:  true,
-			})
+			// 인증 실패 또는 한도 초과 시 Provider 비활성화 후 폴백
+			if ce.Type == provider.ErrorTypeUnauthorized {
+				p.Disable(fmt.Sprintf("Authentication failed: %s", err.Error()))
+				s.log(ctx).Error("Provider disabled due to authentication failure",
+					zap.String("provider", p.Name()),
+					zap.String("reason", err.Error()),
+				)
+				return out
+			}
+			if ce.Type == provider.ErrorTypeRateLimitExceeded {
+				reason := fmt.Sprintf("Rate limit exceeded: %s", err.Error())
 
-			// 3. 좌표 정규화
-			normalized := s.normalizeResponse(result, p.Name())
-			normalized.ProcessedAt = time.Now()
-			normalized.ProcessingTime = time.Since(start)
-			normalized.Attempts = attempts
+				// 지원하는 Provider는 Retry-After(없으면 기본 쿨다운) 동안만 비활성화
+				// DisableBackoff가 설정되어 있으면 연속 한도 초과 횟수에 따라 기간을 늘린다
+				if td, ok := p.(provider.TemporaryDisabler); ok {
+					cooldown := ce.RetryAfter
+					if s.backoff != nil {
+						cooldown = s.backoff.next(p.Name(), ce.RetryAfter)
+					} else if cooldown <= 0 {
+						cooldown = provider.DefaultRateLimitCooldown
+					}
+					td.DisableFor(reason, cooldown)
+					s.log(ctx).Warn("Provider disabled temporarily due to rate limit",
+						zap.String("provider", p.Name()),
+						zap.String("reason", err.Error()),
+						zap.Duration("cooldown", cooldown),
+					)
+					return out
+				}
+
+				p.Disable(reason)
+				s.log(ctx).Warn("Provider disabled due to rate limit",
+					zap.String("provider", p.Name()),
+					zap.String("reason", err.Error()),
+				)
+				return out
+			}
 
-			s.logger.Info("Geocoding succeeded",
-				zap.String("provider", p.Name()),
-				zap.Float64("latitude", normalized.Coordinate.Latitude),
-				zap.Float64("longitude", normalized.Coordinate.Longitude),
-				zap.Duration("processing_time", normalized.ProcessingTime),
-			)
+			// 기타 폴백 불가능한 에러(StopOnNotFound면 NOT_FOUND 포함, 다루지 않는 주소는 제외)는 즉시 반환
+			if !ce.Fallback || (ce.Type == provider.ErrorTypeNotFound && s.options.StopOnNotFound && !errors.Is(err, provider.ErrNotCovered)) {
+				out.response = &model.GeocodingResponse{
+					Success:   false,
+					Provider:  p.Name(),
+					Error:     err.Error(),
+					ErrorCode: providerErrorCode(ce.Type),
+				}
+			}
 
-			return normalized, nil
+			// 폴백 가능한 에러는 다음 Provider로
+			return out
 		}
 
-		// 결과 없음 - 다음 Provider로
-		s.logger.Debug("Provider returned no results",
+		// 기타 에러
+		s.log(ctx).Error("Provider unexpected error",
 			zap.String("provider", p.Name()),
+			zap.Error(err),
 		)
 
 		// 시도 내역 기록
-		attempts = append(attempts, model.ProviderAttempt{
+		return providerOutcome{attempt: model.ProviderAttempt{
 			Provider: p.Name(),
 			Success:  false,
-			Error:    "address not found",
-		})
+			Error:    err.Error(),
+		}}
 	}
-	
-	// 4. 모든 Provider 실패
-	s.logger.Warn("All providers failed to geocode",
-		zap.String("address", address),
-		zap.Duration("total_time", time.Since(start)),
+
+	// 결과가 있는 경우
+	if result != nil && result.Success {
+		// 3. 좌표 정규화
+		normalized, err := s.normalizeResponse(ctx, result, p.Name())
+		if errors.Is(err, ErrOutsideKorea) {
+			// 한국 영역 밖 좌표는 실패로 기록하고 다음 Provider로
+			return providerOutcome{
+				attempt: model.ProviderAttempt{
+					Provider:   p.Name(),
+					Success:    false,
+					Error:      err.Error(),
+					RequestURL: s.debugURL(result.RequestURL),
+				},
+				outsideKorea: true,
+			}
+		}
+		if raw != nil {
+			normalized.Raw = raw.Body()
+		}
+
+		// 좌표가 유효하지 않으면 실패로 기록하고 다음 Provider로 (상세 주소를 담은 응답은 모든 Provider가 실패할 때만 사용)
+		if !normalized.Success {
+			return providerOutcome{
+				attempt: model.ProviderAttempt{
+					Provider:   p.Name(),
+					Success:    false,
+					Error:      normalized.Error,
+					RequestURL: s.debugURL(result.RequestURL),
+				},
+				invalid: normalized,
+			}
+		}
+
+		// 신뢰도가 기준 미만이면 보관해 두고 더 나은 결과를 찾아 다음 Provider로
+		if s.options.MinConfidence > 0 && normalized.Confidence < s.options.MinConfidence {
+			s.log(ctx).Debug("Provider result below minimum confidence",
+				zap.String("provider", p.Name()),
+				zap.Float64("confidence", normalized.Confidence),
+				zap.Float64("min_confidence", s.options.MinConfidence),
+			)
+			return providerOutcome{
+				attempt: model.ProviderAttempt{
+					Provider:   p.Name(),
+					Success:    false,
+					Error:      fmt.Sprintf("confidence %.2f below minimum %.2f", normalized.Confidence, s.options.MinConfidence),
+					RequestURL: s.debugURL(result.RequestURL),
+				},
+				lowConfidence: normalized,
+			}
+		}
+
+		// 성공 시도 기록
+		return providerOutcome{
+			attempt: model.ProviderAttempt{
+				Provider:   p.Name(),
+				Success:    true,
+				RequestURL: s.debugURL(result.RequestURL),
+			},
+			response: normalized,
+		}
+	}
+
+	// 결과 없음 - 다음 Provider로
+	s.log(ctx).Debug("Provider returned no results",
+		zap.String("provider", p.Name()),
 	)
 
-	return &model.GeocodingResponse{
-		Success:        false,
-		Provider:       "none",
-		Attempts:       attempts,
-		Error:          "all providers failed to geocode the address",
-		ProcessedAt:    time.Now(),
-		ProcessingTime: time.Since(start),
-	}, nil
+	// 시도 내역 기록
+	attempt := model.ProviderAttempt{
+		Provider: p.Name(),
+		Success:  false,
+		Error:    "address not found",
+	}
+	if result != nil {
+		attempt.RequestURL = s.debugURL(result.RequestURL)
+		if errors.Is(result.Error, provider.ErrNotCovered) {
+			attempt.Error = result.Error.Error()
+		}
+	}
+
+	// NOT_FOUND에서 멈추도록 설정된 경우 다음 Provider를 호출하지 않음
+	// (로컬 데이터셋에 없는 주소처럼 Provider가 다루지 않는 주소는 찾지 못한 것이 아니므로 폴백)
+	if s.options.StopOnNotFound && (result == nil || !errors.Is(result.Error, provider.ErrNotCovered)) {
+		return providerOutcome{
+			attempt: attempt,
+			response: &model.GeocodingResponse{
+				Success:   false,
+				Provider:  p.Name(),
+				Error:     "address not found",
+				ErrorCode: model.ErrorCodeAddressNotFound,
+			},
+		}
+	}
+	return providerOutcome{attempt: attempt}
+}
+
+// GeocodeBatch 대량 주소 변환 (addressType이 지정되면 모든 주소에 적용)
+// "위도,경도" 형식의 항목(한국 영역 안 좌표)은 역지오코딩으로 처리한다
+func (s *GeocodingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	return s.GeocodeBatchWithOptions(ctx, addresses, BatchOptions{AddressType: addressType})
 }
 
-// GeocodeBatch 대량 주소 변환
-func (s *GeocodingService) GeocodeBatch(ctx context.Context, addresses []string) (*model.BulkResponse, error) {
+// GeocodeBatchWithOptions 호출별 옵션을 적용해 대량 주소 변환
+func (s *GeocodingService) GeocodeBatchWithOptions(ctx context.Context, addresses []string, opts BatchOptions) (*model.BulkResponse, error) {
+	defer s.inFlight.track()()
+
 	start := time.Now()
 	
 	if len(addresses) == 0 {
@@ -236,120 +1176,621 @@ func (s *GeocodingService) GeocodeBatch(ctx context.Context, addresses []string)
 		}, nil
 	}
 	
-	s.logger.Info("Starting batch geocoding",
+	// 사용 가능한 Provider가 없으면 배치 전체를 즉시 실패
+	if !s.hasAvailableProvider(ctx) {
+		s.log(ctx).Error("No providers available for batch",
+			zap.Int("addresses", len(addresses)),
+		)
+		return nil, ErrNoProvidersAvailable
+	}
+
+	s.log(ctx).Info("Starting batch geocoding",
 		zap.Int("addresses", len(addresses)),
+		zap.String("address_type", opts.AddressType),
+		zap.Bool("bounded", opts.Bounds != nil),
 	)
 	
-	// 결과 슬라이스 초기화
+	// 결과 슬라이스 초기화 (인덱스별로 한 번만 기록되므로 잠금 불필요)
 	results := make([]*model.GeocodingResponse, len(addresses))
+	s.geocodeEach(ctx, addresses, opts, func(idx int, result *model.GeocodingResponse) {
+		results[idx] = result
+	})
+	
+	response := newBulkResponse(results, start)
 	
+	s.log(ctx).Info("Batch geocoding completed",
+		zap.Int("total", response.Summary.Total),
+		zap.Int("success", response.Summary.Success),
+		zap.Int("failed", response.Summary.Failed),
+		zap.Duration("processing_time", response.ProcessingTime),
+	)
+	
+	return response, nil
+}
+
+// GeocodeBatchStream 대량 주소 변환 (완료되는 순서대로 send 호출)
+// send는 한 번에 하나씩 호출되며, 에러를 반환하면 남은 주소 처리를 중단하고 그 에러를 반환한다
+func (s *GeocodingService) GeocodeBatchStream(ctx context.Context, addresses []string, opts BatchOptions, send func(index int, result *model.GeocodingResponse) error) error {
+	defer s.inFlight.track()()
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	// 사용 가능한 Provider가 없으면 배치 전체를 즉시 실패
+	if !s.hasAvailableProvider(ctx) {
+		s.log(ctx).Error("No providers available for batch",
+			zap.Int("addresses", len(addresses)),
+		)
+		return ErrNoProvidersAvailable
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var sendErr error
+	s.geocodeEach(ctx, addresses, opts, func(idx int, result *model.GeocodingResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr != nil {
+			return
+		}
+		if err := send(idx, result); err != nil {
+			sendErr = err
+			cancel()
+		}
+	})
+
+	return sendErr
+}
+
+// geocodeEach 주소들을 동시에 변환하고 완료될 때마다 done 호출
+// "위도,경도" 좌표 항목은 역방향 배치의 최대 동시 처리 수도 함께 적용한다
+// done은 여러 고루틴에서 동시에 호출될 수 있다
+func (s *GeocodingService) geocodeEach(ctx context.Context, addresses []string, opts BatchOptions, done func(idx int, result *model.GeocodingResponse)) {
+	isReverse := func(idx int) bool {
+		_, _, ok := utils.ParseCoordinatePair(addresses[idx])
+		return ok
+	}
+	s.forEach(ctx, len(addresses), s.options.ConcurrentLimit, isReverse, func(ctx context.Context, idx int) *model.GeocodingResponse {
+		return s.geocodeOne(ctx, addresses[idx], opts)
+	}, done)
+}
+
+// withTimeout 단건 처리 전체 제한 시간(Options.Timeout) 적용
+// Timeout이 0이면 ctx를 그대로 반환한다
+func (s *GeocodingService) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.options.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.options.Timeout)
+}
+
+// defaultConcurrentLimit 배치 기본 최대 동시 처리 수
+const defaultConcurrentLimit = 10
+
+// reverseConcurrentLimit 역방향 배치 최대 동시 처리 수 (ReverseConcurrentLimit이 없으면 ConcurrentLimit)
+func (s *GeocodingService) reverseConcurrentLimit() int {
+	if s.options.ReverseConcurrentLimit > 0 {
+		return s.options.ReverseConcurrentLimit
+	}
+	return s.options.ConcurrentLimit
+}
+
+// forEach 배치 항목 n개를 처리하고 완료될 때마다 done 호출 (정방향/역방향 배치 공용)
+// 순차 처리 임계값, 최대 동시 처리 수 limit (0 이하면 10), 항목별 제한 시간을 적용한다
+// isReverse가 true인 항목(정방향 배치에 섞인 역방향 항목)은 reverseConcurrentLimit도 함께 적용한다 (nil이면 없음)
+// done은 여러 고루틴에서 동시에 호출될 수 있다
+func (s *GeocodingService) forEach(ctx context.Context, n, limit int, isReverse func(idx int) bool, process func(ctx context.Context, idx int) *model.GeocodingResponse, done func(idx int, result *model.GeocodingResponse)) {
+	processItem := func(idx int) *model.GeocodingResponse {
+		if s.options.BatchItemTimeout <= 0 {
+			return process(ctx, idx)
+		}
+		itemCtx, cancel := context.WithTimeout(ctx, s.options.BatchItemTimeout)
+		defer cancel()
+		return process(itemCtx, idx)
+	}
+
+	// 작은 배치는 순차 처리 (결과와 순서는 동시 처리와 동일)
+	if n < s.options.SequentialBatchThreshold {
+		for i := 0; i < n; i++ {
+			done(i, processItem(i))
+		}
+		return
+	}
+
 	// 동시 처리를 위한 설정
-	const maxConcurrent = 10 // 최대 동시 처리 수
+	maxConcurrent := limit
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultConcurrentLimit
+	}
 	sem := make(chan struct{}, maxConcurrent)
+	var reverseSem chan struct{}
+	if isReverse != nil {
+		maxReverse := s.reverseConcurrentLimit()
+		if maxReverse <= 0 {
+			maxReverse = defaultConcurrentLimit
+		}
+		reverseSem = make(chan struct{}, maxReverse)
+	}
 	var wg sync.WaitGroup
-	
-	// 각 주소 처리
-	for i, addr := range addresses {
+
+	// 각 항목 처리
+	for i := 0; i < n; i++ {
 		wg.Add(1)
-		go func(idx int, address string) {
+		go func(idx int) {
 			defer wg.Done()
-			
+
+			// 역방향 항목은 역방향 제한을 먼저 얻는다 (기다리는 동안 정방향 자리를 차지하지 않도록)
+			if reverseSem != nil && isReverse(idx) {
+				reverseSem <- struct{}{}
+				defer func() { <-reverseSem }()
+			}
+
 			// 동시 실행 제한
 			sem <- struct{}{}
 			defer func() { <-sem }()
-			
-			// 개별 지오코딩 (배치에서는 타입 지정 불가)
-			result, err := s.Geocode(ctx, address, "")
-			if err != nil {
-				// 에러 발생 시에도 실패 결과를 기록
-				results[idx] = &model.GeocodingResponse{
-					Success:     false,
-					Error:       err.Error(),
-					ProcessedAt: time.Now(),
-				}
-			} else {
-				results[idx] = result
-			}
-		}(i, addr)
+
+			done(idx, processItem(idx))
+		}(i)
 	}
-	
+
 	// 모든 처리 완료 대기
 	wg.Wait()
-	
-	// 통계 계산
+}
+
+// newBulkResponse 배치 결과와 통계로 응답 생성
+func newBulkResponse(results []*model.GeocodingResponse, start time.Time) *model.BulkResponse {
 	response := &model.BulkResponse{
 		Results:        results,
 		ProcessingTime: time.Since(start),
 	}
-	
+
 	successCount := 0
 	for _, r := range results {
 		if r.Success {
 			successCount++
 		}
 	}
-	
-	response.Summary.Total = len(addresses)
+
+	response.Summary.Total = len(results)
 	response.Summary.Success = successCount
-	response.Summary.Failed = len(addresses) - successCount
-	
-	s.logger.Info("Batch geocoding completed",
+	response.Summary.Failed = len(results) - successCount
+	return response
+}
+
+// geocodeOrReverse 배치 항목이 "위도,경도" 좌표면 역지오코딩, 아니면 지오코딩
+func (s *GeocodingService) geocodeOrReverse(ctx context.Context, input string, addressType string) (*model.GeocodingResponse, error) {
+	if lat, lng, ok := utils.ParseCoordinatePair(input); ok {
+		return s.ReverseGeocode(ctx, model.Coordinate{Latitude: lat, Longitude: lng})
+	}
+	return s.Geocode(ctx, input, addressType)
+}
+
+// geocodeOne 배치 내 개별 주소(또는 "위도,경도" 좌표) 변환
+// 에러 발생 시에도 실패 결과를 반환
+func (s *GeocodingService) geocodeOne(ctx context.Context, address string, opts BatchOptions) *model.GeocodingResponse {
+	result, err := s.geocodeOrReverse(ctx, address, opts.AddressType)
+	if err != nil {
+		return &model.GeocodingResponse{
+			Success:     false,
+			Error:       err.Error(),
+			ErrorCode:   ErrorCodeOf(err),
+			ProcessedAt: time.Now(),
+		}
+	}
+
+	// 경계 상자 밖 결과는 실패로 처리 (좌표와 상세 주소는 확인용으로 유지)
+	if result.Success && opts.Bounds != nil &&
+		!opts.Bounds.Contains(result.Coordinate.Latitude, result.Coordinate.Longitude) {
+		s.log(ctx).Debug("Result outside requested bounds",
+			zap.String("address", address),
+			zap.Float64("latitude", result.Coordinate.Latitude),
+			zap.Float64("longitude", result.Coordinate.Longitude),
+		)
+		result.Success = false
+		result.Error = ErrOutsideBounds.Error()
+		result.ErrorCode = model.ErrorCodeOutsideBounds
+	}
+	return result
+}
+
+// ReverseGeocode 좌표를 주소로 변환 (단건)
+// 역지오코딩을 지원하는 Provider(provider.ReverseGeocoder)만 순서대로 시도한다
+func (s *GeocodingService) ReverseGeocode(ctx context.Context, coord model.Coordinate) (*model.GeocodingResponse, error) {
+	defer s.inFlight.track()()
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+
+	// 1. 입력 검증
+	if !utils.ValidateCoordinate(coord.Latitude, coord.Longitude) {
+		return &model.GeocodingResponse{
+			Success:        false,
+			Error:          "invalid coordinates",
+			ErrorCode:      model.ErrorCodeInvalidCoordinates,
+			ProcessedAt:    time.Now(),
+			ProcessingTime: time.Since(start),
+		}, nil
+	}
+
+	// 사용 가능한 역지오코딩 Provider가 없으면 시도 없이 즉시 실패
+	providers := s.reverseProviders()
+	if !anyAvailable(ctx, providers) {
+		s.log(ctx).Error("No reverse geocoding providers available",
+			zap.Float64("latitude", coord.Latitude),
+			zap.Float64("longitude", coord.Longitude),
+		)
+		return nil, ErrNoProvidersAvailable
+	}
+
+	// 2. Provider 순회 (폴백)
+	final := s.runChain(ctx, providers, start, func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error) {
+		return p.(provider.ReverseGeocoder).ReverseGeocode(ctx, coord)
+	})
+
+	if final.Provider == noProvider {
+		s.log(ctx).Warn("All providers failed to reverse geocode",
+			zap.Float64("latitude", coord.Latitude),
+			zap.Float64("longitude", coord.Longitude),
+		)
+	}
+
+	return final, nil
+}
+
+// GeocodeZipcode 5자리 우편번호 구역의 대표 좌표 조회 (MatchLevel은 REGION)
+// 우편번호 검색을 지원하는 Provider(provider.ZipcodeGeocoder)만 순서대로 시도한다
+func (s *GeocodingService) GeocodeZipcode(ctx context.Context, zipcode string) (*model.GeocodingResponse, error) {
+	defer s.inFlight.track()()
+
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+
+	// 1. 입력 검증
+	zipcode = strings.TrimSpace(zipcode)
+	if !utils.IsValidZipcode(zipcode) {
+		return &model.GeocodingResponse{
+			Success:        false,
+			Error:          "invalid zipcode",
+			ErrorCode:      model.ErrorCodeInvalidAddress,
+			ProcessedAt:    time.Now(),
+			ProcessingTime: time.Since(start),
+		}, nil
+	}
+
+	// 사용 가능한 우편번호 검색 Provider가 없으면 시도 없이 즉시 실패
+	var providers []provider.GeocodingProvider
+	for _, p := range s.providers {
+		if _, ok := p.(provider.ZipcodeGeocoder); ok {
+			providers = append(providers, p)
+		}
+	}
+	if !anyAvailable(ctx, providers) {
+		s.log(ctx).Error("No zipcode providers available",
+			zap.String("zipcode", zipcode),
+		)
+		return nil, ErrNoProvidersAvailable
+	}
+
+	// 2. Provider 순회 (폴백)
+	final := s.runChain(ctx, providers, start, func(ctx context.Context, p provider.GeocodingProvider) (*model.ProviderResult, error) {
+		return p.(provider.ZipcodeGeocoder).GeocodeZipcode(ctx, zipcode)
+	})
+
+	if final.Success {
+		final.MatchLevel = string(utils.MatchLevelRegion)
+	} else if final.Provider == noProvider {
+		s.log(ctx).Warn("All providers failed to geocode zipcode",
+			zap.String("zipcode", zipcode),
+		)
+	}
+
+	return final, nil
+}
+
+// ReverseGeocodeBatch 대량 좌표 변환
+// 정방향 배치와 같은 동시 처리 수, 속도 제한, 항목별 제한 시간을 적용한다
+func (s *GeocodingService) ReverseGeocodeBatch(ctx context.Context, coords []model.Coordinate) (*model.BulkResponse, error) {
+	defer s.inFlight.track()()
+
+	start := time.Now()
+
+	if len(coords) == 0 {
+		return &model.BulkResponse{
+			Results:        []*model.GeocodingResponse{},
+			ProcessingTime: 0,
+		}, nil
+	}
+
+	// 사용 가능한 Provider가 없으면 배치 전체를 즉시 실패
+	if !anyAvailable(ctx, s.reverseProviders()) {
+		s.log(ctx).Error("No reverse geocoding providers available for batch",
+			zap.Int("coordinates", len(coords)),
+		)
+		return nil, ErrNoProvidersAvailable
+	}
+
+	// 결과 슬라이스 초기화 (인덱스별로 한 번만 기록되므로 잠금 불필요)
+	results := make([]*model.GeocodingResponse, len(coords))
+	s.forEach(ctx, len(coords), s.reverseConcurrentLimit(), nil, func(ctx context.Context, idx int) *model.GeocodingResponse {
+		result, err := s.ReverseGeocode(ctx, coords[idx])
+		if err != nil {
+			return &model.GeocodingResponse{
+				Success:     false,
+				Error:       err.Error(),
+				ErrorCode:   ErrorCodeOf(err),
+				ProcessedAt: time.Now(),
+			}
+		}
+		return result
+	}, func(idx int, result *model.GeocodingResponse) {
+		results[idx] = result
+	})
+
+	response := newBulkResponse(results, start)
+
+	s.log(ctx).Info("Batch reverse geocoding completed",
 		zap.Int("total", response.Summary.Total),
 		zap.Int("success", response.Summary.Success),
 		zap.Int("failed", response.Summary.Failed),
 		zap.Duration("processing_time", response.ProcessingTime),
 	)
-	
+
 	return response, nil
 }
 
+// reverseProviders 역지오코딩을 지원하는 Provider 목록 (등록 순서 유지)
+func (s *GeocodingService) reverseProviders() []provider.GeocodingProvider {
+	var providers []provider.GeocodingProvider
+	for _, p := range s.providers {
+		if _, ok := p.(provider.ReverseGeocoder); ok {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// defaultCoordinatePrecision 출력 좌표 기본 소수점 자릿수 (Decimal 9,6)
+const defaultCoordinatePrecision = 6
+
+// coordinatePrecision 출력 좌표 소수점 자릿수 (설정값이 없으면 defaultCoordinatePrecision)
+func (s *GeocodingService) coordinatePrecision() int {
+	if s.options.CoordinatePrecision == 0 {
+		return defaultCoordinatePrecision
+	}
+	return s.options.CoordinatePrecision
+}
+
+// defaultConfidence 신뢰도를 주지 않는 Provider 결과의 기본 신뢰도
+const defaultConfidence = 0.5
+
+// crsVariant 캐시 키에 넣을 출력 좌표계 (WGS84면 빈 값이라 기존 키와 같다)
+func crsVariant(crs string) string {
+	if crs == provider.CRSWGS84 {
+		return ""
+	}
+	return crs
+}
+
+// addressTypesVariant 캐시 키에 넣을 주소 타입 제한 (순서와 대소문자가 달라도 같은 키)
+func addressTypesVariant(types []string) string {
+	if len(types) == 0 {
+		return ""
+	}
+	normalized := make([]string, len(types))
+	for i, t := range types {
+		normalized[i] = strings.ToUpper(strings.TrimSpace(t))
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ",")
+}
+
+// biasVariant 캐시 키에 넣을 위치 힌트 (힌트가 없으면 빈 값이라 기존 키와 같다)
+func biasVariant(bias *provider.Bias) string {
+	if bias == nil {
+		return ""
+	}
+	return bias.String()
+}
+
 // normalizeResponse Provider 결과를 정규화된 응답으로 변환
-func (s *GeocodingService) normalizeResponse(result *model.ProviderResult, providerName string) *model.GeocodingResponse {
-	// 좌표 정규화 (소수점 6자리)
+// EnforceKoreanBounds 설정 시 한국 영역 밖 좌표는 ErrOutsideKorea 반환
+// WGS84가 아닌 좌표계(result.CRS)의 좌표는 위경도가 아니므로 좌표 범위와 한국 영역을 확인하지 않는다
+func (s *GeocodingService) normalizeResponse(ctx context.Context, result *model.ProviderResult, providerName string) (*model.GeocodingResponse, error) {
+	// 좌표 정규화 (기본 소수점 6자리)
+	precision := s.coordinatePrecision()
 	normalizedCoord := model.Coordinate{
-		Latitude:  utils.RoundToSixDecimal(result.Coordinate.Latitude),
-		Longitude: utils.RoundToSixDecimal(result.Coordinate.Longitude),
+		Latitude:  utils.RoundToDecimal(result.Coordinate.Latitude, precision),
+		Longitude: utils.RoundToDecimal(result.Coordinate.Longitude, precision),
 	}
 	
+	// 행정구역 코드 자릿수 조정
+	detail := result.AddressDetail
+	detail.LegalDongCode = utils.TruncateAdminCode(detail.LegalDongCode, s.options.AdminCodeLength)
+	detail.AdminDongCode = utils.TruncateAdminCode(detail.AdminDongCode, s.options.AdminCodeLength)
+
 	// 좌표 유효성 검증
-	if !utils.ValidateCoordinate(normalizedCoord.Latitude, normalizedCoord.Longitude) {
-		s.logger.Warn("Invalid coordinates",
+	projected := result.CRS != ""
+	if !projected && !utils.ValidateCoordinate(normalizedCoord.Latitude, normalizedCoord.Longitude) {
+		s.log(ctx).Warn("Invalid coordinates",
 			zap.Float64("latitude", normalizedCoord.Latitude),
 			zap.Float64("longitude", normalizedCoord.Longitude),
 		)
+		// 디버깅 및 주소 텍스트만 필요한 소비자를 위해 원본 좌표와 상세 주소는 유지
+		rawCoord := result.Coordinate
 		return &model.GeocodingResponse{
-			Success:  false,
-			Provider: providerName,
-			Error:    "invalid coordinates",
-		}
+			Success:         false,
+			Coordinate:      &rawCoord,
+			CoordinateValid: false,
+			AddressDetail:   &detail,
+			Provider:        providerName,
+			Error:           "invalid coordinates",
+			ErrorCode:       model.ErrorCodeInvalidCoordinates,
+		}, nil
 	}
 	
-	// 한국 영역 확인 (선택적)
-	if !utils.IsValidKoreanCoordinate(normalizedCoord.Latitude, normalizedCoord.Longitude) {
-		s.logger.Warn("Coordinates outside Korea",
+	// 한국 영역 확인
+	insideKorea := projected || s.koreanBounds().Contains(normalizedCoord.Latitude, normalizedCoord.Longitude)
+	if !insideKorea {
+		s.log(ctx).Warn("Coordinates outside Korea",
+			zap.String("provider", providerName),
 			zap.Float64("latitude", normalizedCoord.Latitude),
 			zap.Float64("longitude", normalizedCoord.Longitude),
+			zap.Bool("enforced", s.options.EnforceKoreanBounds),
 		)
-		// 경고만 하고 계속 진행
+		if s.options.EnforceKoreanBounds {
+			return nil, ErrOutsideKorea
+		}
+		// 강제하지 않으면 경고만 하고 계속 진행
 	}
-	
+
 	return &model.GeocodingResponse{
-		Success:       true,
-		Coordinate:    &normalizedCoord,
-		AddressDetail: &result.AddressDetail,
-		Provider:      providerName,
+		Success:         true,
+		Coordinate:      &normalizedCoord,
+		CoordinateValid: true,
+		AddressDetail:   &detail,
+		Provider:        providerName,
+		Source:          result.Source,
+		Confidence:      normalizeConfidence(result, insideKorea),
+		MatchedAddress:  result.MatchedAddress,
+		CRS:             result.CRS,
+		MatchLevel:      result.MatchLevel,
+	}, nil
+}
+
+// normalizeConfidence Provider 신뢰도를 0~1로 정규화하고 공통 신호 반영
+// 도로명·지번 주소가 모두 확인되면 가산하고, 한국 영역 밖 좌표는 절반으로 감산
+func normalizeConfidence(result *model.ProviderResult, insideKorea bool) float64 {
+	score := result.Confidence
+	if score <= 0 {
+		score = defaultConfidence
+	}
+	if result.AddressDetail.RoadAddress != "" && result.AddressDetail.ParcelAddress != "" {
+		score += 0.05
+	}
+	if !insideKorea {
+		score *= 0.5
+	}
+	return utils.RoundToDecimal(math.Min(math.Max(score, 0), 1), 2)
+}
+
+// debugURL 디버그 모드에서만 요청 URL 반환
+func (s *GeocodingService) debugURL(requestURL string) string {
+	if !s.options.Debug {
+		return ""
+	}
+	return requestURL
+}
+
+// koreanBounds 한국 영역 판정에 사용할 경계 반환
+func (s *GeocodingService) koreanBounds() utils.Bounds {
+	if s.options.KoreanBounds != nil {
+		return *s.options.KoreanBounds
+	}
+	return utils.KoreanBounds
+}
+
+// NormalizeAddress 기본 정규화 후 설정된 전처리 함수를 순서대로 적용
+// 전처리 결과의 공백 등은 기본 정규화로 다시 정리한다
+func (s *GeocodingService) NormalizeAddress(address string) string {
+	address = utils.NormalizeAddress(address)
+	if len(s.options.Preprocessors) == 0 {
+		return address
+	}
+	for _, preprocess := range s.options.Preprocessors {
+		address = preprocess(address)
 	}
+	return utils.NormalizeAddress(address)
 }
 
 // ValidateAddress 주소 유효성 검증 (외부 노출용)
+// 유효하지 않으면 거부 사유를 감싼 provider.ErrInvalidAddress를 반환한다
 func (s *GeocodingService) ValidateAddress(address string) error {
-	normalized := utils.NormalizeAddress(address)
-	if !utils.IsValidAddress(normalized) {
-		return errors.New("invalid address format")
+	normalized := s.NormalizeAddress(address)
+	if err := utils.CheckAddress(normalized, s.options.MaxAddressLength); err != nil {
+		return fmt.Errorf("%w: %v", provider.ErrInvalidAddress, err)
 	}
 	return nil
 }
 
+// maxLoggedAddressLength 로그에 남기는 주소 최대 길이 (비정상적으로 긴 입력이 로그를 채우지 않도록)
+const maxLoggedAddressLength = 100
+
+// truncateForLog 로그용으로 주소를 maxLoggedAddressLength자까지 자름
+func truncateForLog(address string) string {
+	runes := []rune(address)
+	if len(runes) <= maxLoggedAddressLength {
+		return address
+	}
+	return string(runes[:maxLoggedAddressLength]) + "..."
+}
+
+// hasAvailableProvider 사용 가능한 Provider가 하나라도 있는지 확인
+func (s *GeocodingService) hasAvailableProvider(ctx context.Context) bool {
+	return anyAvailable(ctx, s.providers)
+}
+
+// selectProviders 이름 목록에 해당하는 Provider만 등록 순서대로 반환 (비어 있으면 전체)
+func (s *GeocodingService) selectProviders(names []string) []provider.GeocodingProvider {
+	if len(names) == 0 {
+		return s.providers
+	}
+	var selected []provider.GeocodingProvider
+	for _, p := range s.providers {
+		for _, name := range names {
+			if strings.EqualFold(p.Name(), name) {
+				selected = append(selected, p)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// preferRegionProvider 주소의 시·도에 우선 Provider(Options.RegionProviders)가 지정되어 있으면 맨 앞으로 옮긴 목록 반환
+// 지정이 없거나 해당 Provider가 목록에 없으면 providers를 그대로 반환한다
+func (s *GeocodingService) preferRegionProvider(address string, providers []provider.GeocodingProvider) []provider.GeocodingProvider {
+	preferred := s.regionProviders[utils.LeadingSido(address)]
+	if preferred == "" {
+		return providers
+	}
+	for i, p := range providers {
+		if strings.EqualFold(p.Name(), preferred) {
+			ordered := make([]provider.GeocodingProvider, 0, len(providers))
+			ordered = append(ordered, p)
+			ordered = append(ordered, providers[:i]...)
+			return append(ordered, providers[i+1:]...)
+		}
+	}
+	return providers
+}
+
+// anyAvailable 사용 가능한 Provider가 하나라도 있는지 확인
+func anyAvailable(ctx context.Context, providers []provider.GeocodingProvider) bool {
+	for _, p := range providers {
+		if p.IsAvailable(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsProvider 이름이 일치하는 Provider가 목록에 있는지 확인
+func containsProvider(providers []provider.GeocodingProvider, name string) bool {
+	for _, p := range providers {
+		if p.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAvailableProviders 사용 가능한 Provider 목록 반환
 func (s *GeocodingService) GetAvailableProviders(ctx context.Context) []string {
 	var available []string
@@ -359,4 +1800,41 @@ func (s *GeocodingService) GetAvailableProviders(ctx context.Context) []string {
 		}
 	}
 	return available
-}
\ No newline at end of file
+}
+
+// ErrorCodeOf 서비스 에러에 해당하는 에러 코드 반환
+func ErrorCodeOf(err error) string {
+	var ce *provider.ClassifiedError
+	switch {
+	case errors.Is(err, ErrNoProvidersAvailable):
+		return model.ErrorCodeProvidersUnavailable
+	case errors.Is(err, ErrOutsideKorea):
+		return model.ErrorCodeOutsideKorea
+	case errors.Is(err, ErrOutsideBounds):
+		return model.ErrorCodeOutsideBounds
+	case errors.Is(err, context.DeadlineExceeded):
+		return model.ErrorCodeTimeout
+	case errors.As(err, &ce):
+		return providerErrorCode(ce.Type)
+	default:
+		return model.ErrorCodeInternal
+	}
+}
+
+// providerErrorCode Provider 에러 분류에 해당하는 에러 코드 반환
+func providerErrorCode(t provider.ErrorType) string {
+	switch t {
+	case provider.ErrorTypeNotFound:
+		return model.ErrorCodeAddressNotFound
+	case provider.ErrorTypeInvalid:
+		return model.ErrorCodeInvalidAddress
+	case provider.ErrorTypeTimeout:
+		return model.ErrorCodeTimeout
+	case provider.ErrorTypeRateLimitExceeded:
+		return model.ErrorCodeRateLimitExceeded
+	case provider.ErrorTypeUnauthorized:
+		return model.ErrorCodeProviderUnauthorized
+	default:
+		return model.ErrorCodeProviderError
+	}
+}
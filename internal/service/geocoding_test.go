@@ -3,13 +3,28 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/oursportsnation/k-geocode/internal/cache"
 	"github.com/oursportsnation/k-geocode/internal/model"
 	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+	"github.com/oursportsnation/k-geocode/pkg/clock"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/oursportsnation/k-geocode/pkg/logger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 // mockProvider is a test mock for GeocodingProvider
@@ -25,6 +40,7 @@ type mockProvider struct {
 func (m *mockProvider) Name() string { return m.name }
 func (m *mockProvider) IsAvailable(ctx context.Context) bool { return m.available && !m.disabled }
 func (m *mockProvider) Disable(reason string) { m.disabled = true; m.disableReason = reason }
+func (m *mockProvider) Enable() { m.disabled = false; m.disableReason = "" }
 func (m *mockProvider) IsDisabled() bool { return m.disabled }
 func (m *mockProvider) GetDisableReason() string { return m.disableReason }
 func (m *mockProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
@@ -68,6 +84,7 @@ func TestGeocodingService_Geocode_Success(t *testing.T) {
 	require.NotNil(t, result)
 	assert.True(t, result.Success)
 	assert.Equal(t, "MockProvider", result.Provider)
+	assert.True(t, result.CoordinateValid)
 	assert.InDelta(t, 37.5665, result.Coordinate.Latitude, 0.0001)
 	assert.InDelta(t, 126.978, result.Coordinate.Longitude, 0.0001)
 }
@@ -83,6 +100,7 @@ func TestGeocodingService_Geocode_InvalidAddress(t *testing.T) {
 	require.NotNil(t, result)
 	assert.False(t, result.Success)
 	assert.Contains(t, result.Error, "invalid address")
+	assert.Equal(t, model.ErrorCodeInvalidAddress, result.ErrorCode)
 }
 
 func TestGeocodingService_Geocode_ProviderNotAvailable(t *testing.T) {
@@ -95,10 +113,35 @@ func TestGeocodingService_Geocode_ProviderNotAvailable(t *testing.T) {
 
 	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로", "")
 
-	require.NoError(t, err)
-	require.NotNil(t, result)
-	assert.False(t, result.Success)
-	assert.Equal(t, "none", result.Provider)
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, result)
+}
+
+func TestGeocodingService_Geocode_AllProvidersDisabled(t *testing.T) {
+	first := newTimingProvider("Provider1")
+	second := newTimingProvider("Provider2")
+	first.Disable("auth failed")
+	second.Disable("rate limit exceeded")
+	svc := NewGeocodingService([]provider.GeocodingProvider{first, second}, zap.NewNop())
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, result)
+	assert.Empty(t, first.callTimes())
+	assert.Empty(t, second.callTimes())
+}
+
+func TestGeocodingService_GeocodeBatch_AllProvidersDisabled(t *testing.T) {
+	p := newTimingProvider("Provider1")
+	p.Disable("auth failed")
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+	result, err := svc.GeocodeBatch(context.Background(), []string{"서울특별시 중구 세종대로 110"}, "")
+
+	require.ErrorIs(t, err, ErrNoProvidersAvailable)
+	assert.Nil(t, result)
+	assert.Empty(t, p.callTimes())
 }
 
 func TestGeocodingService_Geocode_Fallback(t *testing.T) {
@@ -158,6 +201,165 @@ func TestGeocodingService_Geocode_ClassifiedError(t *testing.T) {
 	assert.Equal(t, "BackupProvider", result.Provider)
 }
 
+func TestGeocodingService_Geocode_StopOnNotFound(t *testing.T) {
+	notFound := map[string]*mockProvider{
+		"empty result":     {result: &model.ProviderResult{Success: false}},
+		"classified error": {err: provider.NewClassifiedError(provider.ErrorTypeNotFound, "not found", nil)},
+	}
+
+	for name, first := range notFound {
+		for _, stop := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/stop=%v", name, stop), func(t *testing.T) {
+				primary := &mockProvider{name: "Primary", available: true, result: first.result, err: first.err}
+				backup := &mockProvider{
+					name:      "Backup",
+					available: true,
+					result: &model.ProviderResult{
+						Success:    true,
+						Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+					},
+				}
+				svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{primary, backup}, zap.NewNop(), Options{
+					StopOnNotFound: stop,
+				})
+
+				result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로", "")
+
+				require.NoError(t, err)
+				if stop {
+					assert.False(t, result.Success)
+					assert.Equal(t, "Primary", result.Provider)
+					assert.Equal(t, model.ErrorCodeAddressNotFound, result.ErrorCode)
+					assert.Len(t, result.Attempts, 1)
+					return
+				}
+				assert.True(t, result.Success)
+				assert.Equal(t, "Backup", result.Provider)
+				assert.Len(t, result.Attempts, 2)
+			})
+		}
+	}
+}
+
+func TestGeocodingService_Geocode_MinConfidence(t *testing.T) {
+	seoul := model.Coordinate{Latitude: 37.5665, Longitude: 126.978}
+	newProvider := func(name string, confidence float64) *mockProvider {
+		return &mockProvider{
+			name:      name,
+			available: true,
+			result:    &model.ProviderResult{Success: true, Coordinate: seoul, Confidence: confidence},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		minConfidence float64
+		confidences   []float64
+		wantProvider  string
+		wantLow       bool
+		wantAttempts  int
+	}{
+		{"disabled accepts first match", 0, []float64{0.4, 0.9}, "A", false, 1},
+		{"low match falls back to confident one", 0.7, []float64{0.4, 0.9}, "B", false, 2},
+		{"confident first match stops chain", 0.7, []float64{0.9, 0.4}, "A", false, 1},
+		{"no provider beats threshold returns best", 0.95, []float64{0.4, 0.9}, "B", true, 2},
+		{"tie keeps earlier provider", 0.95, []float64{0.6, 0.6}, "A", true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers := []provider.GeocodingProvider{
+				newProvider("A", tt.confidences[0]),
+				newProvider("B", tt.confidences[1]),
+			}
+			svc := NewGeocodingServiceWithOptions(providers, zap.NewNop(), Options{MinConfidence: tt.minConfidence})
+
+			result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+			require.NoError(t, err)
+			assert.True(t, result.Success)
+			assert.Equal(t, tt.wantProvider, result.Provider)
+			assert.Equal(t, tt.wantLow, result.LowConfidence)
+			require.Len(t, result.Attempts, tt.wantAttempts)
+			if tt.minConfidence > 0 && tt.confidences[0] < tt.minConfidence {
+				assert.False(t, result.Attempts[0].Success)
+				assert.Contains(t, result.Attempts[0].Error, "below minimum")
+			}
+		})
+	}
+
+	t.Run("low match beats a later terminal failure", func(t *testing.T) {
+		invalid := &mockProvider{
+			name:      "B",
+			available: true,
+			err:       provider.NewClassifiedError(provider.ErrorTypeInvalid, "invalid address", provider.ErrInvalidAddress),
+		}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider("A", 0.4), invalid}, zap.NewNop(), Options{
+			MinConfidence: 0.7,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "A", result.Provider)
+		assert.True(t, result.LowConfidence)
+		assert.Len(t, result.Attempts, 2)
+	})
+}
+
+func TestGeocodingService_Geocode_StopOnNotFound_StillFallsBackOnErrors(t *testing.T) {
+	primary := &mockProvider{
+		name:      "Primary",
+		available: true,
+		err:       provider.NewClassifiedError(provider.ErrorTypeSystemFailure, "server error", nil),
+	}
+	backup := &mockProvider{
+		name:      "Backup",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{primary, backup}, zap.NewNop(), Options{
+		StopOnNotFound: true,
+	})
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로", "")
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "Backup", result.Provider)
+}
+
+func TestGeocodingService_Geocode_StopOnNotFound_SkipsUncoveredAddress(t *testing.T) {
+	local := &mockProvider{
+		name:      "Local",
+		available: true,
+		result:    &model.ProviderResult{Success: false, Error: provider.ErrNotCovered},
+	}
+	api := &mockProvider{
+		name:      "Kakao",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{local, api}, zap.NewNop(), Options{
+		StopOnNotFound: true,
+	})
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	require.NoError(t, err)
+	assert.True(t, result.Success, "데이터셋에 없는 주소는 NOT_FOUND로 멈추지 않음")
+	assert.Equal(t, "Kakao", result.Provider)
+	require.Len(t, result.Attempts, 2)
+	assert.Equal(t, provider.ErrNotCovered.Error(), result.Attempts[0].Error)
+}
+
 func TestGeocodingService_Geocode_UnauthorizedDisablesProvider(t *testing.T) {
 	logger := zap.NewNop()
 	mockP := &mockProvider{
@@ -202,6 +404,7 @@ func TestGeocodingService_Geocode_NonFallbackError(t *testing.T) {
 	require.NotNil(t, result)
 	assert.False(t, result.Success)
 	assert.Contains(t, result.Error, "invalid input")
+	assert.Equal(t, model.ErrorCodeInvalidAddress, result.ErrorCode)
 }
 
 func TestGeocodingService_Geocode_UnexpectedError(t *testing.T) {
@@ -218,6 +421,29 @@ func TestGeocodingService_Geocode_UnexpectedError(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.False(t, result.Success)
+	assert.Equal(t, model.ErrorCodeAddressNotFound, result.ErrorCode)
+}
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no providers", ErrNoProvidersAvailable, model.ErrorCodeProvidersUnavailable},
+		{"outside korea", fmt.Errorf("wrapped: %w", ErrOutsideKorea), model.ErrorCodeOutsideKorea},
+		{"deadline", context.DeadlineExceeded, model.ErrorCodeTimeout},
+		{"rate limit", provider.NewClassifiedError(provider.ErrorTypeRateLimitExceeded, "quota", nil), model.ErrorCodeRateLimitExceeded},
+		{"unauthorized", provider.NewClassifiedError(provider.ErrorTypeUnauthorized, "auth", nil), model.ErrorCodeProviderUnauthorized},
+		{"system failure", provider.NewClassifiedError(provider.ErrorTypeSystemFailure, "5xx", nil), model.ErrorCodeProviderError},
+		{"unknown", errors.New("boom"), model.ErrorCodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorCodeOf(tt.err))
+		})
+	}
 }
 
 func TestGeocodingService_GeocodeBatch_Success(t *testing.T) {
@@ -239,7 +465,7 @@ func TestGeocodingService_GeocodeBatch_Success(t *testing.T) {
 		"서울특별시 중구 세종대로 110",
 		"부산광역시 해운대구 해운대해변로 264",
 	}
-	result, err := svc.GeocodeBatch(context.Background(), addresses)
+	result, err := svc.GeocodeBatch(context.Background(), addresses, "")
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -254,7 +480,7 @@ func TestGeocodingService_GeocodeBatch_Empty(t *testing.T) {
 	mockP := &mockProvider{name: "MockProvider", available: true}
 	svc := NewGeocodingService([]provider.GeocodingProvider{mockP}, logger)
 
-	result, err := svc.GeocodeBatch(context.Background(), []string{})
+	result, err := svc.GeocodeBatch(context.Background(), []string{}, "")
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -273,13 +499,16 @@ func TestGeocodingService_ValidateAddress(t *testing.T) {
 		{"valid address", "서울특별시 중구 세종대로 110", false},
 		{"invalid short address", "ab", true},
 		{"empty address", "", true},
+		{"over-length address", strings.Repeat("서울특별시 중구 ", 1000), true},
+		{"control characters", "서울특별시 중구\x00세종대로 110", true},
+		{"excessive repetition", "서울특별시 중구 " + strings.Repeat("가", 50), true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := svc.ValidateAddress(tt.address)
 			if tt.wantErr {
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, provider.ErrInvalidAddress)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -287,6 +516,27 @@ func TestGeocodingService_ValidateAddress(t *testing.T) {
 	}
 }
 
+func TestGeocodingService_Geocode_MaxAddressLength(t *testing.T) {
+	mock := &concurrencyProvider{mockProvider: mockProvider{name: "vWorld", available: true}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{mock}, zap.NewNop(), Options{
+		MaxAddressLength: 20,
+	})
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110 서울특별시청 본관", "")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, model.ErrorCodeInvalidAddress, result.ErrorCode)
+	assert.Zero(t, mock.maxInFlight.Load(), "provider not called")
+
+	result, err = svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, int32(1), mock.maxInFlight.Load())
+	assert.ErrorIs(t, svc.ValidateAddress("서울특별시 중구 세종대로 110 서울특별시청 본관"), provider.ErrInvalidAddress)
+}
+
 func TestGeocodingService_GetAvailableProviders(t *testing.T) {
 	logger := zap.NewNop()
 	providers := []provider.GeocodingProvider{
@@ -303,3 +553,1833 @@ func TestGeocodingService_GetAvailableProviders(t *testing.T) {
 	assert.Contains(t, result, "Provider3")
 	assert.NotContains(t, result, "Provider2")
 }
+
+func TestGeocodingService_Geocode_AdminCodeLength(t *testing.T) {
+	tests := []struct {
+		name          string
+		length        int
+		wantLegalDong string
+		wantAdminDong string
+	}{
+		{"default keeps 10 digits", 0, "1114010300", "1114055000"},
+		{"truncate to 8 digits", 8, "11140103", "11140550"},
+		{"truncate to 5 digits", 5, "11140", "11140"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockP := &mockProvider{
+				name:      "MockProvider",
+				available: true,
+				result: &model.ProviderResult{
+					Success: true,
+					Coordinate: model.Coordinate{
+						Latitude:  37.5665,
+						Longitude: 126.978,
+					},
+					AddressDetail: model.AddressDetail{
+						LegalDongCode: "1114010300",
+						AdminDongCode: "1114055000",
+					},
+				},
+			}
+			svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{mockP}, zap.NewNop(), Options{
+				AdminCodeLength: tt.length,
+			})
+
+			result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			require.NotNil(t, result.AddressDetail)
+			assert.Equal(t, tt.wantLegalDong, result.AddressDetail.LegalDongCode)
+			assert.Equal(t, tt.wantAdminDong, result.AddressDetail.AdminDongCode)
+			// Provider 원본 결과는 변경되지 않아야 함
+			assert.Equal(t, "1114010300", mockP.result.AddressDetail.LegalDongCode)
+		})
+	}
+}
+
+func TestGeocodingService_Geocode_CoordinatePrecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		wantLat   float64
+		wantLng   float64
+	}{
+		{"default 6 places", 0, 37.566536, 126.977969},
+		{"4 places", 4, 37.5665, 126.978},
+		{"6 places", 6, 37.566536, 126.977969},
+		{"8 places", 8, 37.56653582, 126.97796919},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockP := &mockProvider{
+				name:      "MockProvider",
+				available: true,
+				result: &model.ProviderResult{
+					Success: true,
+					Coordinate: model.Coordinate{
+						Latitude:  37.566535821,
+						Longitude: 126.977969193,
+					},
+				},
+			}
+			svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{mockP}, zap.NewNop(), Options{
+				CoordinatePrecision: tt.precision,
+			})
+
+			result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			assert.InDelta(t, tt.wantLat, result.Coordinate.Latitude, 1e-10)
+			assert.InDelta(t, tt.wantLng, result.Coordinate.Longitude, 1e-10)
+		})
+	}
+}
+
+func TestGeocodingService_Geocode_InvalidCoordinateKeepsAddressDetail(t *testing.T) {
+	mockP := &mockProvider{
+		name:      "MockProvider",
+		available: true,
+		result: &model.ProviderResult{
+			Success: true,
+			Coordinate: model.Coordinate{
+				Latitude:  127.5665, // 범위를 벗어난 위도
+				Longitude: 126.978,
+			},
+			AddressDetail: model.AddressDetail{
+				RoadAddress:   "서울특별시 중구 세종대로 110",
+				ParcelAddress: "서울특별시 중구 태평로1가 31",
+			},
+		},
+	}
+	svc := NewGeocodingService([]provider.GeocodingProvider{mockP}, zap.NewNop())
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.False(t, result.CoordinateValid)
+	assert.Equal(t, "invalid coordinates", result.Error)
+	require.NotNil(t, result.AddressDetail)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", result.AddressDetail.RoadAddress)
+	assert.Equal(t, "서울특별시 중구 태평로1가 31", result.AddressDetail.ParcelAddress)
+	require.NotNil(t, result.Coordinate)
+	assert.Equal(t, 127.5665, result.Coordinate.Latitude)
+	assert.Equal(t, 126.978, result.Coordinate.Longitude)
+}
+
+func TestGeocodingService_Geocode_InvalidCoordinateFallsBack(t *testing.T) {
+	invalid := &mockProvider{
+		name:      "vWorld",
+		available: true,
+		result: &model.ProviderResult{
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 127.5665, Longitude: 126.978}, // 범위를 벗어난 위도
+			AddressDetail: model.AddressDetail{RoadAddress: "서울특별시 중구 세종대로 110"},
+		},
+	}
+	valid := &mockProvider{
+		name:      "Kakao",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}
+	svc := NewGeocodingService([]provider.GeocodingProvider{invalid, valid}, zap.NewNop())
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+	// 좌표가 유효하지 않은 결과는 실패한 시도로 기록하고 다음 Provider 결과 사용
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.True(t, result.CoordinateValid)
+	assert.Equal(t, "Kakao", result.Provider)
+	require.Len(t, result.Attempts, 2)
+	assert.False(t, result.Attempts[0].Success)
+	assert.Equal(t, "invalid coordinates", result.Attempts[0].Error)
+	assert.True(t, result.Attempts[1].Success)
+}
+
+func TestGeocodingService_GeocodeBatchStream_StopsOnSendError(t *testing.T) {
+	mockP := &mockProvider{
+		name:      "MockProvider",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}
+	svc := NewGeocodingService([]provider.GeocodingProvider{mockP}, zap.NewNop())
+
+	addresses := make([]string, 30)
+	for i := range addresses {
+		addresses[i] = "서울특별시 중구 세종대로 110"
+	}
+
+	sendErr := errors.New("client went away")
+	calls := 0
+	err := svc.GeocodeBatchStream(context.Background(), addresses, BatchOptions{}, func(index int, result *model.GeocodingResponse) error {
+		calls++
+		return sendErr
+	})
+
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, calls)
+}
+
+// typedProvider 주소 타입 지정 호출을 기록하는 동시성 안전 Mock Provider
+type typedProvider struct {
+	mockProvider
+	mu    sync.Mutex
+	types []string
+}
+
+func (p *typedProvider) GeocodeWithType(ctx context.Context, address string, addrType string) (*model.ProviderResult, error) {
+	p.mu.Lock()
+	p.types = append(p.types, addrType)
+	p.mu.Unlock()
+	return p.result, p.err
+}
+
+func (p *typedProvider) calledTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.types...)
+}
+
+func TestGeocodingService_GeocodeBatch_AddressType(t *testing.T) {
+	p := &typedProvider{mockProvider: mockProvider{
+		name:      "Typed",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}}
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+	addresses := []string{
+		"서울특별시 중구 세종대로 110",
+		"서울특별시 중구 태평로1가 31",
+		"서울특별시 강남구 테헤란로 152",
+	}
+
+	result, err := svc.GeocodeBatch(context.Background(), addresses, "PARCEL")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Summary.Success)
+	assert.Equal(t, []string{"PARCEL", "PARCEL", "PARCEL"}, p.calledTypes())
+}
+
+func TestGeocodingService_Geocode_NormalizesAddressType(t *testing.T) {
+	p := &typedProvider{mockProvider: mockProvider{
+		name:      "Typed",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}}
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+	result, err := svc.Geocode(context.Background(), "서울특별시 중구 태평로1가 31", " parcel ")
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, []string{"PARCEL"}, p.calledTypes())
+
+	result, err = svc.Geocode(context.Background(), "서울특별시 중구 태평로1가 31", "ZIPCODE")
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, model.ErrorCodeInvalidRequest, result.ErrorCode)
+	assert.Contains(t, result.Error, "must be ROAD or PARCEL")
+	assert.Len(t, p.calledTypes(), 1, "잘못된 주소 타입은 Provider를 호출하지 않음")
+}
+
+func TestGeocodingService_GeocodeBatch_NoAddressTypeUsesGeocode(t *testing.T) {
+	p := &typedProvider{mockProvider: mockProvider{
+		name:      "Typed",
+		available: true,
+		result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		},
+	}}
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+	result, err := svc.GeocodeBatch(context.Background(), []string{"서울특별시 중구 세종대로 110"}, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Summary.Success)
+	assert.Empty(t, p.calledTypes())
+}
+
+// coordinateBookProvider 주소별로 미리 정한 좌표를 반환하는 Mock Provider
+type coordinateBookProvider struct {
+	mockProvider
+	coordinates map[string]model.Coordinate
+}
+
+func (p *coordinateBookProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	coord, ok := p.coordinates[address]
+	if !ok {
+		return &model.ProviderResult{Success: false}, nil
+	}
+	return &model.ProviderResult{Success: true, Coordinate: coord}, nil
+}
+
+func TestGeocodingService_GeocodeBatchWithOptions_Bounds(t *testing.T) {
+	p := &coordinateBookProvider{
+		mockProvider: mockProvider{name: "Book", available: true},
+		coordinates: map[string]model.Coordinate{
+			"서울특별시 중구 세종대로 110":     {Latitude: 37.566535, Longitude: 126.977969},
+			"서울특별시 강남구 테헤란로 152":    {Latitude: 37.500622, Longitude: 127.036456},
+			"부산광역시 해운대구 해운대해변로 264": {Latitude: 35.158698, Longitude: 129.160384},
+			"경기도 성남시 분당구 판교역로 235":  {Latitude: 37.402056, Longitude: 127.108212},
+		},
+	}
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+	seoul := &utils.Bounds{MinLatitude: 37.413294, MaxLatitude: 37.715133, MinLongitude: 126.734086, MaxLongitude: 127.269311}
+
+	addresses := []string{
+		"서울특별시 중구 세종대로 110",
+		"부산광역시 해운대구 해운대해변로 264",
+		"서울특별시 강남구 테헤란로 152",
+		"경기도 성남시 분당구 판교역로 235",
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		result, err := svc.GeocodeBatchWithOptions(context.Background(), addresses, BatchOptions{Bounds: seoul})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Summary.Success)
+		assert.Equal(t, 2, result.Summary.Failed)
+
+		assert.True(t, result.Results[0].Success)
+		assert.True(t, result.Results[2].Success)
+		for _, idx := range []int{1, 3} {
+			r := result.Results[idx]
+			assert.False(t, r.Success)
+			assert.Equal(t, ErrOutsideBounds.Error(), r.Error)
+			assert.Equal(t, model.ErrorCodeOutsideBounds, r.ErrorCode)
+			// 확인용 좌표는 유지
+			require.NotNil(t, r.Coordinate)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		var mu sync.Mutex
+		success := map[int]bool{}
+		err := svc.GeocodeBatchStream(context.Background(), addresses, BatchOptions{Bounds: seoul}, func(index int, result *model.GeocodingResponse) error {
+			mu.Lock()
+			defer mu.Unlock()
+			success[index] = result.Success
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[int]bool{0: true, 1: false, 2: true, 3: false}, success)
+	})
+
+	t.Run("single geocode is not affected", func(t *testing.T) {
+		result, err := svc.Geocode(context.Background(), "부산광역시 해운대구 해운대해변로 264", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+	})
+}
+
+func TestGeocodingService_Geocode_KoreanBounds(t *testing.T) {
+	// 도쿄 좌표를 반환하는 Provider 뒤에 서울 좌표를 반환하는 Provider
+	newProviders := func() []provider.GeocodingProvider {
+		return []provider.GeocodingProvider{
+			&mockProvider{
+				name:      "Foreign",
+				available: true,
+				result: &model.ProviderResult{
+					Success:    true,
+					Coordinate: model.Coordinate{Latitude: 35.689487, Longitude: 139.691706},
+				},
+			},
+			&mockProvider{
+				name:      "Korean",
+				available: true,
+				result: &model.ProviderResult{
+					Success:    true,
+					Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+				},
+			},
+		}
+	}
+
+	t.Run("enforce off keeps foreign result", func(t *testing.T) {
+		svc := NewGeocodingService(newProviders(), zap.NewNop())
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Foreign", result.Provider)
+	})
+
+	t.Run("enforce on falls back to next provider", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions(newProviders(), zap.NewNop(), Options{
+			EnforceKoreanBounds: true,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Korean", result.Provider)
+		require.Len(t, result.Attempts, 2)
+		assert.False(t, result.Attempts[0].Success)
+		assert.Equal(t, ErrOutsideKorea.Error(), result.Attempts[0].Error)
+		assert.True(t, result.Attempts[1].Success)
+	})
+
+	t.Run("enforce on with only foreign results", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions(newProviders()[:1], zap.NewNop(), Options{
+			EnforceKoreanBounds: true,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, ErrOutsideKorea.Error(), result.Error)
+	})
+
+	t.Run("custom bounds include foreign result", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions(newProviders(), zap.NewNop(), Options{
+			EnforceKoreanBounds: true,
+			KoreanBounds: &utils.Bounds{
+				MinLatitude:  30.0,
+				MaxLatitude:  45.0,
+				MinLongitude: 120.0,
+				MaxLongitude: 145.0,
+			},
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Foreign", result.Provider)
+	})
+}
+
+func TestGeocodingService_Geocode_DebugRequestURL(t *testing.T) {
+	const requestURL = "https://api.example.com/geocode?address=test&key=REDACTED"
+	newProviders := func() []provider.GeocodingProvider {
+		return []provider.GeocodingProvider{
+			&mockProvider{
+				name:      "Failing",
+				available: true,
+				err: &provider.ClassifiedError{
+					Type:       provider.ErrorTypeSystemFailure,
+					Message:    "API returned status 500",
+					Fallback:   true,
+					RequestURL: requestURL,
+				},
+			},
+			&mockProvider{
+				name:      "MockProvider",
+				available: true,
+				result: &model.ProviderResult{
+					Success:    true,
+					Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+					RequestURL: requestURL,
+				},
+			},
+		}
+	}
+
+	t.Run("debug on", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions(newProviders(), zap.NewNop(), Options{Debug: true})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		require.Len(t, result.Attempts, 2)
+		for _, attempt := range result.Attempts {
+			assert.Equal(t, requestURL, attempt.RequestURL)
+		}
+	})
+
+	t.Run("debug off", func(t *testing.T) {
+		svc := NewGeocodingService(newProviders(), zap.NewNop())
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		require.Len(t, result.Attempts, 2)
+		for _, attempt := range result.Attempts {
+			assert.Empty(t, attempt.RequestURL)
+		}
+	})
+}
+
+// concurrencyProvider 동시에 실행 중인 호출 수의 최대값을 기록하는 Mock Provider
+type concurrencyProvider struct {
+	mockProvider
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (p *concurrencyProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	n := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		max := p.maxInFlight.Load()
+		if n <= max || p.maxInFlight.CompareAndSwap(max, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	return &model.ProviderResult{
+		Success:       true,
+		Coordinate:    model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+		AddressDetail: model.AddressDetail{RoadAddress: address},
+	}, nil
+}
+
+func TestGeocodingService_GeocodeBatch_SequentialThreshold(t *testing.T) {
+	newAddresses := func(n int) []string {
+		addresses := make([]string, n)
+		for i := range addresses {
+			addresses[i] = fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1)
+		}
+		return addresses
+	}
+
+	tests := []struct {
+		name           string
+		count          int
+		wantSequential bool
+	}{
+		{"small batch runs sequentially", 2, true},
+		{"large batch runs concurrently", 10, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &concurrencyProvider{mockProvider: mockProvider{name: "MockProvider", available: true}}
+			svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+				SequentialBatchThreshold: 3,
+			})
+			addresses := newAddresses(tt.count)
+
+			result, err := svc.GeocodeBatch(context.Background(), addresses, "")
+
+			require.NoError(t, err)
+			require.Len(t, result.Results, tt.count)
+			assert.Equal(t, tt.count, result.Summary.Success)
+			// 순서는 처리 방식과 무관하게 입력 순서와 동일
+			for i, r := range result.Results {
+				assert.Equal(t, addresses[i], r.AddressDetail.RoadAddress)
+			}
+			if tt.wantSequential {
+				assert.Equal(t, int32(1), p.maxInFlight.Load())
+			} else {
+				assert.Greater(t, p.maxInFlight.Load(), int32(1))
+			}
+		})
+	}
+}
+
+// slowProvider 지정한 시간만큼 지연 후 응답하는 Mock Provider (컨텍스트 취소 시 즉시 반환)
+type slowProvider struct {
+	mockProvider
+	delay time.Duration
+}
+
+func (p *slowProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	select {
+	case <-time.After(p.delay):
+		return p.result, p.err
+	case <-ctx.Done():
+		return nil, provider.NewClassifiedError(provider.ErrorTypeTimeout, "request cancelled", ctx.Err())
+	}
+}
+
+func TestGeocodingService_Geocode_FallbackAfter(t *testing.T) {
+	newResult := func(road string) *model.ProviderResult {
+		return &model.ProviderResult{
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+			AddressDetail: model.AddressDetail{RoadAddress: road},
+		}
+	}
+
+	t.Run("fast fallback wins over slow primary", func(t *testing.T) {
+		vworld := &slowProvider{
+			mockProvider: mockProvider{name: "vWorld", available: true, result: newResult("vWorld 주소")},
+			delay:        2 * time.Second,
+		}
+		kakao := &slowProvider{
+			mockProvider: mockProvider{name: "Kakao", available: true, result: newResult("Kakao 주소")},
+			delay:        10 * time.Millisecond,
+		}
+		fallbackAfter := 100 * time.Millisecond
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+			FallbackAfter: fallbackAfter,
+		})
+
+		start := time.Now()
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		elapsed := time.Since(start)
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Kakao", result.Provider)
+		assert.Equal(t, "Kakao 주소", result.AddressDetail.RoadAddress)
+		// Kakao는 FallbackAfter 이후에 시작되며, vWorld의 응답을 기다리지 않음
+		assert.GreaterOrEqual(t, elapsed, fallbackAfter)
+		assert.Less(t, elapsed, time.Second)
+		require.Len(t, result.Attempts, 1)
+		assert.Equal(t, "Kakao", result.Attempts[0].Provider)
+	})
+
+	t.Run("primary answering before delay is used alone", func(t *testing.T) {
+		vworld := &slowProvider{
+			mockProvider: mockProvider{name: "vWorld", available: true, result: newResult("vWorld 주소")},
+			delay:        10 * time.Millisecond,
+		}
+		kakao := &concurrencyProvider{mockProvider: mockProvider{name: "Kakao", available: true}}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+			FallbackAfter: 500 * time.Millisecond,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "vWorld", result.Provider)
+		assert.Equal(t, int32(0), kakao.maxInFlight.Load())
+	})
+
+	t.Run("failed primary falls back without waiting", func(t *testing.T) {
+		vworld := &mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: false}}
+		kakao := &slowProvider{
+			mockProvider: mockProvider{name: "Kakao", available: true, result: newResult("Kakao 주소")},
+			delay:        10 * time.Millisecond,
+		}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+			FallbackAfter: time.Second,
+		})
+
+		start := time.Now()
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Kakao", result.Provider)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		require.Len(t, result.Attempts, 2)
+		assert.Equal(t, "vWorld", result.Attempts[0].Provider)
+		assert.False(t, result.Attempts[0].Success)
+	})
+
+	t.Run("all providers fail", func(t *testing.T) {
+		vworld := &slowProvider{
+			mockProvider: mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: false}},
+			delay:        50 * time.Millisecond,
+		}
+		kakao := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{Success: false}}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+			FallbackAfter: 10 * time.Millisecond,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, "none", result.Provider)
+		assert.Len(t, result.Attempts, 2)
+	})
+}
+
+// reverseProvider 지연 후 응답하며 동시 실행 수를 기록하는 역지오코딩 Mock Provider
+type reverseProvider struct {
+	concurrencyProvider
+	delay time.Duration
+}
+
+func (p *reverseProvider) ReverseGeocode(ctx context.Context, coord model.Coordinate) (*model.ProviderResult, error) {
+	n := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		max := p.maxInFlight.Load()
+		if n <= max || p.maxInFlight.CompareAndSwap(max, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return nil, provider.NewClassifiedError(provider.ErrorTypeTimeout, "request cancelled", ctx.Err())
+	}
+	return &model.ProviderResult{
+		Success:       true,
+		Coordinate:    coord,
+		AddressDetail: model.AddressDetail{RoadAddress: fmt.Sprintf("%.4f,%.4f", coord.Latitude, coord.Longitude)},
+	}, nil
+}
+
+// countingLimiter Wait 호출 수를 기록하는 Limiter
+type countingLimiter struct {
+	waits atomic.Int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits.Add(1)
+	return nil
+}
+
+func newCoordinates(n int) []model.Coordinate {
+	coords := make([]model.Coordinate, n)
+	for i := range coords {
+		coords[i] = model.Coordinate{Latitude: 37.5 + float64(i)*0.001, Longitude: 126.9}
+	}
+	return coords
+}
+
+func TestGeocodingService_ReverseGeocode(t *testing.T) {
+	forward := &mockProvider{name: "ForwardOnly", available: true}
+	reverse := &reverseProvider{concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Reverse", available: true}}}
+	svc := NewGeocodingService([]provider.GeocodingProvider{forward, reverse}, zap.NewNop())
+
+	t.Run("uses reverse-capable providers only", func(t *testing.T) {
+		result, err := svc.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 37.5665, Longitude: 126.978})
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Reverse", result.Provider)
+		assert.Equal(t, "37.5665,126.9780", result.AddressDetail.RoadAddress)
+		require.Len(t, result.Attempts, 1)
+	})
+
+	t.Run("invalid coordinate", func(t *testing.T) {
+		result, err := svc.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 91, Longitude: 126.978})
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, "invalid coordinates", result.Error)
+	})
+
+	t.Run("no reverse provider", func(t *testing.T) {
+		svc := NewGeocodingService([]provider.GeocodingProvider{forward}, zap.NewNop())
+
+		_, err := svc.ReverseGeocode(context.Background(), model.Coordinate{Latitude: 37.5665, Longitude: 126.978})
+
+		assert.ErrorIs(t, err, ErrNoProvidersAvailable)
+	})
+}
+
+func TestGeocodingService_ReverseGeocodeBatch_RespectsConcurrency(t *testing.T) {
+	p := &reverseProvider{
+		concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Reverse", available: true}},
+		delay:               20 * time.Millisecond,
+	}
+	limiter := &countingLimiter{}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		ConcurrentLimit: 3,
+		Limiter:         limiter,
+	})
+	coords := newCoordinates(12)
+
+	result, err := svc.ReverseGeocodeBatch(context.Background(), coords)
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, len(coords))
+	assert.Equal(t, len(coords), result.Summary.Success)
+	// 순서는 입력 순서와 동일
+	for i, r := range result.Results {
+		assert.InDelta(t, coords[i].Latitude, r.Coordinate.Latitude, 0.000001)
+	}
+	// 정방향 배치와 같은 동시 처리 제한 및 전역 속도 제한 적용
+	assert.Equal(t, int32(3), p.maxInFlight.Load())
+	assert.Equal(t, int32(len(coords)), limiter.waits.Load())
+}
+
+func TestGeocodingService_ReverseGeocodeBatch_OwnConcurrentLimit(t *testing.T) {
+	reverse := &reverseProvider{
+		concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Reverse", available: true}},
+		delay:               20 * time.Millisecond,
+	}
+	forward := &concurrencyProvider{mockProvider: mockProvider{name: "Forward", available: true}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{reverse}, zap.NewNop(), Options{
+		ConcurrentLimit:        5,
+		ReverseConcurrentLimit: 2,
+	})
+
+	result, err := svc.ReverseGeocodeBatch(context.Background(), newCoordinates(12))
+
+	require.NoError(t, err)
+	assert.Equal(t, 12, result.Summary.Success)
+	assert.Equal(t, int32(2), reverse.maxInFlight.Load(), "역방향 배치는 자체 제한 적용")
+
+	// 정방향 배치는 ConcurrentLimit 그대로
+	svc = NewGeocodingServiceWithOptions([]provider.GeocodingProvider{forward}, zap.NewNop(), Options{
+		ConcurrentLimit:        5,
+		ReverseConcurrentLimit: 2,
+	})
+	addresses := make([]string, 12)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1)
+	}
+	_, err = svc.GeocodeBatch(context.Background(), addresses, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), forward.maxInFlight.Load())
+}
+
+func TestGeocodingService_GeocodeBatch_MixedReverseConcurrentLimit(t *testing.T) {
+	forward := &concurrencyProvider{mockProvider: mockProvider{name: "Forward", available: true}}
+	reverse := &reverseProvider{
+		concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Reverse", available: true}},
+		delay:               20 * time.Millisecond,
+	}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{forward, reverse}, zap.NewNop(), Options{
+		ConcurrentLimit:        5,
+		ReverseConcurrentLimit: 2,
+	})
+	var inputs []string
+	for i, coord := range newCoordinates(12) {
+		inputs = append(inputs,
+			fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1),
+			fmt.Sprintf("%f,%f", coord.Latitude, coord.Longitude),
+		)
+	}
+
+	result, err := svc.GeocodeBatch(context.Background(), inputs, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, len(inputs), result.Summary.Success)
+	// 섞인 좌표 항목도 역방향 배치와 같은 제한 적용
+	assert.Equal(t, int32(2), reverse.maxInFlight.Load())
+	assert.LessOrEqual(t, forward.maxInFlight.Load(), int32(5))
+}
+
+func TestGeocodingService_ReverseGeocodeBatch_ItemTimeout(t *testing.T) {
+	p := &reverseProvider{
+		concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Reverse", available: true}},
+		delay:               time.Second,
+	}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		BatchItemTimeout: 20 * time.Millisecond,
+	})
+
+	start := time.Now()
+	result, err := svc.ReverseGeocodeBatch(context.Background(), newCoordinates(3))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Summary.Failed)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestGeocodingService_GeocodeBatch_ConcurrentLimit(t *testing.T) {
+	p := &concurrencyProvider{mockProvider: mockProvider{name: "MockProvider", available: true}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		ConcurrentLimit: 2,
+	})
+	addresses := make([]string, 8)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1)
+	}
+
+	result, err := svc.GeocodeBatch(context.Background(), addresses, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, len(addresses), result.Summary.Success)
+	assert.Equal(t, int32(2), p.maxInFlight.Load())
+}
+
+// cooldownProvider 한도 초과 에러를 반환하고 DisableFor 호출을 기록하는 Mock Provider
+type cooldownProvider struct {
+	mockProvider
+	cooldown time.Duration
+}
+
+func (p *cooldownProvider) DisableFor(reason string, d time.Duration) {
+	p.disabled = true
+	p.disableReason = reason
+	p.cooldown = d
+}
+
+func (p *cooldownProvider) DisabledUntil() time.Time { return time.Time{} }
+
+func TestGeocodingService_Geocode_RateLimitCooldown(t *testing.T) {
+	rateLimited := func(retryAfter time.Duration) error {
+		ce := provider.NewClassifiedError(provider.ErrorTypeRateLimitExceeded, "Rate limit exceeded", provider.ErrQuotaExceeded)
+		ce.RetryAfter = retryAfter
+		return ce
+	}
+
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{"uses Retry-After", 90 * time.Second, 90 * time.Second},
+		{"default cooldown", 0, provider.DefaultRateLimitCooldown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &cooldownProvider{mockProvider: mockProvider{name: "vWorld", available: true, err: rateLimited(tt.retryAfter)}}
+			svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+			_, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+			require.NoError(t, err)
+			assert.True(t, p.disabled)
+			assert.Equal(t, tt.want, p.cooldown)
+		})
+	}
+}
+
+func TestGeocodingService_Geocode_DisableBackoff(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC))
+	p := &cooldownProvider{mockProvider: mockProvider{name: "Kakao", available: true}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		DisableBackoff:      []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute},
+		DisableBackoffReset: 30 * time.Minute,
+		Clock:               fake,
+	})
+	ctx := context.Background()
+	address := "서울특별시 중구 세종대로 110"
+
+	// rateLimit 한도 초과 응답으로 비활성화시키고 적용된 기간 반환 (다음 호출을 위해 다시 활성화)
+	rateLimit := func(retryAfter time.Duration) time.Duration {
+		ce := provider.NewClassifiedError(provider.ErrorTypeRateLimitExceeded, "Rate limit exceeded", provider.ErrQuotaExceeded)
+		ce.RetryAfter = retryAfter
+		p.err, p.result = ce, nil
+		_, err := svc.Geocode(ctx, address, "")
+		require.NoError(t, err)
+		require.True(t, p.disabled)
+		p.Enable()
+		return p.cooldown
+	}
+	succeed := func() {
+		p.err = nil
+		p.result = &model.ProviderResult{Success: true, Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780}}
+		result, err := svc.Geocode(ctx, address, "")
+		require.NoError(t, err)
+		require.True(t, result.Success)
+	}
+
+	// 연속 한도 초과 시 단계별로 증가하고 마지막 단계 유지
+	assert.Equal(t, time.Minute, rateLimit(0))
+	assert.Equal(t, 5*time.Minute, rateLimit(0))
+	assert.Equal(t, 15*time.Minute, rateLimit(0))
+	assert.Equal(t, 15*time.Minute, rateLimit(0))
+
+	// Retry-After가 단계보다 길면 Retry-After 사용
+	assert.Equal(t, time.Hour, rateLimit(time.Hour))
+
+	// 정상 응답이 reset 기간보다 짧게 이어지면 단계 유지
+	succeed()
+	fake.Advance(29 * time.Minute)
+	succeed()
+	assert.Equal(t, 15*time.Minute, rateLimit(0))
+
+	// reset 기간 동안 정상 응답이 이어지면 처음 단계로 초기화
+	succeed()
+	fake.Advance(30 * time.Minute)
+	succeed()
+	assert.Equal(t, time.Minute, rateLimit(0))
+}
+
+func TestGeocodingService_RegionFallback(t *testing.T) {
+	newProvider := func() *coordinateBookProvider {
+		return &coordinateBookProvider{
+			mockProvider: mockProvider{name: "Book", available: true},
+			coordinates: map[string]model.Coordinate{
+				"서울특별시 중구 세종대로 110": {Latitude: 37.566535, Longitude: 126.977969},
+				"서울특별시 강남구":         {Latitude: 37.517236, Longitude: 127.047325},
+				"경기도 성남시":           {Latitude: 37.420000, Longitude: 127.126703},
+			},
+		}
+	}
+
+	t.Run("full address is a rooftop match", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "ROOFTOP", result.MatchLevel)
+		assert.Len(t, result.Attempts, 1)
+	})
+
+	t.Run("too specific address degrades to region", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 강남구 없는로 999", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Equal(t, "REGION", result.MatchLevel)
+		assert.InDelta(t, 37.517236, result.Coordinate.Latitude, 1e-6)
+		// 전체 주소, 도로명까지, 구까지 시도
+		assert.Len(t, result.Attempts, 3)
+	})
+
+	t.Run("stops at the most specific region", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "경기도 성남시 분당구 삼평동 681", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Equal(t, "REGION", result.MatchLevel)
+		assert.InDelta(t, 37.42, result.Coordinate.Latitude, 1e-6)
+	})
+
+	t.Run("street level match", func(t *testing.T) {
+		p := newProvider()
+		p.coordinates["서울특별시 강남구 테헤란로"] = model.Coordinate{Latitude: 37.504, Longitude: 127.048}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 강남구 테헤란로 99999", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Equal(t, "STREET", result.MatchLevel)
+	})
+
+	t.Run("nothing resolves", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "부산광역시 해운대구 없는로 1", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Empty(t, result.MatchLevel)
+		assert.Equal(t, model.ErrorCodeAddressNotFound, result.ErrorCode)
+		assert.Len(t, result.Attempts, 4)
+	})
+
+	t.Run("applies after StopOnNotFound", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{
+			RegionFallback: true,
+			StopOnNotFound: true,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 강남구 없는로 999", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Equal(t, "REGION", result.MatchLevel)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		svc := NewGeocodingService([]provider.GeocodingProvider{newProvider()}, zap.NewNop())
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 강남구 없는로 999", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Len(t, result.Attempts, 1)
+	})
+}
+
+func TestGeocodingService_BuildingNameFallback(t *testing.T) {
+	newProvider := func() *coordinateBookProvider {
+		return &coordinateBookProvider{
+			mockProvider: mockProvider{name: "Book", available: true},
+			coordinates: map[string]model.Coordinate{
+				"서울특별시 중구 세종대로 110": {Latitude: 37.566535, Longitude: 126.977969},
+				"서울특별시 강남구 역삼동 737": {Latitude: 37.500088, Longitude: 127.036508},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		address      string
+		wantBuilding string
+		wantAttempts int
+	}{
+		{"road address with building name", "서울특별시 중구 세종대로 110 서울특별시청", "서울특별시청", 2},
+		{"parcel address with multi-word name", "서울특별시 강남구 역삼동 737 강남 파이낸스센터", "강남 파이낸스센터", 2},
+		{"address without building name", "서울특별시 중구 세종대로 110", "", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{BuildingNameFallback: true})
+
+			result, err := svc.Geocode(context.Background(), tt.address, "")
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			assert.Equal(t, "ROOFTOP", result.MatchLevel)
+			assert.Len(t, result.Attempts, tt.wantAttempts)
+			if tt.wantBuilding != "" {
+				require.NotNil(t, result.AddressDetail)
+				assert.Equal(t, tt.wantBuilding, result.AddressDetail.BuildingName)
+			}
+		})
+	}
+
+	t.Run("retry also fails", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{BuildingNameFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "부산광역시 해운대구 없는로 1 없는빌딩", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, model.ErrorCodeAddressNotFound, result.ErrorCode)
+		assert.Len(t, result.Attempts, 2)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		svc := NewGeocodingService([]provider.GeocodingProvider{newProvider()}, zap.NewNop())
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110 서울특별시청", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Len(t, result.Attempts, 1)
+	})
+}
+
+func TestGeocodingService_Geocode_Strategy(t *testing.T) {
+	newResult := func(road string) *model.ProviderResult {
+		return &model.ProviderResult{
+			Success:       true,
+			Coordinate:    model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+			AddressDetail: model.AddressDetail{RoadAddress: road},
+		}
+	}
+	// vWorld: 느린 성공, Kakao: 빠른 성공, Juso: 가장 빠른 실패
+	newProviders := func() []provider.GeocodingProvider {
+		return []provider.GeocodingProvider{
+			&slowProvider{
+				mockProvider: mockProvider{name: "vWorld", available: true, result: newResult("vWorld 주소")},
+				delay:        200 * time.Millisecond,
+			},
+			&slowProvider{
+				mockProvider: mockProvider{name: "Kakao", available: true, result: newResult("Kakao 주소")},
+				delay:        30 * time.Millisecond,
+			},
+			&slowProvider{
+				mockProvider: mockProvider{name: "Juso", available: true, result: &model.ProviderResult{Success: false}},
+				delay:        5 * time.Millisecond,
+			},
+		}
+	}
+
+	geocode := func(t *testing.T, strategy Strategy) (*model.GeocodingResponse, time.Duration) {
+		svc := NewGeocodingServiceWithOptions(newProviders(), zap.NewNop(), Options{Strategy: strategy})
+		start := time.Now()
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		require.NoError(t, err)
+		return result, time.Since(start)
+	}
+
+	fallback, fallbackElapsed := geocode(t, StrategyFallback)
+	parallel, parallelElapsed := geocode(t, StrategyParallel)
+
+	t.Run("fallback uses the first provider in order", func(t *testing.T) {
+		assert.True(t, fallback.Success)
+		assert.Equal(t, "vWorld", fallback.Provider)
+		assert.GreaterOrEqual(t, fallbackElapsed, 200*time.Millisecond)
+		require.Len(t, fallback.Attempts, 1)
+	})
+
+	t.Run("parallel uses the fastest success", func(t *testing.T) {
+		assert.True(t, parallel.Success)
+		assert.Equal(t, "Kakao", parallel.Provider)
+		assert.Equal(t, "Kakao 주소", parallel.AddressDetail.RoadAddress)
+		assert.Less(t, parallelElapsed, 150*time.Millisecond)
+		assert.Less(t, parallelElapsed, fallbackElapsed)
+
+		// 응답한 Provider만 완료 순서대로 기록 (취소된 vWorld는 제외)
+		require.Len(t, parallel.Attempts, 2)
+		assert.Equal(t, "Juso", parallel.Attempts[0].Provider)
+		assert.False(t, parallel.Attempts[0].Success)
+		assert.Equal(t, "Kakao", parallel.Attempts[1].Provider)
+		assert.True(t, parallel.Attempts[1].Success)
+	})
+
+	t.Run("parallel starts every provider at once", func(t *testing.T) {
+		vworld := &concurrencyProvider{mockProvider: mockProvider{name: "vWorld", available: true}}
+		kakao := &concurrencyProvider{mockProvider: mockProvider{name: "Kakao", available: true}}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop(), Options{
+			Strategy:      StrategyParallel,
+			FallbackAfter: time.Hour, // 병렬 전략에서는 무시
+		})
+
+		_, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), vworld.maxInFlight.Load())
+		assert.Equal(t, int32(1), kakao.maxInFlight.Load())
+	})
+
+	t.Run("parallel fast terminal failure does not cancel a slower success", func(t *testing.T) {
+		invalid := &slowProvider{
+			mockProvider: mockProvider{name: "vWorld", available: true,
+				err: provider.NewClassifiedError(provider.ErrorTypeInvalid, "invalid address", provider.ErrInvalidAddress)},
+			delay: 5 * time.Millisecond,
+		}
+		success := &slowProvider{
+			mockProvider: mockProvider{name: "Kakao", available: true, result: newResult("Kakao 주소")},
+			delay:        30 * time.Millisecond,
+		}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{invalid, success}, zap.NewNop(), Options{
+			Strategy: StrategyParallel,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Kakao", result.Provider)
+		require.Len(t, result.Attempts, 2)
+		assert.Equal(t, "vWorld", result.Attempts[0].Provider)
+		assert.False(t, result.Attempts[0].Success)
+	})
+
+	t.Run("parallel with every provider failing", func(t *testing.T) {
+		failing := &mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: false}}
+		unavailable := &mockProvider{name: "Kakao", available: false}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{failing, unavailable}, zap.NewNop(), Options{
+			Strategy: StrategyParallel,
+		})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Equal(t, "none", result.Provider)
+		assert.Len(t, result.Attempts, 2)
+	})
+}
+
+func TestGeocodingService_Geocode_BuildingUnit(t *testing.T) {
+	// 동/호가 붙은 주소는 찾지 못하는 Provider
+	newProvider := func() *coordinateBookProvider {
+		return &coordinateBookProvider{
+			mockProvider: mockProvider{name: "Book", available: true},
+			coordinates: map[string]model.Coordinate{
+				"서울특별시 송파구 올림픽로 135 잠실엘스":  {Latitude: 37.511553, Longitude: 127.080633},
+				"서울특별시 강남구 압구정로 201 현대아파트": {Latitude: 37.527880, Longitude: 127.025713},
+				"부산광역시 해운대구 마린시티2로 33":     {Latitude: 35.156012, Longitude: 129.146391},
+			},
+		}
+	}
+
+	tests := []struct {
+		address  string
+		wantDong string
+		wantUnit string
+	}{
+		{"서울특별시 송파구 올림픽로 135 잠실엘스 101동 1502호", "101동", "1502호"},
+		{"서울특별시 강남구 압구정로 201 현대아파트 12동1104호", "12동", "1104호"},
+		{"부산광역시 해운대구 마린시티2로 33, 103동 4501호", "103동", "4501호"},
+		{"서울특별시 송파구 올림픽로 135 잠실엘스 201호", "", "201호"},
+	}
+
+	svc := NewGeocodingService([]provider.GeocodingProvider{newProvider()}, zap.NewNop())
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			result, err := svc.Geocode(context.Background(), tt.address, "")
+
+			require.NoError(t, err)
+			require.True(t, result.Success)
+			require.NotNil(t, result.AddressDetail)
+			assert.Equal(t, tt.wantDong, result.AddressDetail.BuildingDong)
+			assert.Equal(t, tt.wantUnit, result.AddressDetail.BuildingUnit)
+		})
+	}
+
+	t.Run("address without unit", func(t *testing.T) {
+		result, err := svc.Geocode(context.Background(), "서울특별시 송파구 올림픽로 135 잠실엘스", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Empty(t, result.AddressDetail.BuildingDong)
+		assert.Empty(t, result.AddressDetail.BuildingUnit)
+	})
+
+	// 같은 건물의 다른 호수는 캐시를 공유하되 동/호는 각자 유지
+	t.Run("cached building keeps each unit", func(t *testing.T) {
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{newProvider()}, zap.NewNop(), Options{
+			Cache: cache.NewMemory(time.Minute, 10),
+		})
+
+		first, err := svc.Geocode(context.Background(), "서울특별시 송파구 올림픽로 135 잠실엘스 101동 1502호", "")
+		require.NoError(t, err)
+		second, err := svc.Geocode(context.Background(), "서울특별시 송파구 올림픽로 135 잠실엘스 102동 301호", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "102동", second.AddressDetail.BuildingDong)
+		assert.Equal(t, "301호", second.AddressDetail.BuildingUnit)
+		assert.Equal(t, "101동", first.AddressDetail.BuildingDong)
+		assert.Equal(t, "1502호", first.AddressDetail.BuildingUnit)
+	})
+}
+
+// downCache 저장소에 연결할 수 없는 캐시 (매번 addr로 실제 연결을 시도하고 실패하면 미스/버림)
+type downCache struct {
+	addr       string
+	gets, sets atomic.Int32
+	dialErrors atomic.Int32
+}
+
+func (c *downCache) dial(ctx context.Context) bool {
+	if err := cache.PingRedis(ctx, c.addr, "", 0); err != nil {
+		c.dialErrors.Add(1)
+		return false
+	}
+	return true
+}
+
+func (c *downCache) Get(ctx context.Context, key string) (*model.GeocodingResponse, bool) {
+	c.gets.Add(1)
+	c.dial(ctx)
+	return nil, false
+}
+
+func (c *downCache) Set(ctx context.Context, key string, resp *model.GeocodingResponse) {
+	c.sets.Add(1)
+	c.dial(ctx)
+}
+
+func TestGeocodingService_Geocode_CacheFailureDoesNotFailRequest(t *testing.T) {
+	p := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780},
+	}}
+	// 닫힌 포트로 연결이 거부되는 Redis
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	down := &downCache{addr: closed.Addr().String()}
+	closed.Close()
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{Cache: down})
+
+	for range 2 {
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "Kakao", result.Provider)
+	}
+	assert.Equal(t, int32(2), down.gets.Load())
+	assert.Equal(t, int32(2), down.sets.Load())
+	assert.Equal(t, int32(4), down.dialErrors.Load(), "every cache call failed to connect")
+}
+
+func TestGeocodingService_Geocode_CacheKeyedByAddressType(t *testing.T) {
+	p := &typedProvider{mockProvider: mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5546, Longitude: 126.9706},
+	}}}
+	shared := cache.NewMemory(time.Minute, 10)
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{Cache: shared})
+
+	_, err := svc.Geocode(context.Background(), "서울역", "ROAD")
+	require.NoError(t, err)
+	_, err = svc.Geocode(context.Background(), "서울역", "ROAD")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ROAD"}, p.calledTypes(), "second ROAD request is a cache hit")
+
+	// 같은 주소라도 PARCEL 요청은 ROAD 결과를 재사용하지 않음
+	_, err = svc.Geocode(context.Background(), "서울역", "PARCEL")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ROAD", "PARCEL"}, p.calledTypes())
+
+	// 캐시를 공유해도 좌표 정밀도가 다르면 별도 항목
+	coarse := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		Cache:               shared,
+		CoordinatePrecision: 2,
+	})
+	result, err := coarse.Geocode(context.Background(), "서울역", "ROAD")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ROAD", "PARCEL", "ROAD"}, p.calledTypes())
+	assert.Equal(t, 37.55, result.Coordinate.Latitude)
+}
+
+func TestGeocodingService_Geocode_CacheKeyIgnoresAddressTypesOrder(t *testing.T) {
+	p := &typedProvider{mockProvider: mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{
+		Success:    true,
+		Coordinate: model.Coordinate{Latitude: 37.5546, Longitude: 126.9706},
+	}}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{Cache: cache.NewMemory(time.Minute, 10)})
+
+	_, err := svc.GeocodeWithOptions(context.Background(), "서울역", "ROAD", GeocodeOptions{AddressTypes: []string{"ROAD", "PARCEL"}})
+	require.NoError(t, err)
+	_, err = svc.GeocodeWithOptions(context.Background(), "서울역", "ROAD", GeocodeOptions{AddressTypes: []string{"parcel", "ROAD"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ROAD"}, p.calledTypes(), "same restriction in another order is a cache hit")
+}
+
+func TestGeocodingService_Geocode_Confidence(t *testing.T) {
+	geocode := func(t *testing.T, result *model.ProviderResult) *model.GeocodingResponse {
+		t.Helper()
+		p := &mockProvider{name: "Mock", available: true, result: result}
+		svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+		resp, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		require.NoError(t, err)
+		require.True(t, resp.Success)
+		return resp
+	}
+	seoul := model.Coordinate{Latitude: 37.566535, Longitude: 126.977969}
+
+	exact := geocode(t, &model.ProviderResult{Success: true, Coordinate: seoul, Confidence: 0.95,
+		AddressDetail: model.AddressDetail{RoadAddress: "서울특별시 중구 세종대로 110", ParcelAddress: "서울특별시 중구 태평로1가 31"}})
+	fuzzy := geocode(t, &model.ProviderResult{Success: true, Coordinate: seoul, Confidence: 0.4,
+		AddressDetail: model.AddressDetail{RoadAddress: "서울특별시 중구 세종대로"}})
+	unscored := geocode(t, &model.ProviderResult{Success: true, Coordinate: seoul})
+	outside := geocode(t, &model.ProviderResult{Success: true, Coordinate: model.Coordinate{Latitude: 35.6762, Longitude: 139.6503}, Confidence: 0.95,
+		AddressDetail: model.AddressDetail{RoadAddress: "서울특별시 중구 세종대로 110", ParcelAddress: "서울특별시 중구 태평로1가 31"}})
+
+	// 도로명·지번 모두 확인된 정확 일치는 1을 넘지 않음
+	assert.Equal(t, 1.0, exact.Confidence)
+	assert.Equal(t, 0.4, fuzzy.Confidence)
+	assert.Greater(t, exact.Confidence, fuzzy.Confidence)
+	assert.Equal(t, defaultConfidence, unscored.Confidence)
+	assert.Equal(t, 0.5, outside.Confidence)
+
+	t.Run("coarser match scores lower", func(t *testing.T) {
+		p := &coordinateBookProvider{
+			mockProvider: mockProvider{name: "Book", available: true},
+			coordinates: map[string]model.Coordinate{
+				"서울특별시 강남구": {Latitude: 37.517236, Longitude: 127.047325},
+			},
+		}
+		svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{RegionFallback: true})
+
+		result, err := svc.Geocode(context.Background(), "서울특별시 강남구 없는로 999", "")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.Equal(t, "REGION", result.MatchLevel)
+		assert.Less(t, result.Confidence, unscored.Confidence)
+	})
+}
+
+func TestGeocodingService_Geocode_RequestIDInLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"meta": {"total_count": 1},
+			"documents": [{
+				"address_name": "서울 중구 세종대로 110",
+				"address_type": "ROAD_ADDR",
+				"x": "126.977969",
+				"y": "37.566535",
+				"road_address": {"address_name": "서울 중구 세종대로 110"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	log := zap.New(core)
+	kakao := provider.NewKakaoProvider("test-key", httpclient.NewClient(0), log)
+	require.NoError(t, kakao.SetBaseURL(server.URL))
+	svc := NewGeocodingService([]provider.GeocodingProvider{kakao}, log)
+
+	ctx := logger.WithRequestID(context.Background(), "req-42")
+	result, err := svc.Geocode(ctx, "서울특별시 중구 세종대로 110", "")
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.NotEmpty(t, logs.All())
+	// 서비스 로그와 Provider 로그 모두 같은 request_id를 가짐
+	assert.NotEmpty(t, logs.FilterMessage("Kakao geocoding succeeded").All())
+	for _, entry := range logs.All() {
+		assert.Equal(t, "req-42", entry.ContextMap()["request_id"], entry.Message)
+	}
+
+	t.Run("without request ID", func(t *testing.T) {
+		logs.TakeAll()
+
+		_, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+
+		require.NoError(t, err)
+		for _, entry := range logs.All() {
+			assert.NotContains(t, entry.ContextMap(), "request_id", entry.Message)
+		}
+	})
+}
+
+func TestGeocodingService_GeocodeBatch_MixedCoordinates(t *testing.T) {
+	p := &reverseProvider{concurrencyProvider: concurrencyProvider{mockProvider: mockProvider{name: "Both", available: true}}}
+	svc := NewGeocodingService([]provider.GeocodingProvider{p}, zap.NewNop())
+
+	resp, err := svc.GeocodeBatch(context.Background(), []string{
+		"서울특별시 중구 세종대로 110",
+		"37.5665, 126.978",
+		"35.1796,129.0756",
+		"126.978,37.5665", // 경도·위도 순서는 좌표로 보지 않음
+	}, "")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 4)
+	assert.Equal(t, 3, resp.Summary.Success)
+
+	// 주소 → 지오코딩
+	assert.True(t, resp.Results[0].Success)
+	assert.Equal(t, "서울특별시 중구 세종대로 110", resp.Results[0].AddressDetail.RoadAddress)
+
+	// 좌표 → 역지오코딩 (결과 좌표는 입력 좌표)
+	assert.True(t, resp.Results[1].Success)
+	assert.Equal(t, "37.5665,126.9780", resp.Results[1].AddressDetail.RoadAddress)
+	assert.InDelta(t, 37.5665, resp.Results[1].Coordinate.Latitude, 1e-6)
+	assert.True(t, resp.Results[2].Success)
+	assert.Equal(t, "35.1796,129.0756", resp.Results[2].AddressDetail.RoadAddress)
+
+	// 좌표도 주소도 아님
+	assert.False(t, resp.Results[3].Success)
+	assert.Equal(t, model.ErrorCodeInvalidAddress, resp.Results[3].ErrorCode)
+
+	t.Run("no reverse provider", func(t *testing.T) {
+		forward := &mockProvider{name: "ForwardOnly", available: true, result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.566535, Longitude: 126.977969},
+		}}
+		svc := NewGeocodingService([]provider.GeocodingProvider{forward}, zap.NewNop())
+
+		resp, err := svc.GeocodeBatch(context.Background(), []string{"서울특별시 중구 세종대로 110", "37.5665,126.978"}, "")
+
+		require.NoError(t, err)
+		assert.True(t, resp.Results[0].Success)
+		assert.False(t, resp.Results[1].Success)
+		assert.Equal(t, ErrorCodeOf(ErrNoProvidersAvailable), resp.Results[1].ErrorCode)
+	})
+}
+
+// gatedProvider release가 닫힐 때까지 응답을 미루고 호출 수를 기록하는 Mock Provider
+type gatedProvider struct {
+	mockProvider
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (p *gatedProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.calls.Add(1)
+	<-p.release
+	return p.result, p.err
+}
+
+// cancellableGatedProvider release가 닫히거나 ctx가 끝날 때까지 응답을 미루는 Provider
+type cancellableGatedProvider struct {
+	gatedProvider
+	cancelled atomic.Int32
+}
+
+func (p *cancellableGatedProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.calls.Add(1)
+	select {
+	case <-p.release:
+		return p.result, p.err
+	case <-ctx.Done():
+		p.cancelled.Add(1)
+		return nil, provider.NewClassifiedError(provider.ErrorTypeTimeout, "request cancelled", ctx.Err())
+	}
+}
+
+// countingCache 저장 횟수를 기록하는 캐시
+type countingCache struct {
+	cache.Cache
+	sets atomic.Int32
+}
+
+func (c *countingCache) Set(ctx context.Context, key string, resp *model.GeocodingResponse) {
+	c.sets.Add(1)
+	c.Cache.Set(ctx, key, resp)
+}
+
+func TestGeocodingService_Geocode_CoalescesConcurrentLookups(t *testing.T) {
+	p := &gatedProvider{
+		mockProvider: mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780},
+		}},
+		release: make(chan struct{}),
+	}
+	counting := &countingCache{Cache: cache.NewMemory(time.Minute, 10)}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{Cache: counting})
+
+	const n = 50
+	results := make([]*model.GeocodingResponse, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+			assert.NoError(t, err)
+			results[i] = result
+		}()
+	}
+
+	// 첫 호출이 시작된 뒤 나머지 요청이 합류할 시간을 두고 응답
+	require.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), p.calls.Load())
+	assert.Equal(t, int32(1), counting.sets.Load())
+	for _, r := range results {
+		require.NotNil(t, r)
+		assert.True(t, r.Success)
+		assert.Equal(t, "Kakao", r.Provider)
+	}
+	// 응답은 호출자마다 별도 복사본
+	assert.NotSame(t, results[0], results[1])
+}
+
+func TestGeocodingService_Geocode_CoalescedLeaderCancel(t *testing.T) {
+	p := &cancellableGatedProvider{gatedProvider: gatedProvider{
+		mockProvider: mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780},
+		}},
+		release: make(chan struct{}),
+	}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{})
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := svc.Geocode(leaderCtx, "서울특별시 중구 세종대로 110", "")
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	followerResult := make(chan *model.GeocodingResponse, 1)
+	go func() {
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		assert.NoError(t, err)
+		followerResult <- result
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// 먼저 시작한 요청이 취소되면 그 요청만 기다리지 않고 반환
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(p.release)
+	result := <-followerResult
+	require.NotNil(t, result)
+	assert.True(t, result.Success, "공유 호출은 취소되지 않음")
+	assert.Equal(t, int32(1), p.calls.Load())
+}
+
+func TestGeocodingService_Geocode_CoalescedCallerDeadline(t *testing.T) {
+	p := &cancellableGatedProvider{gatedProvider: gatedProvider{
+		mockProvider: mockProvider{name: "Kakao", available: true},
+		release:      make(chan struct{}),
+	}}
+	defer close(p.release)
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := svc.Geocode(ctx, "서울특별시 중구 세종대로 110", "")
+
+	// 혼자 기다리던 호출자의 마감이 지나면 Provider 호출도 취소되고 실패 응답을 받는다
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, int32(1), p.cancelled.Load())
+}
+
+func TestGeocodingService_Geocode_CoalescedCancelWhenAllCallersLeave(t *testing.T) {
+	p := &cancellableGatedProvider{gatedProvider: gatedProvider{
+		mockProvider: mockProvider{name: "Kakao", available: true},
+		release:      make(chan struct{}),
+	}}
+	defer close(p.release)
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{})
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := svc.Geocode(leaderCtx, "서울특별시 중구 세종대로 110", "")
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	followerCtx, cancelFollower := context.WithCancel(context.Background())
+	followerDone := make(chan struct{})
+	go func() {
+		defer close(followerDone)
+		_, _ = svc.Geocode(followerCtx, "서울특별시 중구 세종대로 110", "")
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	assert.Equal(t, int32(0), p.cancelled.Load(), "기다리는 호출자가 남아 있으면 취소하지 않음")
+
+	cancelFollower()
+	<-followerDone
+	assert.Equal(t, int32(1), p.cancelled.Load(), "마지막 호출자가 떠나면 공유 호출 취소")
+	assert.Equal(t, int32(1), p.calls.Load())
+}
+
+// orderProvider 호출 순서를 calls에 기록하고 주소를 찾지 못하는 Mock Provider
+type orderProvider struct {
+	mockProvider
+	mu    *sync.Mutex
+	calls *[]string
+}
+
+func (p *orderProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.mu.Lock()
+	*p.calls = append(*p.calls, p.name)
+	p.mu.Unlock()
+	return &model.ProviderResult{Success: false}, nil
+}
+
+func TestGeocodingService_Geocode_RegionProviders(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    []string
+	}{
+		{"Seoul prefers Kakao", "서울특별시 중구 세종대로 110", []string{"Kakao", "Nominatim", "vWorld"}},
+		{"abbreviated Seoul prefers Kakao", "서울 중구 세종대로 110", []string{"Kakao", "Nominatim", "vWorld"}},
+		{"Gangwon prefers vWorld", "강원특별자치도 춘천시 중앙로 1", []string{"vWorld", "Nominatim", "Kakao"}},
+		{"unmatched region keeps default order", "부산광역시 해운대구 해운대해변로 264", []string{"Nominatim", "vWorld", "Kakao"}},
+		{"no leading 시·도 keeps default order", "중구 세종대로 110", []string{"Nominatim", "vWorld", "Kakao"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu    sync.Mutex
+				calls []string
+			)
+			var providers []provider.GeocodingProvider
+			for _, name := range []string{"Nominatim", "vWorld", "Kakao"} {
+				providers = append(providers, &orderProvider{mockProvider: mockProvider{name: name, available: true}, mu: &mu, calls: &calls})
+			}
+			svc := NewGeocodingServiceWithOptions(providers, zap.NewNop(), Options{
+				RegionProviders: map[string]string{"서울": "Kakao", "강원도": "vWorld"},
+			})
+
+			_, err := svc.Geocode(context.Background(), tt.address, "")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, calls)
+		})
+	}
+}
+
+func TestGeocodingService_Geocode_MinAttemptBudget(t *testing.T) {
+	newProviders := func() (*slowProvider, *quotaProvider) {
+		slow := &slowProvider{
+			mockProvider: mockProvider{name: "vWorld", available: true,
+				err: provider.NewClassifiedError(provider.ErrorTypeSystemFailure, "upstream error", nil)},
+			delay: 150 * time.Millisecond,
+		}
+		return slow, newQuotaProvider("Kakao", true)
+	}
+	geocode := func(t *testing.T, budget time.Duration, providers ...provider.GeocodingProvider) *model.GeocodingResponse {
+		svc := NewGeocodingServiceWithOptions(providers, zap.NewNop(), Options{MinAttemptBudget: budget})
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		resp, err := svc.Geocode(ctx, "서울특별시 중구 세종대로 110", "")
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("skips fallback without enough time left", func(t *testing.T) {
+		slow, kakao := newProviders()
+		resp := geocode(t, 100*time.Millisecond, slow, kakao)
+
+		assert.False(t, resp.Success)
+		assert.Zero(t, kakao.calls.Load(), "남은 시간(약 50ms)이 최소 시도 시간보다 짧으면 호출하지 않음")
+		require.Len(t, resp.Attempts, 2)
+		assert.Equal(t, "Kakao", resp.Attempts[1].Provider)
+		assert.Equal(t, errInsufficientBudget.Error(), resp.Attempts[1].Error)
+	})
+
+	t.Run("tries fallback with enough time left", func(t *testing.T) {
+		slow, kakao := newProviders()
+		resp := geocode(t, 10*time.Millisecond, slow, kakao)
+
+		assert.True(t, resp.Success)
+		assert.Equal(t, "Kakao", resp.Provider)
+		assert.Equal(t, int64(1), kakao.calls.Load())
+	})
+
+	t.Run("first provider is always tried", func(t *testing.T) {
+		_, kakao := newProviders()
+		resp := geocode(t, time.Second, kakao)
+
+		assert.True(t, resp.Success)
+		assert.Equal(t, int64(1), kakao.calls.Load())
+	})
+}
+
+// bookNormalizer 주소별 공식 주소를 돌려주는 정규화 Provider
+type bookNormalizer struct {
+	details map[string]*model.AddressDetail
+}
+
+func (n *bookNormalizer) Name() string                         { return "Juso" }
+func (n *bookNormalizer) IsAvailable(ctx context.Context) bool { return true }
+func (n *bookNormalizer) Normalize(ctx context.Context, address string) (*model.AddressDetail, error) {
+	if detail, ok := n.details[address]; ok {
+		return detail, nil
+	}
+	return nil, provider.NewClassifiedError(provider.ErrorTypeNotFound, "no matching address", provider.ErrAddressNotFound)
+}
+
+func TestGeocodingService_Geocode_NormalizerPipeline(t *testing.T) {
+	p := &coordinateBookProvider{
+		mockProvider: mockProvider{name: "Book", available: true},
+		coordinates: map[string]model.Coordinate{
+			"서울특별시 중구 세종대로 110":  {Latitude: 37.566535, Longitude: 126.977969},
+			"서울특별시 송파구 올림픽로 25": {Latitude: 37.5152, Longitude: 127.0730},
+		},
+	}
+	normalizer := &bookNormalizer{details: map[string]*model.AddressDetail{
+		"서울특별시 중구 태평로1가 31": {
+			RoadAddress:              "서울특별시 중구 세종대로 110 (태평로1가)",
+			ParcelAddress:            "서울특별시 중구 태평로1가 31",
+			Zipcode:                  "04524",
+			EnglishAddress:           "110 Sejong-daero, Jung-gu, Seoul",
+			BuildingManagementNumber: "1114010300100310000000001",
+		},
+	}}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{Normalizer: normalizer})
+
+	t.Run("geocodes the official road address", func(t *testing.T) {
+		result, err := svc.Geocode(context.Background(), "서울특별시 중구 태평로1가 31", "PARCEL")
+
+		require.NoError(t, err)
+		require.True(t, result.Success)
+		assert.InDelta(t, 37.566535, result.Coordinate.Latitude, 1e-6)
+		require.NotNil(t, result.AddressDetail)
+		assert.Equal(t, "서울특별시 중구 세종대로 110 (태평로1가)", result.AddressDetail.RoadAddress)
+		assert.Equal(t, "04524", result.AddressDetail.Zipcode)
+		assert.Equal(t, "110 Sejong-daero, Jung-gu, Seoul", result.AddressDetail.EnglishAddress)
+		assert.Equal(t, "1114010300100310000000001", result.AddressDetail.BuildingManagementNumber)
+	})
+
+	t.Run("falls back to the input address", func(t *testing.T) {
+		result, err := svc.Geocode(context.Background(), "서울특별시 송파구 올림픽로 25", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.InDelta(t, 37.5152, result.Coordinate.Latitude, 1e-6)
+	})
+}
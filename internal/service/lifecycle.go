@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/oursportsnation/k-geocode/internal/cache"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+)
+
+// Closer 종료 시 정리가 필요한 구성 요소 (io.Closer와 같되 ctx로 제한 시간을 받음)
+type Closer interface {
+	Close(ctx context.Context) error
+}
+
+// CloserFunc 함수를 Closer로 사용하기 위한 어댑터
+type CloserFunc func(ctx context.Context) error
+
+// Close f(ctx) 호출
+func (f CloserFunc) Close(ctx context.Context) error {
+	return f(ctx)
+}
+
+// IOCloser io.Closer를 Closer로 변환 (ctx는 무시하며, 제한 시간은 Lifecycle.Shutdown이 적용)
+func IOCloser(c io.Closer) Closer {
+	return CloserFunc(func(context.Context) error { return c.Close() })
+}
+
+// Lifecycle 종료 시 정리할 구성 요소 목록
+// Shutdown은 등록 순서대로 구성 요소를 한 번씩만 닫는다
+type Lifecycle struct {
+	mu       sync.Mutex
+	closers  []namedCloser
+	shutdown bool
+}
+
+type namedCloser struct {
+	name   string
+	closer Closer
+}
+
+// Register 구성 요소 등록 (Shutdown 이후 등록한 것은 닫지 않는다)
+func (l *Lifecycle) Register(name string, c Closer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closers = append(l.closers, namedCloser{name: name, closer: c})
+}
+
+// Shutdown 등록 순서대로 구성 요소를 닫고 에러를 모아 반환 (errors.Join)
+// ctx가 끝나면 닫는 중인 구성 요소를 기다리지 않고, 남은 구성 요소는 닫지 않은 채 ctx 에러로 보고한다
+// 두 번째 호출부터는 아무것도 하지 않고 nil을 반환한다
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	if l.shutdown {
+		l.mu.Unlock()
+		return nil
+	}
+	l.shutdown = true
+	closers := l.closers
+	l.mu.Unlock()
+
+	var errs []error
+	for _, c := range closers {
+		if err := closeWithin(ctx, c.closer); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// closeWithin c를 닫되 ctx가 먼저 끝나면 기다리지 않고 ctx 에러 반환
+func closeWithin(ctx context.Context, c Closer) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() { done <- c.Close(ctx) }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// NewLifecycle 지오코딩 서비스와 구성 요소의 종료 순서 구성
+// 진행 중인 요청을 먼저 기다린 뒤 Provider, 캐시, HTTP 클라이언트 순으로 닫는다
+// Provider와 캐시는 io.Closer를 구현한 경우에만 등록하며, cache와 httpClient는 nil이어도 된다
+func NewLifecycle(svc *GeocodingService, providers []provider.GeocodingProvider, c cache.Cache, httpClient *httpclient.Client) *Lifecycle {
+	l := &Lifecycle{}
+	l.Register("geocoding service", CloserFunc(svc.Drain))
+	for _, p := range providers {
+		if closer, ok := p.(io.Closer); ok {
+			l.Register("provider "+p.Name(), IOCloser(closer))
+		}
+	}
+	if closer, ok := c.(io.Closer); ok {
+		l.Register("cache", IOCloser(closer))
+	}
+	if httpClient != nil {
+		l.Register("http client", IOCloser(httpClient))
+	}
+	return l
+}
+
+// inFlight 진행 중인 요청 수 (종료 시 Drain이 모두 끝나기를 기다림)
+type inFlight struct {
+	mu   sync.Mutex
+	n    int
+	idle chan struct{} // n이 0이 되면 닫힘 (n이 0일 때는 nil)
+}
+
+// track 요청 시작을 기록하고 끝날 때 호출할 함수 반환
+func (f *inFlight) track() func() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n++
+	if f.n == 1 {
+		f.idle = make(chan struct{})
+	}
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.n--
+		if f.n == 0 {
+			close(f.idle)
+			f.idle = nil
+		}
+	}
+}
+
+// wait 진행 중인 요청이 없을 때까지 대기 (ctx가 먼저 끝나면 ctx 에러)
+func (f *inFlight) wait(ctx context.Context) error {
+	f.mu.Lock()
+	idle := f.idle
+	f.mu.Unlock()
+	if idle == nil {
+		return nil
+	}
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Drain 진행 중인 지오코딩·역지오코딩 요청(배치 포함)이 모두 끝날 때까지 대기
+// 새 요청을 막지는 않으므로 HTTP 서버 등 요청을 받는 쪽을 먼저 닫은 뒤 호출한다
+func (s *GeocodingService) Drain(ctx context.Context) error {
+	return s.inFlight.wait(ctx)
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/cache"
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/oursportsnation/k-geocode/pkg/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// closeRecorder 닫힌 순서를 기록하는 Closer 모음
+type closeRecorder struct {
+	mu     sync.Mutex
+	closed []string
+}
+
+func (r *closeRecorder) closer(name string, err error) Closer {
+	return CloserFunc(func(context.Context) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.closed = append(r.closed, name)
+		return err
+	})
+}
+
+func TestLifecycle_Shutdown_ClosesEachOnceInOrder(t *testing.T) {
+	rec := &closeRecorder{}
+	l := &Lifecycle{}
+	l.Register("a", rec.closer("a", nil))
+	l.Register("b", rec.closer("b", nil))
+	l.Register("c", rec.closer("c", nil))
+
+	require.NoError(t, l.Shutdown(context.Background()))
+	require.NoError(t, l.Shutdown(context.Background()), "두 번째 호출은 아무것도 하지 않음")
+
+	assert.Equal(t, []string{"a", "b", "c"}, rec.closed)
+}
+
+func TestLifecycle_Shutdown_JoinsErrors(t *testing.T) {
+	errCache := errors.New("cache close failed")
+	errProvider := errors.New("provider close failed")
+	rec := &closeRecorder{}
+	l := &Lifecycle{}
+	l.Register("provider", rec.closer("provider", errProvider))
+	l.Register("cache", rec.closer("cache", errCache))
+	l.Register("http client", rec.closer("http client", nil))
+
+	err := l.Shutdown(context.Background())
+
+	assert.ErrorIs(t, err, errProvider)
+	assert.ErrorIs(t, err, errCache)
+	assert.ErrorContains(t, err, "cache: cache close failed")
+	assert.Equal(t, []string{"provider", "cache", "http client"}, rec.closed, "에러가 나도 나머지를 닫음")
+}
+
+func TestLifecycle_Shutdown_Timeout(t *testing.T) {
+	rec := &closeRecorder{}
+	block := make(chan struct{})
+	defer close(block)
+	l := &Lifecycle{}
+	l.Register("slow", CloserFunc(func(context.Context) error {
+		<-block
+		return nil
+	}))
+	l.Register("after", rec.closer("after", nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := l.Shutdown(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "slow:")
+	assert.ErrorContains(t, err, "after:")
+	assert.Empty(t, rec.closed, "제한 시간이 지나면 남은 구성 요소는 닫지 않음")
+}
+
+// closingProvider 닫힌 횟수를 기록하는 Provider (io.Closer)
+type closingProvider struct {
+	mockProvider
+	closes int
+}
+
+func (p *closingProvider) Close() error {
+	p.closes++
+	return nil
+}
+
+// closingCache 닫힌 횟수를 기록하는 캐시 (io.Closer)
+type closingCache struct {
+	cache.Cache
+	closes int
+}
+
+func (c *closingCache) Close() error {
+	c.closes++
+	return nil
+}
+
+func TestNewLifecycle_ClosesComponentsOnce(t *testing.T) {
+	closing := &closingProvider{mockProvider: mockProvider{name: "vWorld", available: true}}
+	plain := &mockProvider{name: "Kakao", available: true}
+	c := &closingCache{Cache: cache.NewMemory(time.Minute, 10)}
+	providers := []provider.GeocodingProvider{closing, plain}
+	svc := NewGeocodingServiceWithOptions(providers, zap.NewNop(), Options{Cache: c})
+
+	l := NewLifecycle(svc, providers, c, httpclient.NewClient(0))
+	require.NoError(t, l.Shutdown(context.Background()))
+	require.NoError(t, l.Shutdown(context.Background()))
+
+	assert.Equal(t, 1, closing.closes)
+	assert.Equal(t, 1, c.closes)
+	names := make([]string, len(l.closers))
+	for i, nc := range l.closers {
+		names[i] = nc.name
+	}
+	assert.Equal(t, []string{"geocoding service", "provider vWorld", "cache", "http client"}, names)
+}
+
+func TestGeocodingService_Drain_WaitsForInFlight(t *testing.T) {
+	p := &gatedProvider{
+		mockProvider: mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{
+			Success:    true,
+			Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.9780},
+		}},
+		release: make(chan struct{}),
+	}
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{})
+	require.NoError(t, svc.Drain(context.Background()), "진행 중인 요청이 없으면 바로 반환")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := svc.Geocode(context.Background(), "서울특별시 중구 세종대로 110", "")
+		assert.NoError(t, err)
+	}()
+	require.Eventually(t, func() bool { return p.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, svc.Drain(ctx), context.DeadlineExceeded, "요청이 끝나기 전에는 기다림")
+
+	close(p.release)
+	require.NoError(t, svc.Drain(context.Background()))
+	<-done
+}
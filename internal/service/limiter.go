@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+)
+
+// Limiter Provider 호출 속도 제한 인터페이스
+// golang.org/x/time/rate.Limiter가 이 인터페이스를 만족한다
+type Limiter interface {
+	// Wait 토큰을 얻을 때까지 대기 (컨텍스트 만료 시 에러 반환)
+	Wait(ctx context.Context) error
+}
+
+// waitForToken 전역 및 Provider별 Limiter에서 호출 토큰 획득
+// 단건/배치 호출 모두 이 경로를 거치므로 동일한 속도 제한이 공유된다
+func (s *GeocodingService) waitForToken(ctx context.Context, providerName string) error {
+	if s.options.Limiter != nil {
+		if err := s.options.Limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if limiter, ok := s.options.ProviderLimiters[providerName]; ok && limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// timingProvider 호출 시각을 기록하는 동시성 안전 Mock Provider
+type timingProvider struct {
+	mockProvider
+	mu    sync.Mutex
+	calls []time.Time
+}
+
+func (p *timingProvider) Geocode(ctx context.Context, address string) (*model.ProviderResult, error) {
+	p.mu.Lock()
+	p.calls = append(p.calls, time.Now())
+	p.mu.Unlock()
+	return p.result, p.err
+}
+
+func (p *timingProvider) callTimes() []time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]time.Time(nil), p.calls...)
+}
+
+func newTimingProvider(name string) *timingProvider {
+	return &timingProvider{
+		mockProvider: mockProvider{
+			name:      name,
+			available: true,
+			result: &model.ProviderResult{
+				Success:    true,
+				Coordinate: model.Coordinate{Latitude: 37.5665, Longitude: 126.978},
+			},
+		},
+	}
+}
+
+// assertRateBounded 임의의 구간에서 호출 수가 burst + rps*구간 을 넘지 않는지 확인
+func assertRateBounded(t *testing.T, calls []time.Time, rps float64, burst int) {
+	t.Helper()
+	const tolerance = 10 * time.Millisecond
+
+	for i := range calls {
+		for j := i; j < len(calls); j++ {
+			window := calls[j].Sub(calls[i]) + tolerance
+			allowed := burst + int(rps*window.Seconds())
+			assert.LessOrEqualf(t, j-i+1, allowed,
+				"%d calls within %v exceeds rate %.0f/s (burst %d)", j-i+1, window, rps, burst)
+		}
+	}
+}
+
+func TestGeocodingService_Geocode_ConcurrentCallsShareLimiter(t *testing.T) {
+	p := newTimingProvider("MockProvider")
+	const rps, burst, callers = 100.0, 1, 20
+
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		Limiter: rate.NewLimiter(rate.Limit(rps), burst),
+	})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// 주소가 같으면 호출이 하나로 합쳐지므로 서로 다른 주소 사용
+			result, err := svc.Geocode(context.Background(), fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1), "")
+			assert.NoError(t, err)
+			assert.True(t, result.Success)
+		}()
+	}
+	wg.Wait()
+
+	calls := p.callTimes()
+	require.Len(t, calls, callers)
+	// burst 1, 100 req/s 에서 20건은 최소 190ms 소요
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+	assertRateBounded(t, calls, rps, burst)
+}
+
+func TestGeocodingService_Geocode_ProviderLimiter(t *testing.T) {
+	limited := newTimingProvider("Limited")
+	const rps, burst, callers = 50.0, 2, 10
+
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{limited}, zap.NewNop(), Options{
+		ProviderLimiters: map[string]Limiter{
+			"Limited": rate.NewLimiter(rate.Limit(rps), burst),
+		},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			svc.Geocode(context.Background(), fmt.Sprintf("서울특별시 중구 세종대로 %d", i+1), "")
+		}()
+	}
+	wg.Wait()
+
+	calls := limited.callTimes()
+	require.Len(t, calls, callers)
+	assertRateBounded(t, calls, rps, burst)
+}
+
+func TestGeocodingService_Geocode_LimiterRespectsContextDeadline(t *testing.T) {
+	p := newTimingProvider("MockProvider")
+	// 초당 1건, 첫 토큰 소진 후 다음 토큰은 1초 뒤
+	limiter := rate.NewLimiter(rate.Limit(1), 1)
+	require.True(t, limiter.Allow())
+
+	svc := NewGeocodingServiceWithOptions([]provider.GeocodingProvider{p}, zap.NewNop(), Options{
+		Limiter: limiter,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	result, err := svc.Geocode(ctx, "서울특별시 중구 세종대로 110", "")
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	require.Len(t, result.Attempts, 1)
+	assert.Contains(t, result.Attempts[0].Error, "rate limiter")
+	assert.Empty(t, p.callTimes())
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/oursportsnation/k-geocode/internal/provider"
+
+	"go.uber.org/zap"
+)
+
+// ErrInvalidAPIKey Provider가 API 키를 거부함 (ValidateProviders)
+var ErrInvalidAPIKey = errors.New("API key rejected")
+
+// validationAddress API 키 확인에 사용하는 주소 (결과 유무와 관계없이 인증 실패 여부만 본다)
+const validationAddress = "서울특별시 중구 세종대로 110"
+
+// ValidateProviders Provider마다 지오코딩을 한 번 호출해 API 키가 받아들여지는지 확인
+// 인증에 실패한 Provider는 비활성화하고, Provider 이름과 ErrInvalidAPIKey를 감싼 에러를 모아 반환한다
+// 네트워크 오류나 결과 없음은 키 문제로 보지 않으며, 이미 비활성화된 Provider는 호출하지 않는다
+// 확인한 Provider가 모두 인증에 실패하면 반환 에러는 ErrNoProvidersAvailable도 감싼다
+func (s *GeocodingService) ValidateProviders(ctx context.Context) error {
+	var errs []error
+	checked := 0
+	for _, p := range s.providers {
+		if p.IsDisabled() {
+			continue
+		}
+		checked++
+
+		_, err := p.Geocode(ctx, validationAddress)
+		ce, ok := provider.IsClassifiedError(err)
+		if !ok || ce.Type != provider.ErrorTypeUnauthorized {
+			if err != nil {
+				s.log(ctx).Warn("Provider key validation inconclusive",
+					zap.String("provider", p.Name()),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+
+		p.Disable(fmt.Sprintf("Authentication failed: %s", err.Error()))
+		s.log(ctx).Error("Provider disabled: API key rejected on validation",
+			zap.String("provider", p.Name()),
+			zap.Error(err),
+		)
+		errs = append(errs, fmt.Errorf("%s: %w: %s", p.Name(), ErrInvalidAPIKey, ce.Message))
+	}
+
+	if len(errs) > 0 && len(errs) == checked {
+		errs = append(errs, ErrNoProvidersAvailable)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oursportsnation/k-geocode/internal/model"
+	"github.com/oursportsnation/k-geocode/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGeocodingService_ValidateProviders(t *testing.T) {
+	unauthorized := func() error {
+		return provider.NewClassifiedError(provider.ErrorTypeUnauthorized, "Invalid API key", provider.ErrAPIKeyInvalid)
+	}
+
+	t.Run("all keys accepted", func(t *testing.T) {
+		vworld := &mockProvider{name: "vWorld", available: true, result: &model.ProviderResult{Success: true}}
+		kakao := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{Success: false}}
+		svc := NewGeocodingService([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop())
+
+		assert.NoError(t, svc.ValidateProviders(context.Background()))
+		assert.False(t, vworld.IsDisabled())
+		assert.False(t, kakao.IsDisabled())
+	})
+
+	t.Run("one key rejected", func(t *testing.T) {
+		vworld := &mockProvider{name: "vWorld", available: true, err: unauthorized()}
+		kakao := &mockProvider{name: "Kakao", available: true, result: &model.ProviderResult{Success: true}}
+		svc := NewGeocodingService([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop())
+
+		err := svc.ValidateProviders(context.Background())
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		assert.NotErrorIs(t, err, ErrNoProvidersAvailable)
+		assert.Contains(t, err.Error(), "vWorld")
+		assert.NotContains(t, err.Error(), "Kakao")
+		assert.True(t, vworld.IsDisabled())
+		assert.False(t, kakao.IsDisabled())
+	})
+
+	t.Run("every key rejected", func(t *testing.T) {
+		vworld := &mockProvider{name: "vWorld", available: true, err: unauthorized()}
+		kakao := &mockProvider{name: "Kakao", available: true, err: unauthorized()}
+		svc := NewGeocodingService([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop())
+
+		err := svc.ValidateProviders(context.Background())
+
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		assert.ErrorIs(t, err, ErrNoProvidersAvailable)
+	})
+
+	t.Run("other errors are not key errors", func(t *testing.T) {
+		vworld := &mockProvider{name: "vWorld", available: true, err: provider.NewClassifiedError(provider.ErrorTypeTimeout, "timeout", nil)}
+		kakao := &mockProvider{name: "Kakao", available: true, err: errors.New("connection refused")}
+		svc := NewGeocodingService([]provider.GeocodingProvider{vworld, kakao}, zap.NewNop())
+
+		assert.NoError(t, svc.ValidateProviders(context.Background()))
+		assert.False(t, vworld.IsDisabled())
+		assert.False(t, kakao.IsDisabled())
+	})
+}
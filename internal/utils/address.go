@@ -0,0 +1,313 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AddressType 주소 유형 (도로명/지번)
+type AddressType string
+
+const (
+	AddressTypeUnknown AddressType = ""       // 판별 불가
+	AddressTypeRoad    AddressType = "ROAD"   // 도로명 주소
+	AddressTypeParcel  AddressType = "PARCEL" // 지번 주소
+)
+
+// ErrInvalidAddressType ROAD, PARCEL이 아닌 주소 타입
+var ErrInvalidAddressType = errors.New("invalid address type")
+
+// NormalizeAddressType 요청의 주소 타입 값 검증 후 정규화 (앞뒤 공백 제거, 대소문자 무시)
+// "road", " ROAD " → AddressTypeRoad, "parcel" → AddressTypeParcel, 빈 값은 AddressTypeUnknown(자동 판별)
+// 그 밖의 값은 ErrInvalidAddressType을 감싼 에러를 반환한다
+func NormalizeAddressType(s string) (AddressType, error) {
+	switch t := AddressType(strings.ToUpper(strings.TrimSpace(s))); t {
+	case AddressTypeUnknown, AddressTypeRoad, AddressTypeParcel:
+		return t, nil
+	}
+	return AddressTypeUnknown, fmt.Errorf("%w %q (must be %s or %s)", ErrInvalidAddressType, s, AddressTypeRoad, AddressTypeParcel)
+}
+
+var (
+	// 도로명: "세종대로", "효원로241번길" 처럼 로/길로 끝나는 토큰
+	roadNameToken = regexp.MustCompile(`^\S+(로|길)$`)
+	// 도로명 + 건물번호가 붙어있는 토큰: "세종대로110", "테헤란로152-1"
+	roadNameWithNumberToken = regexp.MustCompile(`^\S+(로|길)\d+(-\d+)?$`)
+	// 건물번호: "110", "152-1"
+	buildingNumberToken = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+	// 지번 지역명: "역삼동", "고내리", "태평로1가" 처럼 동/리/가로 끝나는 토큰
+	parcelRegionToken = regexp.MustCompile(`^\S+(동|리|\d가)$`)
+	// 지번: "737", "1411-1", "산12-3", "31번지"
+	parcelNumberToken = regexp.MustCompile(`^(산)?\d+(-\d+)?(번지)?$`)
+)
+
+// DetectAddressType 주소 문자열로 도로명/지번 주소 여부를 추정
+//
+// 판별 규칙:
+//   - "번지"가 포함되면 지번
+//   - 로/길로 끝나는 토큰 뒤에 건물번호가 오면 도로명 (지하 표기 허용)
+//   - 동/리/N가로 끝나는 토큰 뒤에 번지(산 포함)가 오면 지번
+//   - 두 패턴이 모두 나타나면 주소 끝에 더 가까운 쪽을 선택
+//
+// 판별할 수 없으면 AddressTypeUnknown 반환
+func DetectAddressType(address string) AddressType {
+	tokens := SplitAddress(NormalizeAddress(address))
+	if len(tokens) == 0 {
+		return AddressTypeUnknown
+	}
+
+	if strings.Contains(address, "번지") {
+		return AddressTypeParcel
+	}
+
+	roadPos, parcelPos := -1, -1
+	for i, token := range tokens {
+		next := ""
+		if i+1 < len(tokens) {
+			next = tokens[i+1]
+		}
+		// "강남대로 지하 396" 처럼 지하 표기 건너뛰기
+		if next == "지하" && i+2 < len(tokens) {
+			next = tokens[i+2]
+		}
+
+		switch {
+		case roadNameWithNumberToken.MatchString(token):
+			roadPos = i
+		case roadNameToken.MatchString(token) && buildingNumberToken.MatchString(next):
+			roadPos = i
+		}
+
+		if parcelRegionToken.MatchString(token) {
+			if parcelNumberToken.MatchString(next) {
+				parcelPos = i
+			} else if next == "산" && i+2 < len(tokens) && parcelNumberToken.MatchString(tokens[i+2]) {
+				parcelPos = i
+			}
+		}
+	}
+
+	switch {
+	case roadPos < 0 && parcelPos < 0:
+		return AddressTypeUnknown
+	case roadPos > parcelPos:
+		return AddressTypeRoad
+	default:
+		return AddressTypeParcel
+	}
+}
+
+// SplitTrailingBuildingName 건물번호/번지 뒤에 붙은 건물명을 분리
+// "서울특별시 중구 세종대로 110 서울특별시청" → ("서울특별시 중구 세종대로 110", "서울특별시청")
+// 마지막 건물번호/번지 뒤의 토큰에 숫자가 없을 때만 건물명으로 보며, 분리할 것이 없으면 name은 빈 문자열
+func SplitTrailingBuildingName(address string) (base, name string) {
+	tokens := SplitAddress(NormalizeAddress(address))
+
+	numberPos := -1
+	for i, token := range tokens {
+		if buildingNumberToken.MatchString(token) || parcelNumberToken.MatchString(token) || roadNameWithNumberToken.MatchString(token) {
+			numberPos = i
+		}
+	}
+	if numberPos < 0 || numberPos == len(tokens)-1 {
+		return address, ""
+	}
+	for _, token := range tokens[numberPos+1:] {
+		if strings.ContainsAny(token, "0123456789") {
+			return address, ""
+		}
+	}
+
+	return strings.Join(tokens[:numberPos+1], " "), strings.Join(tokens[numberPos+1:], " ")
+}
+
+// MatchLevel 지오코딩 결과의 정밀도
+type MatchLevel string
+
+const (
+	MatchLevelRooftop MatchLevel = "ROOFTOP" // 전체 주소 (건물번호/번지까지) 일치
+	MatchLevelStreet  MatchLevel = "STREET"  // 도로명 또는 동/리까지 일치 (건물번호/번지 제외)
+	MatchLevelRegion  MatchLevel = "REGION"  // 행정구역 (시/군/구, 시/도) 일치
+)
+
+// CoarseAddress 원래 주소보다 덜 구체적인 주소 후보
+type CoarseAddress struct {
+	Address string
+	Level   MatchLevel
+}
+
+var (
+	// 행정구역: "서울특별시", "성남시", "양평군", "분당구", "경기도"
+	districtToken = regexp.MustCompile(`^\S+(시|군|구|도)$`)
+	// 도로명에 붙은 건물번호: "세종대로110"의 "110"
+	trailingBuildingNumber = regexp.MustCompile(`\d+(-\d+)?$`)
+)
+
+// CoarserAddresses 주소를 점점 덜 구체적인 형태로 줄인 후보 목록 반환 (구체적인 순)
+//
+// 줄이는 순서:
+//   - 건물번호/번지 제거: "서울특별시 중구 세종대로 110" → "서울특별시 중구 세종대로" (STREET)
+//   - 도로명/동/리 제거: → "서울특별시 중구" (REGION)
+//   - 구/군/시 제거: → "서울특별시" (REGION)
+//
+// 더 줄일 수 없으면 빈 목록 반환
+func CoarserAddresses(address string) []CoarseAddress {
+	tokens := SplitAddress(NormalizeAddress(address))
+	full := strings.Join(tokens, " ")
+
+	var candidates []CoarseAddress
+	add := func(coarse string, level MatchLevel) {
+		if coarse == "" || coarse == full {
+			return
+		}
+		if n := len(candidates); n > 0 && candidates[n-1].Address == coarse {
+			return
+		}
+		candidates = append(candidates, CoarseAddress{Address: coarse, Level: level})
+	}
+
+	// 1단계: 마지막 도로명 또는 동/리 토큰 뒤의 건물번호/번지 제거
+	streetPos := -1
+	for i, token := range tokens {
+		if roadNameWithNumberToken.MatchString(token) || roadNameToken.MatchString(token) || parcelRegionToken.MatchString(token) {
+			streetPos = i
+		}
+	}
+	if streetPos >= 0 {
+		street := append([]string{}, tokens[:streetPos+1]...)
+		// "세종대로110" → "세종대로"
+		if roadNameWithNumberToken.MatchString(street[streetPos]) {
+			street[streetPos] = trailingBuildingNumber.ReplaceAllString(street[streetPos], "")
+		}
+		add(strings.Join(street, " "), MatchLevelStreet)
+	}
+
+	// 2단계: 도로명/동/리 앞의 행정구역부터 상위 행정구역 순으로
+	limit := len(tokens)
+	if streetPos >= 0 {
+		limit = streetPos
+	}
+	for i := limit - 1; i >= 0; i-- {
+		if districtToken.MatchString(tokens[i]) {
+			add(strings.Join(tokens[:i+1], " "), MatchLevelRegion)
+		}
+	}
+
+	return candidates
+}
+
+var (
+	// 공동주택 동: "101동", "제101동", "A동" (법정동 "역삼동"과 구분하기 위해 숫자/영문만 허용)
+	buildingDongToken = regexp.MustCompile(`^제?([0-9A-Za-z]+동)$`)
+	// 호수: "1502호", "제1502호", "B101호"
+	buildingUnitToken = regexp.MustCompile(`^제?([0-9A-Za-z]+(-[0-9]+)?호)$`)
+	// 동/호가 붙어있는 토큰: "101동1502호"
+	buildingDongUnitToken = regexp.MustCompile(`^제?([0-9A-Za-z]+동)제?([0-9A-Za-z]+(-[0-9]+)?호)$`)
+)
+
+// SplitBuildingUnit 주소 끝의 공동주택 동/호 표기를 분리
+// "서울특별시 송파구 올림픽로 135 잠실엘스 101동 1502호" → ("서울특별시 송파구 올림픽로 135 잠실엘스", "101동", "1502호")
+// 동/호가 없으면 원본 주소와 빈 값 반환
+func SplitBuildingUnit(address string) (base, dong, unit string) {
+	tokens := SplitAddress(address)
+	end := len(tokens)
+
+	if end > 1 {
+		if m := buildingDongUnitToken.FindStringSubmatch(tokens[end-1]); m != nil {
+			return strings.TrimRight(strings.Join(tokens[:end-1], " "), ","), m[1], m[2]
+		}
+		if m := buildingUnitToken.FindStringSubmatch(tokens[end-1]); m != nil {
+			unit = m[1]
+			end--
+		}
+	}
+	if end > 1 {
+		if m := buildingDongToken.FindStringSubmatch(tokens[end-1]); m != nil {
+			dong = m[1]
+			end--
+		}
+	}
+	if dong == "" && unit == "" {
+		return address, "", ""
+	}
+
+	// "세종대로 110, 101동" 처럼 구분용 쉼표 제거
+	base = strings.TrimRight(strings.Join(tokens[:end], " "), ",")
+	return base, dong, unit
+}
+
+// AddressComponents 주소 문자열을 나눈 구성 요소 (찾지 못한 요소는 빈 값)
+type AddressComponents struct {
+	Sido         string // 시·도 공식 명칭 (약칭은 확장, 예: "서울" → "서울특별시")
+	Sigungu      string // 시·군·구, 일반구 포함 (예: "성남시 분당구")
+	EupMyeonDong string // 읍·면·동·리 (예: "애월읍 고내리", 지번 주소와 "용봉동 용봉로"처럼 함께 쓴 경우)
+	RoadName     string // 도로명 (예: "세종대로", "중앙로1275번길")
+	BuildingNo   string // 도로명 주소 건물번호 (예: "110", "38-10")
+	LotNo        string // 지번 (예: "737", "산12-3")
+	Underground  bool   // 지하 건물 여부 (예: "강남대로 지하 396")
+	BuildingName string // 건물번호·번지 뒤의 건물명
+	BuildingDong string // 공동주택 동 (예: "101동")
+	BuildingUnit string // 공동주택 호 (예: "1502호")
+}
+
+// eupMyeonToken 읍·면: "애월읍", "대관령면"
+var eupMyeonToken = regexp.MustCompile(`^\S+(읍|면)$`)
+
+// ParseAddressComponents 주소를 시·도, 시·군·구, 읍·면·동, 도로명, 건물번호/지번, 건물명, 동/호로 분리
+// 외부 호출 없이 토큰 패턴만으로 나누므로 실제로 존재하는 주소인지는 확인하지 않는다
+// (예: "서울 중구 세종대로 110 서울특별시청" → 서울특별시 / 중구 / 세종대로 / 110 / 서울특별시청)
+func ParseAddressComponents(address string) AddressComponents {
+	var c AddressComponents
+
+	base := StripParentheses(NormalizeAddress(address))
+	base, c.BuildingDong, c.BuildingUnit = SplitBuildingUnit(base)
+	base, c.BuildingName = SplitTrailingBuildingName(base)
+
+	tokens := SplitAddress(base)
+	if c.Sido = LeadingSido(base); c.Sido != "" {
+		tokens = tokens[1:]
+	}
+
+	// 시·도 바로 뒤에 이어지는 시·군·구
+	var sigungu []string
+	for len(tokens) > 0 && districtToken.MatchString(tokens[0]) {
+		sigungu = append(sigungu, tokens[0])
+		tokens = tokens[1:]
+	}
+	c.Sigungu = strings.Join(sigungu, " ")
+
+	var eupMyeonDong []string
+	mountain := false
+	for _, token := range tokens {
+		number := strings.TrimSuffix(token, "번지")
+		switch {
+		case roadNameWithNumberToken.MatchString(token):
+			c.BuildingNo = trailingBuildingNumber.FindString(token)
+			c.RoadName = strings.TrimSuffix(token, c.BuildingNo)
+		case c.RoadName != "" && c.BuildingNo == "" && roadNameToken.MatchString(token) && token[0] >= '0' && token[0] <= '9':
+			// "중앙로 1275번길" 처럼 띄어 쓴 번길
+			c.RoadName += token
+		case roadNameToken.MatchString(token):
+			c.RoadName, c.BuildingNo = token, ""
+		case parcelRegionToken.MatchString(token), eupMyeonToken.MatchString(token):
+			eupMyeonDong = append(eupMyeonDong, token)
+		case token == "지하":
+			c.Underground = true
+		case token == "산":
+			mountain = true
+		case c.RoadName != "" && c.BuildingNo == "" && buildingNumberToken.MatchString(token):
+			c.BuildingNo = token
+		case len(eupMyeonDong) > 0 && c.LotNo == "" && parcelNumberToken.MatchString(token):
+			if mountain && !strings.HasPrefix(number, "산") {
+				number = "산" + number
+			}
+			c.LotNo = number
+		}
+	}
+	c.EupMyeonDong = strings.Join(eupMyeonDong, " ")
+
+	return c
+}
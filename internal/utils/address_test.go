@@ -0,0 +1,271 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectAddressType(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected AddressType
+	}{
+		// 도로명 주소
+		{"road with building number", "서울특별시 중구 세종대로 110", AddressTypeRoad},
+		{"road with sub number", "서울특별시 강남구 테헤란로 152-1", AddressTypeRoad},
+		{"road number attached", "서울 중구 세종대로110", AddressTypeRoad},
+		{"road with 번길", "경기도 고양시 일산동구 중앙로1275번길 38-10", AddressTypeRoad},
+		{"road with spaced 번길", "경기도 고양시 일산동구 중앙로 1275번길 38-10", AddressTypeRoad},
+		{"underground road", "서울특별시 강남구 강남대로 지하 396", AddressTypeRoad},
+		{"road with apartment unit", "서울특별시 송파구 올림픽로 300 101동 1502호", AddressTypeRoad},
+		{"road name containing 동", "서울특별시 중구 명동길 14", AddressTypeRoad},
+		{"sejong road", "세종특별자치시 한누리대로 2130", AddressTypeRoad},
+
+		// 지번 주소
+		{"dong with number", "서울특별시 강남구 역삼동 737", AddressTypeParcel},
+		{"dong with sub number", "부산광역시 해운대구 우동 1411-1", AddressTypeParcel},
+		{"ri with number", "제주특별자치도 제주시 애월읍 고내리 123", AddressTypeParcel},
+		{"mountain parcel spaced", "강원특별자치도 평창군 대관령면 횡계리 산 1-1", AddressTypeParcel},
+		{"mountain parcel attached", "경상북도 울릉군 울릉읍 도동리 산12", AddressTypeParcel},
+		{"ga region with 로 in name", "서울특별시 중구 태평로1가 31", AddressTypeParcel},
+		{"ga region in 동성로", "대구광역시 중구 동성로2가 88", AddressTypeParcel},
+		{"explicit 번지", "서울시 종로구 관철동 45번지", AddressTypeParcel},
+
+		// 모호하거나 판별 불가
+		{"both forms, road last", "광주광역시 북구 용봉동 용봉로 77", AddressTypeRoad},
+		{"both forms, parcel last", "서울특별시 중구 세종대로 태평로1가 31", AddressTypeParcel},
+		{"region only", "서울특별시 강남구 역삼동", AddressTypeUnknown},
+		{"road name without number", "서울특별시 중구 세종대로", AddressTypeUnknown},
+		{"district ending in 로 without number", "서울특별시 종로구", AddressTypeUnknown},
+		{"place name", "잠실종합운동장", AddressTypeUnknown},
+		{"empty", "", AddressTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectAddressType(tt.input))
+		})
+	}
+}
+
+func TestCoarserAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []CoarseAddress
+	}{
+		{
+			name:  "road address",
+			input: "서울특별시 중구 세종대로 110",
+			expected: []CoarseAddress{
+				{"서울특별시 중구 세종대로", MatchLevelStreet},
+				{"서울특별시 중구", MatchLevelRegion},
+				{"서울특별시", MatchLevelRegion},
+			},
+		},
+		{
+			name:  "road name with attached building number",
+			input: "서울특별시 중구 세종대로110",
+			expected: []CoarseAddress{
+				{"서울특별시 중구 세종대로", MatchLevelStreet},
+				{"서울특별시 중구", MatchLevelRegion},
+				{"서울특별시", MatchLevelRegion},
+			},
+		},
+		{
+			name:  "parcel address with 시 and 구",
+			input: "경기도 성남시 분당구 삼평동 681",
+			expected: []CoarseAddress{
+				{"경기도 성남시 분당구 삼평동", MatchLevelStreet},
+				{"경기도 성남시 분당구", MatchLevelRegion},
+				{"경기도 성남시", MatchLevelRegion},
+				{"경기도", MatchLevelRegion},
+			},
+		},
+		{
+			name:  "building name after number",
+			input: "서울특별시 강남구 테헤란로 152 강남파이낸스센터",
+			expected: []CoarseAddress{
+				{"서울특별시 강남구 테헤란로", MatchLevelStreet},
+				{"서울특별시 강남구", MatchLevelRegion},
+				{"서울특별시", MatchLevelRegion},
+			},
+		},
+		{
+			name:  "already street level",
+			input: "서울특별시 중구 세종대로",
+			expected: []CoarseAddress{
+				{"서울특별시 중구", MatchLevelRegion},
+				{"서울특별시", MatchLevelRegion},
+			},
+		},
+		{name: "region only", input: "서울특별시", expected: nil},
+		{name: "unrecognized", input: "잠실종합운동장", expected: nil},
+		{name: "empty", input: "  ", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CoarserAddresses(tt.input))
+		})
+	}
+}
+
+func TestSplitTrailingBuildingName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantBase string
+		wantName string
+	}{
+		{"road address", "서울특별시 중구 세종대로 110 서울특별시청", "서울특별시 중구 세종대로 110", "서울특별시청"},
+		{"attached building number", "서울특별시 중구 세종대로110 서울특별시청", "서울특별시 중구 세종대로110", "서울특별시청"},
+		{"parcel address", "서울특별시 강남구 역삼동 737 강남 파이낸스센터", "서울특별시 강남구 역삼동 737", "강남 파이낸스센터"},
+		{"번지", "서울특별시 강남구 역삼동 737번지 강남파이낸스센터", "서울특별시 강남구 역삼동 737번지", "강남파이낸스센터"},
+		{"no building name", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110", ""},
+		{"trailing token with digits", "서울특별시 서초구 반포대로 275 101동", "서울특별시 서초구 반포대로 275 101동", ""},
+		{"no building number", "서울특별시 중구 세종대로 서울특별시청", "서울특별시 중구 세종대로 서울특별시청", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, name := SplitTrailingBuildingName(tt.input)
+			assert.Equal(t, tt.wantBase, base)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestSplitBuildingUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantBase string
+		wantDong string
+		wantUnit string
+	}{
+		{"dong and unit", "서울특별시 송파구 올림픽로 135 잠실엘스 101동 1502호", "서울특별시 송파구 올림픽로 135 잠실엘스", "101동", "1502호"},
+		{"with 제 prefix", "경기도 성남시 분당구 판교역로 235 제2동 제301호", "경기도 성남시 분당구 판교역로 235", "2동", "301호"},
+		{"attached", "서울특별시 강남구 압구정로 201 현대아파트 12동1104호", "서울특별시 강남구 압구정로 201 현대아파트", "12동", "1104호"},
+		{"comma separated", "부산광역시 해운대구 마린시티2로 33, 103동 4501호", "부산광역시 해운대구 마린시티2로 33", "103동", "4501호"},
+		{"unit only", "서울특별시 마포구 월드컵북로 396 B101호", "서울특별시 마포구 월드컵북로 396", "", "B101호"},
+		{"lettered dong only", "서울특별시 서초구 반포대로 275 A동", "서울특별시 서초구 반포대로 275", "A동", ""},
+		{"legal dong is kept", "서울특별시 강남구 역삼동 737", "서울특별시 강남구 역삼동 737", "", ""},
+		{"address ending with legal dong", "서울특별시 강남구 역삼동", "서울특별시 강남구 역삼동", "", ""},
+		{"no unit", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110", "", ""},
+		{"unit alone is not split", "1502호", "1502호", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, dong, unit := SplitBuildingUnit(tt.input)
+			assert.Equal(t, tt.wantBase, base)
+			assert.Equal(t, tt.wantDong, dong)
+			assert.Equal(t, tt.wantUnit, unit)
+		})
+	}
+}
+
+func TestParseAddressComponents(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected AddressComponents
+	}{
+		{
+			name:     "road address with building name",
+			input:    "서울 중구 세종대로 110 서울특별시청",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "중구", RoadName: "세종대로", BuildingNo: "110", BuildingName: "서울특별시청"},
+		},
+		{
+			name:     "road number attached",
+			input:    "서울특별시 중구 세종대로110",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "중구", RoadName: "세종대로", BuildingNo: "110"},
+		},
+		{
+			name:     "spaced 번길 with general district",
+			input:    "경기도 고양시 일산동구 중앙로 1275번길 38-10",
+			expected: AddressComponents{Sido: "경기도", Sigungu: "고양시 일산동구", RoadName: "중앙로1275번길", BuildingNo: "38-10"},
+		},
+		{
+			name:     "underground road",
+			input:    "서울특별시 강남구 강남대로 지하 396",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "강남구", RoadName: "강남대로", BuildingNo: "396", Underground: true},
+		},
+		{
+			name:     "apartment unit",
+			input:    "서울특별시 송파구 올림픽로 300 101동 1502호",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "송파구", RoadName: "올림픽로", BuildingNo: "300", BuildingDong: "101동", BuildingUnit: "1502호"},
+		},
+		{
+			name:     "parcel address with 번지",
+			input:    "서울시 종로구 관철동 45번지",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "종로구", EupMyeonDong: "관철동", LotNo: "45"},
+		},
+		{
+			name:     "parcel address with eup and ri",
+			input:    "제주특별자치도 제주시 애월읍 고내리 123",
+			expected: AddressComponents{Sido: "제주특별자치도", Sigungu: "제주시", EupMyeonDong: "애월읍 고내리", LotNo: "123"},
+		},
+		{
+			name:     "spaced mountain parcel",
+			input:    "강원특별자치도 평창군 대관령면 횡계리 산 1-1",
+			expected: AddressComponents{Sido: "강원특별자치도", Sigungu: "평창군", EupMyeonDong: "대관령면 횡계리", LotNo: "산1-1"},
+		},
+		{
+			name:     "ga region",
+			input:    "서울특별시 중구 태평로1가 31 (시청)",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "중구", EupMyeonDong: "태평로1가", LotNo: "31"},
+		},
+		{
+			name:     "region only",
+			input:    "서울특별시 강남구",
+			expected: AddressComponents{Sido: "서울특별시", Sigungu: "강남구"},
+		},
+		{
+			name:     "empty",
+			input:    "   ",
+			expected: AddressComponents{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseAddressComponents(tt.input))
+		})
+	}
+}
+
+func TestNormalizeAddressType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected AddressType
+	}{
+		{"ROAD", AddressTypeRoad},
+		{"road", AddressTypeRoad},
+		{"Road", AddressTypeRoad},
+		{" road ", AddressTypeRoad},
+		{"PARCEL", AddressTypeParcel},
+		{"parcel", AddressTypeParcel},
+		{"\tPARCEL\n", AddressTypeParcel},
+		{"", AddressTypeUnknown},
+		{"  ", AddressTypeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeAddressType(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	for _, invalid := range []string{"JIBUN", "roads", "도로명", "ROAD,PARCEL"} {
+		got, err := NormalizeAddressType(invalid)
+		assert.ErrorIs(t, err, ErrInvalidAddressType, invalid)
+		assert.ErrorContains(t, err, "must be ROAD or PARCEL")
+		assert.Equal(t, AddressTypeUnknown, got)
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import "strings"
+
+// legacyDistrict 폐지/개편된 행정구역명과 현재 명칭
+// from은 공백으로 구분된 토큰 단위로 비교한다 (부분 문자열은 치환하지 않음)
+type legacyDistrict struct {
+	from []string
+	to   string
+}
+
+// legacyDistricts 폐지/개편된 시·군·구 명칭 매핑 (개편 연도)
+// 같은 위치에서 여러 규칙이 일치하면 먼저 나온(더 구체적인) 규칙을 적용하므로
+// 여러 토큰으로 된 규칙을 단일 토큰 규칙보다 앞에 둔다
+var legacyDistricts = []legacyDistrict{
+	// 2010 마산·창원·진해 통합
+	{from: []string{"마산시", "합포구"}, to: "창원시 마산합포구"},
+	{from: []string{"마산시", "회원구"}, to: "창원시 마산회원구"},
+	{from: []string{"마산시"}, to: "창원시"},
+	{from: []string{"진해시"}, to: "창원시 진해구"},
+
+	// 2014 청주·청원 통합
+	{from: []string{"청원군"}, to: "청주시"},
+
+	// 2012 세종특별자치시 출범
+	{from: []string{"충청남도", "연기군"}, to: "세종특별자치시"},
+	{from: []string{"연기군"}, to: "세종특별자치시"},
+
+	// 2006 제주특별자치도 출범
+	{from: []string{"제주도"}, to: "제주특별자치도"},
+	{from: []string{"북제주군"}, to: "제주시"},
+	{from: []string{"남제주군"}, to: "서귀포시"},
+
+	// 2016 부천시 일반구 폐지
+	{from: []string{"부천시", "원미구"}, to: "부천시"},
+	{from: []string{"부천시", "소사구"}, to: "부천시"},
+	{from: []string{"부천시", "오정구"}, to: "부천시"},
+
+	// 2018 인천 남구 → 미추홀구 (다른 광역시의 남구는 유지)
+	{from: []string{"인천광역시", "남구"}, to: "인천광역시 미추홀구"},
+	{from: []string{"인천", "남구"}, to: "인천 미추홀구"},
+
+	// 2023 군위군 대구광역시 편입
+	{from: []string{"경상북도", "군위군"}, to: "대구광역시 군위군"},
+
+	// 군 → 시 승격
+	{from: []string{"여주군"}, to: "여주시"}, // 2013
+	{from: []string{"당진군"}, to: "당진시"}, // 2012
+	{from: []string{"포천군"}, to: "포천시"}, // 2003
+	{from: []string{"양주군"}, to: "양주시"}, // 2003
+
+	// 1997 울산광역시 승격
+	{from: []string{"울산시"}, to: "울산광역시"},
+}
+
+// NormalizeLegacyDistricts 폐지/개편된 시·군·구 명칭을 현재 명칭으로 치환
+// 공백으로 구분된 토큰 전체가 일치할 때만 치환하며, 토큰 사이 공백은 하나로 정리된다
+func NormalizeLegacyDistricts(address string) string {
+	tokens := strings.Fields(address)
+	result := make([]string, 0, len(tokens))
+
+	for i := 0; i < len(tokens); {
+		rule, ok := matchLegacyDistrict(tokens[i:])
+		if !ok {
+			result = append(result, tokens[i])
+			i++
+			continue
+		}
+		result = append(result, rule.to)
+		i += len(rule.from)
+	}
+
+	return strings.Join(result, " ")
+}
+
+// matchLegacyDistrict tokens 앞부분과 일치하는 첫 번째 규칙 반환
+func matchLegacyDistrict(tokens []string) (legacyDistrict, bool) {
+	for _, rule := range legacyDistricts {
+		if len(rule.from) > len(tokens) {
+			continue
+		}
+		matched := true
+		for j, from := range rule.from {
+			if tokens[j] != from {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return rule, true
+		}
+	}
+	return legacyDistrict{}, false
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLegacyDistricts(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"마산시 합포구", "경상남도 마산시 합포구 월영동 1", "경상남도 창원시 마산합포구 월영동 1"},
+		{"마산시 회원구", "경상남도 마산시 회원구 양덕동 1", "경상남도 창원시 마산회원구 양덕동 1"},
+		{"마산시 단독", "경상남도 마산시 월영동 1", "경상남도 창원시 월영동 1"},
+		{"진해시", "경상남도 진해시 중앙동 1", "경상남도 창원시 진해구 중앙동 1"},
+		{"청원군", "충청북도 청원군 오창읍 양청리 1", "충청북도 청주시 오창읍 양청리 1"},
+		{"연기군 with province", "충청남도 연기군 조치원읍 1", "세종특별자치시 조치원읍 1"},
+		{"연기군 alone", "연기군 조치원읍 1", "세종특별자치시 조치원읍 1"},
+		{"제주도 북제주군", "제주도 북제주군 애월읍 1", "제주특별자치도 제주시 애월읍 1"},
+		{"남제주군", "제주도 남제주군 대정읍 1", "제주특별자치도 서귀포시 대정읍 1"},
+		{"부천시 원미구", "경기도 부천시 원미구 중동 1", "경기도 부천시 중동 1"},
+		{"부천시 소사구", "경기도 부천시 소사구 송내동 1", "경기도 부천시 송내동 1"},
+		{"인천 남구", "인천광역시 남구 주안동 1", "인천광역시 미추홀구 주안동 1"},
+		{"군위군", "경상북도 군위군 군위읍 1", "대구광역시 군위군 군위읍 1"},
+		{"여주군", "경기도 여주군 여주읍 1", "경기도 여주시 여주읍 1"},
+		{"당진군", "충청남도 당진군 당진읍 1", "충청남도 당진시 당진읍 1"},
+		{"울산시", "울산시 남구 삼산동 1", "울산광역시 남구 삼산동 1"},
+
+		// 치환하지 않아야 하는 경우
+		{"current name unchanged", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110"},
+		{"부산 남구 unchanged", "부산광역시 남구 대연동 1", "부산광역시 남구 대연동 1"},
+		{"대구 남구 unchanged", "대구광역시 남구 대명동 1", "대구광역시 남구 대명동 1"},
+		{"substring not replaced", "경상남도 창원시 마산합포구 마산시장길 1", "경상남도 창원시 마산합포구 마산시장길 1"},
+		{"road name containing old name", "경상남도 창원시 진해구 진해시청로 1", "경상남도 창원시 진해구 진해시청로 1"},
+		{"current 청주시 unchanged", "충청북도 청주시 상당구 상당로 155", "충청북도 청주시 상당구 상당로 155"},
+		{"current 제주특별자치도 unchanged", "제주특별자치도 제주시 문연로 6", "제주특별자치도 제주시 문연로 6"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeLegacyDistricts(tt.input))
+		})
+	}
+}
+
+func TestNormalizeAddress_LegacyDistricts(t *testing.T) {
+	assert.Equal(t, "경상남도 창원시 진해구 중앙동 1", NormalizeAddress("  경상남도   진해시 중앙동 1 "))
+}
@@ -3,13 +3,22 @@ package utils
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 )
 
 // RoundToSixDecimal 소수점 6자리로 반올림 (Decimal 9,6 포맷)
 // 예: 37.123456789 → 37.123457
 // 예: 127.987654321 → 127.987654
 func RoundToSixDecimal(val float64) float64 {
-	return math.Round(val*1000000) / 1000000
+	return RoundToDecimal(val, 6)
+}
+
+// RoundToDecimal 소수점 places자리로 반올림
+// 예: RoundToDecimal(37.123456789, 4) → 37.1235
+func RoundToDecimal(val float64, places int) float64 {
+	scale := math.Pow10(places)
+	return math.Round(val*scale) / scale
 }
 
 // FormatCoordinate 좌표를 포맷팅된 문자열로 반환
@@ -41,9 +50,87 @@ func CalculateDistance(lat1, lon1, lat2, lon2 float64) float64 {
 	return earthRadius * c
 }
 
+// Bounds 위경도 경계 상자 (WGS84)
+type Bounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// KoreanBounds 한국 대략 범위: 위도 33~43, 경도 124~132
+var KoreanBounds = Bounds{
+	MinLatitude:  33.0,
+	MaxLatitude:  43.0,
+	MinLongitude: 124.0,
+	MaxLongitude: 132.0,
+}
+
+// Contains 좌표가 경계 상자 안에 있는지 확인 (경계 포함)
+func (b Bounds) Contains(latitude, longitude float64) bool {
+	return latitude >= b.MinLatitude && latitude <= b.MaxLatitude &&
+		longitude >= b.MinLongitude && longitude <= b.MaxLongitude
+}
+
 // IsValidKoreanCoordinate 한국 영역 내 좌표인지 확인
 // 한국 대략 범위: 위도 33~43, 경도 124~132
 func IsValidKoreanCoordinate(latitude, longitude float64) bool {
-	return latitude >= 33.0 && latitude <= 43.0 &&
-		longitude >= 124.0 && longitude <= 132.0
-}
\ No newline at end of file
+	return KoreanBounds.Contains(latitude, longitude)
+}
+
+// ParseCoordinatePair "위도,경도" 문자열을 좌표로 변환 (예: "37.5665, 126.978")
+// 쉼표 양쪽이 모두 숫자이고 한국 영역 안일 때만 ok=true (주소와 구분하기 위해 엄격하게 판단)
+func ParseCoordinatePair(s string) (latitude, longitude float64, ok bool) {
+	latText, lngText, found := strings.Cut(s, ",")
+	if !found {
+		return 0, 0, false
+	}
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(latText), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	longitude, err = strconv.ParseFloat(strings.TrimSpace(lngText), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if !IsValidKoreanCoordinate(latitude, longitude) {
+		return 0, 0, false
+	}
+	return latitude, longitude, true
+}
+
+// geohashBase32 Geohash 인코딩 문자 집합
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash 좌표를 지정한 자릿수의 Geohash 문자열로 인코딩
+// 자릿수별 대략적인 셀 크기: 7 → 150m, 8 → 38m, 9 → 5m
+func Geohash(latitude, longitude float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	hash := make([]byte, 0, precision)
+	bit, ch := 0, 0
+	even := true // 짝수 비트는 경도, 홀수 비트는 위도
+	for len(hash) < precision {
+		r, val := &latRange, latitude
+		if even {
+			r, val = &lngRange, longitude
+		}
+		mid := (r[0] + r[1]) / 2
+		if val >= mid {
+			ch |= 1 << (4 - bit)
+			r[0] = mid
+		} else {
+			r[1] = mid
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+			continue
+		}
+		hash = append(hash, geohashBase32[ch])
+		bit, ch = 0, 0
+	}
+	return string(hash)
+}
@@ -29,6 +29,30 @@ func TestRoundToSixDecimal(t *testing.T) {
 	}
 }
 
+func TestRoundToDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		places   int
+		expected float64
+	}{
+		{"4 places round up", 37.12345678, 4, 37.1235},
+		{"4 places round down", 127.98764321, 4, 127.9876},
+		{"6 places", 37.12345678, 6, 37.123457},
+		{"6 places negative", -127.12345678, 6, -127.123457},
+		{"8 places", 37.1234567891, 8, 37.12345679},
+		{"8 places keeps shorter value", 37.5665, 8, 37.5665},
+		{"0 places", 37.5665, 0, 38},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RoundToDecimal(tt.input, tt.places)
+			assert.InDelta(t, tt.expected, result, 1e-10)
+		})
+	}
+}
+
 func TestFormatCoordinate(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -103,6 +127,49 @@ func TestIsValidKoreanCoordinate(t *testing.T) {
 	}
 }
 
+func TestParseCoordinatePair(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantLat float64
+		wantLng float64
+		wantOK  bool
+	}{
+		{"plain", "37.5665,126.978", 37.5665, 126.978, true},
+		{"spaces", " 37.5665 , 126.978 ", 37.5665, 126.978, true},
+		{"integers", "37,127", 37, 127, true},
+		{"Jeju", "33.4996,126.5312", 33.4996, 126.5312, true},
+
+		{"swapped order", "126.978,37.5665", 0, 0, false},
+		{"outside Korea", "35.6762,139.6503", 0, 0, false},
+		{"address", "서울특별시 중구 세종대로 110", 0, 0, false},
+		{"address with comma", "부산광역시 해운대구 해운대해변로 264, 103동", 0, 0, false},
+		{"single number", "37.5665", 0, 0, false},
+		{"three numbers", "37.5,126.9,1", 0, 0, false},
+		{"NaN", "NaN,126.978", 0, 0, false},
+		{"empty", "", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, lng, ok := ParseCoordinatePair(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantLat, lat)
+			assert.Equal(t, tt.wantLng, lng)
+		})
+	}
+}
+
+func TestBounds_Contains(t *testing.T) {
+	// 이어도 해역까지 포함하도록 남쪽을 넓힌 경계
+	wide := Bounds{MinLatitude: 31.0, MaxLatitude: 43.0, MinLongitude: 124.0, MaxLongitude: 132.0}
+
+	assert.True(t, wide.Contains(32.1, 125.1))
+	assert.False(t, KoreanBounds.Contains(32.1, 125.1))
+	assert.True(t, wide.Contains(31.0, 124.0), "boundary is inclusive")
+	assert.False(t, wide.Contains(30.9, 127.0))
+}
+
 func TestCalculateDistance(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -139,3 +206,23 @@ func TestCalculateDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat       float64
+		lng       float64
+		precision int
+		expected  string
+	}{
+		{"reference point", 57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{"Seoul City Hall", 37.566535, 126.977969, 8, "wydm9qy8"},
+		{"origin", 0, 0, 5, "s0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Geohash(tt.lat, tt.lng, tt.precision))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// parenthesizedPattern 가장 안쪽 괄호 묶음과 그 앞 공백
+var parenthesizedPattern = regexp.MustCompile(`\s*\([^()]*\)`)
+
+// StripParentheses 괄호로 묶인 참고항목 제거 (예: "세종대로 110 (태평로1가)" → "세종대로 110")
+// 중첩 괄호도 바깥까지 모두 제거하며, 짝이 맞지 않는 괄호는 그대로 둔다
+func StripParentheses(address string) string {
+	for {
+		stripped := parenthesizedPattern.ReplaceAllString(address, "")
+		if stripped == address {
+			break
+		}
+		address = stripped
+	}
+	return strings.Join(strings.Fields(address), " ")
+}
+
+// sidoAbbreviations 17개 시·도의 약칭·통칭과 공식 명칭
+// "광주시"는 경기도 광주시와 겹치므로 포함하지 않는다 ("광주"만 광주광역시로 확장)
+var sidoAbbreviations = map[string]string{
+	"서울": "서울특별시", "서울시": "서울특별시",
+	"부산": "부산광역시", "부산시": "부산광역시",
+	"대구": "대구광역시", "대구시": "대구광역시",
+	"인천": "인천광역시", "인천시": "인천광역시",
+	"광주": "광주광역시",
+	"대전": "대전광역시", "대전시": "대전광역시",
+	"울산": "울산광역시", "울산시": "울산광역시",
+	"세종": "세종특별자치시", "세종시": "세종특별자치시",
+	"경기": "경기도",
+	"강원": "강원특별자치도", "강원도": "강원특별자치도", // 2023 특별자치도 출범
+	"충북": "충청북도",
+	"충남": "충청남도",
+	"전북": "전북특별자치도", "전라북도": "전북특별자치도", // 2024 특별자치도 출범
+	"전남": "전라남도",
+	"경북": "경상북도",
+	"경남": "경상남도",
+	"제주": "제주특별자치도", "제주도": "제주특별자치도",
+}
+
+// sidoOffices 17개 시·도 청사(특별·광역·자치시는 시청, 도는 도청) 좌표 (WGS84 위도, 경도)
+var sidoOffices = map[string][2]float64{
+	"서울특별시":   {37.566295, 126.977945},
+	"부산광역시":   {35.179770, 129.075010},
+	"대구광역시":   {35.871435, 128.601445},
+	"인천광역시":   {37.456256, 126.705206},
+	"광주광역시":   {35.160032, 126.851338},
+	"대전광역시":   {36.350412, 127.384548},
+	"울산광역시":   {35.539567, 129.311515},
+	"세종특별자치시": {36.480014, 127.289020},
+	"경기도":     {37.289330, 127.053522},
+	"강원특별자치도": {37.885343, 127.729835},
+	"충청북도":    {36.635700, 127.491393},
+	"충청남도":    {36.658860, 126.672770},
+	"전북특별자치도": {35.820310, 127.108840},
+	"전라남도":    {34.816100, 126.462900},
+	"경상북도":    {36.576032, 128.505599},
+	"경상남도":    {35.238000, 128.692000},
+	"제주특별자치도": {33.488936, 126.498238},
+}
+
+// SidoOffice 주소 첫 토큰의 시·도 청사 좌표 반환 (약칭은 확장, 예: "부산 해운대구" → 부산광역시청)
+// 첫 토큰이 시·도가 아니면 ok=false
+func SidoOffice(address string) (latitude, longitude float64, ok bool) {
+	office, ok := sidoOffices[LeadingSido(address)]
+	if !ok {
+		return 0, 0, false
+	}
+	return office[0], office[1], true
+}
+
+// LeadingSido 주소 첫 토큰의 시·도 공식 명칭 반환 (약칭은 확장, 예: "서울 중구" → "서울특별시")
+// 첫 토큰이 시·도가 아니면 빈 문자열
+func LeadingSido(address string) string {
+	tokens := strings.Fields(address)
+	if len(tokens) == 0 {
+		return ""
+	}
+	if canonical, ok := sidoAbbreviations[tokens[0]]; ok {
+		return canonical
+	}
+	if isSido(tokens[0]) {
+		return tokens[0]
+	}
+	return ""
+}
+
+// ExpandSidoAbbreviations 첫 토큰의 시·도 약칭을 공식 명칭으로 확장 (예: "서울시", "서울" → "서울특별시")
+// 첫 토큰 전체가 약칭과 일치할 때만 치환한다
+func ExpandSidoAbbreviations(address string) string {
+	tokens := strings.Fields(address)
+	if len(tokens) == 0 {
+		return address
+	}
+	canonical, ok := sidoAbbreviations[tokens[0]]
+	if !ok {
+		return address
+	}
+	tokens[0] = canonical
+	return strings.Join(tokens, " ")
+}
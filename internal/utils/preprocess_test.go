@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripParentheses(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"trailing note", "서울특별시 중구 세종대로 110 (태평로1가)", "서울특별시 중구 세종대로 110"},
+		{"note in the middle", "서울특별시 중구 세종대로 110 (서울시청) 본관", "서울특별시 중구 세종대로 110 본관"},
+		{"attached note", "서울특별시 중구 세종대로 110(태평로1가)", "서울특별시 중구 세종대로 110"},
+		{"several notes", "부산광역시 해운대구 (우동) 해운대해변로 264 (마린시티)", "부산광역시 해운대구 해운대해변로 264"},
+		{"nested", "서울특별시 중구 세종대로 110 (시청 (본관))", "서울특별시 중구 세종대로 110"},
+		{"unbalanced kept", "서울특별시 중구 세종대로 110 (태평로1가", "서울특별시 중구 세종대로 110 (태평로1가"},
+		{"no parentheses", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, StripParentheses(tt.input))
+		})
+	}
+}
+
+func TestExpandSidoAbbreviations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// 특별시·광역시·특별자치시
+		{"서울 중구 세종대로 110", "서울특별시 중구 세종대로 110"},
+		{"서울시 중구 세종대로 110", "서울특별시 중구 세종대로 110"},
+		{"부산 해운대구 해운대해변로 264", "부산광역시 해운대구 해운대해변로 264"},
+		{"부산시 해운대구 해운대해변로 264", "부산광역시 해운대구 해운대해변로 264"},
+		{"대구 중구 공평로 88", "대구광역시 중구 공평로 88"},
+		{"대구시 중구 공평로 88", "대구광역시 중구 공평로 88"},
+		{"인천 남동구 정각로 29", "인천광역시 남동구 정각로 29"},
+		{"인천시 남동구 정각로 29", "인천광역시 남동구 정각로 29"},
+		{"광주 서구 내방로 111", "광주광역시 서구 내방로 111"},
+		{"대전 서구 둔산로 100", "대전광역시 서구 둔산로 100"},
+		{"대전시 서구 둔산로 100", "대전광역시 서구 둔산로 100"},
+		{"울산 남구 중앙로 201", "울산광역시 남구 중앙로 201"},
+		{"울산시 남구 중앙로 201", "울산광역시 남구 중앙로 201"},
+		{"세종 한누리대로 2130", "세종특별자치시 한누리대로 2130"},
+		{"세종시 한누리대로 2130", "세종특별자치시 한누리대로 2130"},
+
+		// 도·특별자치도
+		{"경기 수원시 팔달구 효원로 1", "경기도 수원시 팔달구 효원로 1"},
+		{"강원 춘천시 중앙로 1", "강원특별자치도 춘천시 중앙로 1"},
+		{"강원도 춘천시 중앙로 1", "강원특별자치도 춘천시 중앙로 1"},
+		{"충북 청주시 상당구 상당로 82", "충청북도 청주시 상당구 상당로 82"},
+		{"충남 홍성군 홍북읍 충남대로 21", "충청남도 홍성군 홍북읍 충남대로 21"},
+		{"전북 전주시 완산구 효자로 225", "전북특별자치도 전주시 완산구 효자로 225"},
+		{"전라북도 전주시 완산구 효자로 225", "전북특별자치도 전주시 완산구 효자로 225"},
+		{"전남 무안군 삼향읍 오룡길 1", "전라남도 무안군 삼향읍 오룡길 1"},
+		{"경북 안동시 풍천면 도청대로 455", "경상북도 안동시 풍천면 도청대로 455"},
+		{"경남 창원시 의창구 중앙대로 300", "경상남도 창원시 의창구 중앙대로 300"},
+		{"제주 제주시 문연로 6", "제주특별자치도 제주시 문연로 6"},
+		{"제주도 제주시 문연로 6", "제주특별자치도 제주시 문연로 6"},
+
+		// 치환하지 않아야 하는 경우
+		{"서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110"},
+		{"경기도 수원시 팔달구 효원로 1", "경기도 수원시 팔달구 효원로 1"},
+		{"광주시 오포읍 1", "광주시 오포읍 1"},             // 경기도 광주시
+		{"제주시 문연로 6", "제주시 문연로 6"},             // 시·도가 아닌 시
+		{"경기도 부천시 서울시장길 1", "경기도 부천시 서울시장길 1"}, // 첫 토큰만 확장
+		{"서울시청 앞", "서울시청 앞"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExpandSidoAbbreviations(tt.input))
+		})
+	}
+
+	// 17개 시·도 모두 약칭 매핑이 있어야 함
+	canonical := make(map[string]bool)
+	for _, name := range sidoAbbreviations {
+		canonical[name] = true
+	}
+	assert.Len(t, canonical, 17)
+}
+
+func TestLeadingSido(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"서울특별시 중구 세종대로 110", "서울특별시"},
+		{"서울 중구 세종대로 110", "서울특별시"},
+		{"강원도 춘천시 중앙로 1", "강원특별자치도"},
+		{"강원특별자치도 춘천시 중앙로 1", "강원특별자치도"},
+		{"경기도 수원시 팔달구 효원로 1", "경기도"},
+		{"수원시 팔달구 효원로 1", ""},
+		{"광주시 오포읍 1", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, LeadingSido(tt.input))
+		})
+	}
+}
+
+func TestSidoOffice(t *testing.T) {
+	lat, lng, ok := SidoOffice("부산 해운대구 해운대해변로 264")
+	assert.True(t, ok)
+	assert.InDelta(t, 35.1798, lat, 0.01)
+	assert.InDelta(t, 129.0750, lng, 0.01)
+
+	_, _, ok = SidoOffice("수원시 팔달구 효원로 1")
+	assert.False(t, ok)
+
+	// 모든 시·도 공식 명칭에 청사 좌표가 있어야 함
+	for _, canonical := range sidoAbbreviations {
+		lat, lng, ok := SidoOffice(canonical)
+		assert.True(t, ok, canonical)
+		assert.True(t, IsValidKoreanCoordinate(lat, lng), canonical)
+	}
+}
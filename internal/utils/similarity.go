@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"strings"
+)
+
+// numberTokenWeight 건물번호·번지 토큰의 가중치 (지역명 토큰은 1)
+// 같은 도로·동의 다른 번지는 전혀 다른 위치이므로 번호 불일치를 더 크게 반영한다
+const numberTokenWeight = 2
+
+// AddressSimilarity 입력 주소와 Provider가 매칭한 주소의 유사도 (0~1)
+//
+// 두 주소를 같은 규칙으로 정규화한 뒤 토큰 단위로 비교한다:
+//   - 공백·전각 문자 정리, 괄호 참고항목 제거 ("(태평로1가)")
+//   - 시·도 약칭을 공식 명칭으로 확장 ("서울" → "서울특별시")
+//   - 도로명에 붙은 건물번호 분리 ("세종대로110" → "세종대로", "110"), "번지" 접미사 제거
+//
+// 시·도, 시·군·구, 동·리, 도로명 토큰은 1, 건물번호·번지 토큰은 2의 가중치로
+// 가중 Dice 계수(공통 토큰 가중치 합 × 2 / 양쪽 가중치 합)를 계산한다.
+// 같은 주소는 1, 번지만 다르면 0.6 안팎, 지역이 다르면 0에 가깝다.
+// 양쪽 모두 시·도가 있는데 서로 다르거나 둘 중 하나가 비어 있으면 0
+func AddressSimilarity(input, matched string) float64 {
+	a, aSido := similarityTokens(input)
+	b, bSido := similarityTokens(matched)
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	// 도로명·동 이름은 시·도마다 겹치므로 (예: 중구 중앙로) 시·도가 다르면 다른 주소
+	if aSido != "" && bSido != "" && aSido != bSido {
+		return 0
+	}
+
+	var common, total int
+	for token, weight := range a {
+		total += weight
+		if _, ok := b[token]; ok {
+			common += weight
+		}
+	}
+	for _, weight := range b {
+		total += weight
+	}
+	return RoundToDecimal(float64(2*common)/float64(total), 2)
+}
+
+// similarityTokens 비교용 토큰과 가중치, 첫 토큰이 시·도 공식 명칭이면 그 시·도
+func similarityTokens(address string) (tokens map[string]int, sido string) {
+	address = ExpandSidoAbbreviations(StripParentheses(NormalizeAddress(address)))
+	address = strings.ReplaceAll(address, ",", " ")
+
+	tokens = make(map[string]int)
+	add := func(token string) {
+		token = strings.TrimSuffix(token, "번지")
+		if token == "" {
+			return
+		}
+		weight := 1
+		if parcelNumberToken.MatchString(token) {
+			weight = numberTokenWeight
+		}
+		tokens[token] = weight
+	}
+	for _, token := range strings.Fields(address) {
+		// "세종대로110" → "세종대로", "110"
+		if roadNameWithNumberToken.MatchString(token) {
+			number := trailingBuildingNumber.FindString(token)
+			add(strings.TrimSuffix(token, number))
+			add(number)
+			continue
+		}
+		add(token)
+	}
+
+	if first, _, _ := strings.Cut(address, " "); isSido(first) {
+		sido = first
+	}
+	return tokens, sido
+}
+
+// isSido 17개 시·도 공식 명칭 여부
+func isSido(token string) bool {
+	for _, canonical := range sidoAbbreviations {
+		if token == canonical {
+			return true
+		}
+	}
+	return false
+}
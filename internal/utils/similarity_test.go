@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressSimilarity(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		matched string
+		min     float64
+		max     float64
+	}{
+		// 정확 일치
+		{"identical", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 110", 1, 1},
+		{"abbreviated sido", "서울 중구 세종대로 110", "서울특별시 중구 세종대로 110", 1, 1},
+		{"attached building number", "서울 중구 세종대로110", "서울특별시 중구 세종대로 110 (태평로1가)", 1, 1},
+		{"parcel with 번지", "서울특별시 강남구 역삼동 737번지", "서울 강남구 역삼동 737", 1, 1},
+		{"extra whitespace and comma", "  서울특별시  중구,  세종대로 110 ", "서울특별시 중구 세종대로 110", 1, 1},
+
+		// 유사 일치
+		{"extra building name", "서울특별시 중구 세종대로 110 서울시청", "서울특별시 중구 세종대로 110", 0.85, 0.95},
+		{"missing sigungu", "서울특별시 세종대로 110", "서울특별시 중구 세종대로 110", 0.85, 0.95},
+
+		// 잘못된 일치
+		{"different building number", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 112", 0.5, 0.7},
+		{"road only", "서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로", 0.6, 0.8},
+		{"different mountain lot", "서울특별시 종로구 부암동 산2-1", "서울특별시 종로구 부암동 2-1", 0.5, 0.7},
+		{"different region", "부산광역시 해운대구 우동 1234", "서울특별시 중구 세종대로 110", 0, 0},
+		{"same road other sido", "서울특별시 중구 중앙로 10", "대전광역시 중구 중앙로 10", 0, 0},
+		{"empty matched", "서울특별시 중구 세종대로 110", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := AddressSimilarity(tt.input, tt.matched)
+			assert.GreaterOrEqual(t, score, tt.min)
+			assert.LessOrEqual(t, score, tt.max)
+			assert.Equal(t, score, AddressSimilarity(tt.matched, tt.input), "symmetric")
+		})
+	}
+}
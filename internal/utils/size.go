@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// byteUnits 크기 단위별 바이트 수 (1024 기준)
+var byteUnits = map[string]int64{
+	"":   1,
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+}
+
+// ParseByteSize 사람이 읽기 쉬운 크기 문자열을 바이트 수로 변환
+// 예: "1MB" → 1048576, "512KB" → 524288, "100" → 100
+// 단위는 대소문자를 구분하지 않으며 숫자와 단위 사이 공백을 허용한다
+func ParseByteSize(size string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+
+	// 숫자 부분과 단위 부분 분리
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	number, unit := s[:i], strings.TrimSpace(s[i:])
+
+	multiplier, ok := byteUnits[unit]
+	if !ok || number == "" {
+		return 0, fmt.Errorf("invalid byte size: %q", size)
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size: %q", size)
+	}
+
+	bytes := int64(value * float64(multiplier))
+	if bytes <= 0 {
+		return 0, fmt.Errorf("byte size must be positive: %q", size)
+	}
+	return bytes, nil
+}
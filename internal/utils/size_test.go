@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{"megabytes", "1MB", 1 << 20, false},
+		{"kilobytes", "512KB", 512 << 10, false},
+		{"gigabytes", "2GB", 2 << 30, false},
+		{"bytes suffix", "100B", 100, false},
+		{"plain number", "100", 100, false},
+		{"lowercase with space", " 10 mb ", 10 << 20, false},
+		{"fractional", "1.5KB", 1536, false},
+		{"empty", "", 0, true},
+		{"unknown unit", "1TB", 0, true},
+		{"no number", "MB", 0, true},
+		{"zero", "0MB", 0, true},
+		{"garbage", "abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseByteSize(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -1,14 +1,22 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 // NormalizeAddress 주소 정규화
 func NormalizeAddress(address string) string {
-	// 특수문자 정규화 (전각 공백 포함)
+	// 유니코드 NFC 정규화 (NFD로 분해된 한글 자모를 완성형 음절로 결합)
+	address = norm.NFC.String(address)
+
+	// 특수문자 정규화 (전각 공백·영문·숫자 포함)
 	address = normalizeSpecialChars(address)
 	
 	// 공백 정리
@@ -18,6 +26,9 @@ func NormalizeAddress(address string) string {
 	space := regexp.MustCompile(`\s+`)
 	address = space.ReplaceAllString(address, " ")
 	
+	// 폐지/개편된 행정구역명을 현재 명칭으로
+	address = NormalizeLegacyDistricts(address)
+	
 	return address
 }
 
@@ -35,39 +46,95 @@ func normalizeSpecialChars(s string) string {
 		"；", ";",
 		"　", " ", // 전각 공백을 반각 공백으로
 	)
-	return replacer.Replace(s)
+	return strings.Map(halfWidthAlnum, replacer.Replace(s))
+}
+
+// halfWidthAlnum 전각 영문·숫자(０-９, Ａ-Ｚ, ａ-ｚ)를 반각으로 변환 (예: "１１０" → "110")
+// 다른 문자는 그대로 반환한다
+func halfWidthAlnum(r rune) rune {
+	switch {
+	case r >= '０' && r <= '９', r >= 'Ａ' && r <= 'Ｚ', r >= 'ａ' && r <= 'ｚ':
+		return r - '０' + '0'
+	}
+	return r
 }
 
-// IsValidAddress 주소 유효성 검증
+// DefaultMaxAddressLength 주소 최대 길이 기본값 (문자 수)
+const DefaultMaxAddressLength = 200
+
+// maxRepeatedRunes 같은 문자가 연속으로 반복될 수 있는 최대 횟수 (넘으면 비정상 입력으로 판단)
+const maxRepeatedRunes = 10
+
+// IsValidAddress 주소 유효성 검증 (최대 길이는 DefaultMaxAddressLength)
 func IsValidAddress(address string) bool {
+	return CheckAddress(address, DefaultMaxAddressLength) == nil
+}
+
+// CheckAddress 주소 유효성 검증 후 거부 사유 반환 (유효하면 nil)
+// 최소 2자, 최대 maxLength자(0 이하면 DefaultMaxAddressLength), 한글 포함 여부와 함께
+// 제어 문자나 같은 문자의 과도한 반복처럼 Provider에 보낼 필요가 없는 입력을 거른다
+func CheckAddress(address string, maxLength int) error {
+	if maxLength <= 0 {
+		maxLength = DefaultMaxAddressLength
+	}
+
 	// 빈 문자열 체크
 	if strings.TrimSpace(address) == "" {
-		return false
+		return errors.New("address is empty")
 	}
 	
-	// 최소 길이 체크 (최소 2자 이상)
-	if len([]rune(address)) < 2 {
-		return false
+	// 길이 체크 (최소 2자 이상, 최대 maxLength자 이하)
+	length := utf8.RuneCountInString(address)
+	if length < 2 {
+		return errors.New("address is too short")
+	}
+	if length > maxLength {
+		return fmt.Errorf("address is longer than %d characters", maxLength)
 	}
 	
-	// 한글이 포함되어 있는지 체크
+	// 한글 포함, 제어 문자, 연속 반복 체크
 	hasKorean := false
+	var prev rune
+	repeated := 0
 	for _, r := range address {
+		if unicode.IsControl(r) {
+			return errors.New("address contains control characters")
+		}
 		if unicode.Is(unicode.Hangul, r) {
 			hasKorean = true
-			break
+		}
+
+		if r == prev {
+			repeated++
+		} else {
+			prev, repeated = r, 1
+		}
+		if repeated > maxRepeatedRunes && !unicode.IsSpace(r) {
+			return fmt.Errorf("address repeats %q more than %d times", r, maxRepeatedRunes)
 		}
 	}
-	
-	return hasKorean
+	if !hasKorean {
+		return errors.New("address contains no Korean characters")
+	}
+
+	return nil
 }
 
+// zipcodePattern 5자리 우편번호(국가기초구역번호) 패턴
+var zipcodePattern = regexp.MustCompile(`\b\d{5}\b`)
+
 // ExtractZipcode 주소에서 우편번호 추출
 func ExtractZipcode(address string) string {
-	// 5자리 우편번호 패턴
-	re := regexp.MustCompile(`\b\d{5}\b`)
-	matches := re.FindString(address)
-	return matches
+	return zipcodePattern.FindString(address)
+}
+
+// IsValidZipcode 5자리 우편번호 형식 검증 (앞 두 자리는 시·도 구역 01~63)
+func IsValidZipcode(zipcode string) bool {
+	if len(zipcode) != 5 || zipcodePattern.FindString(zipcode) != zipcode {
+		return false
+	}
+	area := (zipcode[0]-'0')*10 + zipcode[1] - '0'
+	return area >= 1 && area <= 63
 }
 
 // SplitAddress 주소를 구성 요소로 분리
@@ -84,4 +151,33 @@ func SplitAddress(address string) []string {
 	}
 	
 	return result
-}
\ No newline at end of file
+}
+
+// adminCodeLength 법정동/행정동 코드 전체 자릿수
+const adminCodeLength = 10
+
+// IsAdminCode 10자리 숫자로 된 법정동/행정동 코드인지 확인
+func IsAdminCode(code string) bool {
+	if len(code) != adminCodeLength {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// TruncateAdminCode 행정구역 코드를 지정한 자릿수로 절삭
+// 법정동코드(10자리: 시도2+시군구3+읍면동3+리2) → 8자리(읍면동) 또는 5자리(시군구)
+// length가 0이면 원본 반환, 10자리 숫자 코드가 아니면 잘못된 코드를 만들지 않도록 빈 문자열 반환
+func TruncateAdminCode(code string, length int) string {
+	if length <= 0 {
+		return code
+	}
+	if !IsAdminCode(code) {
+		return ""
+	}
+	return code[:min(length, adminCodeLength)]
+}
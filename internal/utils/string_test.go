@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestNormalizeAddress(t *testing.T) {
@@ -16,6 +19,9 @@ func TestNormalizeAddress(t *testing.T) {
 		{"multiple spaces", "서울시    중구", "서울시 중구"},
 		{"tabs and newlines", "서울시\t중구\n강남", "서울시 중구 강남"},
 		{"full-width space", "서울시　중구", "서울시 중구"},
+		{"full-width digits", "세종대로 １１０", "세종대로 110"},
+		{"full-width letters", "테헤란로 １５２ Ｂ동 ａ호", "테헤란로 152 B동 a호"},
+		{"full-width digits with hyphen", "역삼동 ７３７－１２", "역삼동 737-12"},
 		{"already normalized", "서울시 중구", "서울시 중구"},
 		{"empty string", "", ""},
 		{"only spaces", "   ", ""},
@@ -29,6 +35,36 @@ func TestNormalizeAddress(t *testing.T) {
 	}
 }
 
+func TestNormalizeAddress_NFC(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		// "서울" 을 초성/중성/종성 자모로 분해한 형태
+		{"decomposed jamo", "\u1109\u1165\u110b\u116e\u11af 중구", "서울 중구"},
+		{"road address", norm.NFD.String("서울특별시 중구 세종대로 110"), "서울특별시 중구 세종대로 110"},
+		{"with full-width space", norm.NFD.String("부산광역시　해운대구  해운대해변로 264"), "부산광역시 해운대구 해운대해변로 264"},
+		{"mixed NFC and NFD", "서울특별시 " + norm.NFD.String("강남구 테헤란로 152"), "서울특별시 강남구 테헤란로 152"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NotEqual(t, tt.expected, tt.input, "input must not already be NFC")
+
+			result := NormalizeAddress(tt.input)
+
+			assert.Equal(t, tt.expected, result)
+			assert.True(t, norm.NFC.IsNormalString(result))
+			assert.True(t, IsValidAddress(result))
+		})
+	}
+
+	// NFC 정규화 후 주소 유형 판별도 동일해야 함
+	assert.Equal(t, AddressTypeRoad, DetectAddressType(norm.NFD.String("서울특별시 중구 세종대로 110")))
+	assert.Equal(t, AddressTypeParcel, DetectAddressType(norm.NFD.String("서울특별시 강남구 역삼동 737")))
+}
+
 func TestIsValidAddress(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -54,6 +90,45 @@ func TestIsValidAddress(t *testing.T) {
 	}
 }
 
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		maxLength int
+		wantErr   string
+	}{
+		{"normal road address", "서울특별시 중구 세종대로 110", 0, ""},
+		{"normal parcel address", "서울특별시 강남구 역삼동 737-1", 0, ""},
+		{"building unit", "서울특별시 송파구 올림픽로 300 101동 1502호", 0, ""},
+		{"exactly default length", strings.Repeat("가나", DefaultMaxAddressLength/2), 0, ""},
+		{"over default length", strings.Repeat("가", DefaultMaxAddressLength+1), 0, "longer than 200 characters"},
+		{"10KB of Korean text", strings.Repeat("서울특별시 중구 ", 1000), 0, "longer than 200 characters"},
+		{"custom limit", "서울특별시 중구 세종대로 110", 10, "longer than 10 characters"},
+		{"null byte", "서울특별시 중구\x00 세종대로 110", 0, "control characters"},
+		{"escape sequence", "서울특별시 \x1b[31m중구", 0, "control characters"},
+		{"excessive repetition", "서울특별시 " + strings.Repeat("ㅋ", 11), 0, "more than 10 times"},
+		{"repetition at limit", "서울특별시 " + strings.Repeat("1", 10), 0, ""},
+		{"too short", "서", 0, "too short"},
+		{"no Korean", "Sejong-daero 110", 0, "no Korean characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAddress(tt.input, tt.maxLength)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	// IsValidAddress는 기본 최대 길이 적용
+	assert.False(t, IsValidAddress(strings.Repeat("가", DefaultMaxAddressLength+1)))
+	assert.False(t, IsValidAddress("서울특별시\x00중구"))
+}
+
 func TestExtractZipcode(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -75,6 +150,30 @@ func TestExtractZipcode(t *testing.T) {
 	}
 }
 
+func TestIsValidZipcode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"04524", true},
+		{"63309", true},
+		{"01000", true},
+		{"00123", false},
+		{"64000", false},
+		{"0452", false},
+		{"045244", false},
+		{"04-52", false},
+		{"０４５２４", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsValidZipcode(tt.input))
+		})
+	}
+}
+
 func TestSplitAddress(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -94,3 +193,27 @@ func TestSplitAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestTruncateAdminCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		length   int
+		expected string
+	}{
+		{"full length", "1114010300", 10, "1114010300"},
+		{"truncate to 8", "1114010300", 8, "11140103"},
+		{"truncate to 5", "1114010300", 5, "11140"},
+		{"zero keeps original", "1114010300", 0, "1114010300"},
+		{"shorter than full code", "11140", 8, ""},
+		{"longer than full code", "111401030012", 8, ""},
+		{"non-numeric code", "11140A0300", 5, ""},
+		{"empty code", "", 5, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TruncateAdminCode(tt.code, tt.length))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Option adjusts a [Config] passed to [New]. Options are applied in order,
+// before the configuration is validated.
+type Option func(*Config) error
+
+// WithBaseURL points the named provider ("vWorld", "Kakao" or "Nominatim",
+// case-insensitive) at another API root, such as a mock server, a regional
+// mirror or a corporate proxy. It sets [Config.VWorldBaseURL],
+// [Config.KakaoBaseURL] or [Config.NominatimBaseURL]; the URL is validated
+// by [New].
+//
+//	client, err := geocoding.New(cfg, geocoding.WithBaseURL("Kakao", "https://proxy.example.com/kakao"))
+func WithBaseURL(providerName, url string) Option {
+	return func(cfg *Config) error {
+		switch strings.ToLower(providerName) {
+		case "vworld":
+			cfg.VWorldBaseURL = url
+		case "kakao":
+			cfg.KakaoBaseURL = url
+		case "nominatim":
+			cfg.NominatimBaseURL = url
+		default:
+			return fmt.Errorf("unknown provider %q for base URL", providerName)
+		}
+		return nil
+	}
+}
+
+// Clock supplies the current time to the client. Implementations must be
+// safe for concurrent use. The clock package under pkg/clock provides a
+// manually advanced fake for tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+}
+
+// WithClock makes the client read time from c instead of the system clock,
+// so provider cooldowns, daily quota resets and cache expiry can be driven
+// deterministically in tests. It sets [Config.Clock].
+//
+//	fake := clock.NewFake(time.Now())
+//	client, err := geocoding.New(cfg, geocoding.WithClock(fake))
+//	fake.Advance(10 * time.Minute) // rate-limited providers become available again
+func WithClock(c Clock) Option {
+	return func(cfg *Config) error {
+		cfg.Clock = c
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 현재 시각 공급자 (할당량 초기화, 비활성화 만료 등 시간 기반 판단에 사용)
+type Clock interface {
+	// Now 현재 시각
+	Now() time.Time
+
+	// Since t부터 현재까지 경과 시간
+	Since(t time.Time) time.Duration
+}
+
+// Real 시스템 시계
+type Real struct{}
+
+// Now time.Now
+func (Real) Now() time.Time { return time.Now() }
+
+// Since time.Since
+func (Real) Since(t time.Time) time.Duration { return time.Since(t) }
+
+// OrReal c가 nil이면 시스템 시계 반환
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real{}
+	}
+	return c
+}
+
+// Fake 수동으로 움직이는 시계 (테스트용, 동시성 안전)
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake t 시각에 멈춰 있는 시계 생성
+func NewFake(t time.Time) *Fake {
+	return &Fake{now: t}
+}
+
+// Now 현재 설정된 시각
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since 설정된 시각 기준 경과 시간
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// Advance 시계를 d만큼 앞으로 이동
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set 시계를 t 시각으로 이동
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = t
+}
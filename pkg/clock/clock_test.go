@@ -0,0 +1,40 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	assert.Equal(t, start, c.Now())
+	assert.Zero(t, c.Since(start))
+
+	c.Advance(90 * time.Second)
+	assert.Equal(t, start.Add(90*time.Second), c.Now())
+	assert.Equal(t, 90*time.Second, c.Since(start))
+
+	later := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	c.Set(later)
+	assert.Equal(t, later, c.Now())
+}
+
+func TestOrReal(t *testing.T) {
+	assert.Equal(t, Real{}, OrReal(nil))
+
+	fake := NewFake(time.Time{})
+	assert.Same(t, fake, OrReal(fake))
+}
+
+func TestReal(t *testing.T) {
+	var c Clock = Real{}
+	before := time.Now()
+
+	now := c.Now()
+	assert.False(t, now.Before(before))
+	assert.GreaterOrEqual(t, c.Since(before), time.Duration(0))
+}
@@ -1,14 +1,36 @@
 package httpclient
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"net"
 	"net/http"
 	"time"
 )
 
+// DefaultMaxRetries 연결 오류 시 기본 재시도 횟수
+const DefaultMaxRetries = 2
+
+// 재시도 대기 시간 (지수 백오프: 100ms, 200ms, 400ms ... 최대 2s)
+var (
+	retryBaseDelay = 100 * time.Millisecond
+	retryMaxDelay  = 2 * time.Second
+)
+
 // Client 최적화된 HTTP 클라이언트
 type Client struct {
 	*http.Client
+
+	// MaxRetries DoWithRetry에 넘길 연결 오류 재시도 횟수 (Provider 공용 설정)
+	MaxRetries int
+
+	// UserAgent DoWithRetry 요청에 User-Agent가 없을 때 보낼 값 (빈 값이면 Go 기본값)
+	UserAgent string
+
+	// Headers DoWithRetry 요청마다 추가할 헤더 (Provider가 이미 설정한 인증 헤더 등은 덮어쓰지 않음)
+	Headers map[string]string
 }
 
 // NewClient HTTP 클라이언트 생성
@@ -31,10 +53,139 @@ func NewClient(timeout time.Duration) *Client {
 				ForceAttemptHTTP2:     true, // HTTP/2 활성화
 			},
 		},
+		MaxRetries: DefaultMaxRetries,
 	}
 }
 
+// Close 유휴 연결을 닫음 (io.Closer, 진행 중인 요청에는 영향 없음)
+func (c *Client) Close() error {
+	c.CloseIdleConnections()
+	return nil
+}
+
 // DefaultClient 기본 설정의 HTTP 클라이언트
 func DefaultClient() *Client {
 	return NewClient(30 * time.Second)
-}
\ No newline at end of file
+}
+
+// DoWithRetry 연결 수준 오류(끊긴 keep-alive 연결 등)에 한해 지수 백오프로 재시도하며 요청 실행
+// 멱등 메서드(GET, HEAD 등)이고 본문을 다시 만들 수 있는(GetBody) 요청만 재시도한다.
+// HTTP 4xx/5xx 응답은 에러가 아니므로 재시도하지 않고 그대로 반환한다 (분류는 Provider 담당).
+// 타임아웃, 컨텍스트 취소, DNS 조회 실패, TLS 오류도 재시도하지 않는다.
+// UserAgent와 Headers는 요청에 같은 헤더가 없을 때만 적용한다.
+func (c *Client) DoWithRetry(req *http.Request, maxRetries int) (*http.Response, error) {
+	c.applyHeaders(req)
+	resp, err := c.Do(req)
+	if !isIdempotent(req) || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, err
+	}
+
+	for attempt := 0; attempt < maxRetries && err != nil && isRetryable(req.Context(), err); attempt++ {
+		if waitErr := sleepContext(req.Context(), backoff(attempt)); waitErr != nil {
+			return nil, err
+		}
+
+		retry, cloneErr := cloneRequest(req)
+		if cloneErr != nil {
+			return nil, err
+		}
+		resp, err = c.Do(retry)
+	}
+
+	return resp, err
+}
+
+// applyHeaders 공용 User-Agent와 헤더를 요청에 없는 것만 추가
+func (c *Client) applyHeaders(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for name, value := range c.Headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+}
+
+// isIdempotent 재시도해도 안전한 HTTP 메서드인지 확인
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryable 재시도 대상인 연결 수준 오류인지 확인
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// 타임아웃은 재시도하면 지연만 늘어나므로 제외
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return false
+	}
+
+	// DNS 조회 실패와 TLS 핸드셰이크·인증서 오류는 다시 시도해도 그대로이므로 제외
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return false
+	}
+	return !isTLSError(err)
+}
+
+// isTLSError TLS 핸드셰이크 또는 인증서 검증 오류인지 확인
+func isTLSError(err error) bool {
+	var (
+		recordErr    tls.RecordHeaderError
+		alertErr     tls.AlertError
+		verifyErr    *tls.CertificateVerificationError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+	)
+	return errors.As(err, &recordErr) ||
+		errors.As(err, &alertErr) ||
+		errors.As(err, &verifyErr) ||
+		errors.As(err, &authorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr)
+}
+
+// cloneRequest 재시도용 요청 복제 (본문은 GetBody로 다시 생성)
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// backoff attempt번째 재시도 전 대기 시간
+func backoff(attempt int) time.Duration {
+	d := retryBaseDelay << attempt
+	if d <= 0 || d > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return d
+}
+
+// sleepContext d만큼 대기 (컨텍스트가 먼저 끝나면 그 에러 반환)
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
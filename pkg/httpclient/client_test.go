@@ -1,8 +1,18 @@
 package httpclient
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -68,3 +78,215 @@ func TestClient_HandlesTimeout(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "context deadline exceeded")
 }
+
+// flakyServer 처음 failures번의 요청은 응답 없이 연결을 끊고 이후에는 성공하는 테스트 서버
+func flakyServer(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if calls.Add(1) <= failures {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// fastRetry 테스트 동안 재시도 대기 시간 단축
+func fastRetry(t *testing.T) {
+	t.Helper()
+	base, max := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() { retryBaseDelay, retryMaxDelay = base, max })
+}
+
+func TestClient_DoWithRetry_DefaultHeaders(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := NewClient(5 * time.Second)
+	client.UserAgent = "my-etl/2.0"
+	client.Headers = map[string]string{
+		"X-Trace-Id":    "trace-123",
+		"Authorization": "Bearer gateway-token",
+	}
+
+	t.Run("applied when missing", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := client.DoWithRetry(req, 0)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, "my-etl/2.0", got.Get("User-Agent"))
+		assert.Equal(t, "trace-123", got.Get("X-Trace-Id"))
+		assert.Equal(t, "Bearer gateway-token", got.Get("Authorization"))
+	})
+
+	t.Run("request headers win", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "KakaoAK test-key")
+		req.Header.Set("User-Agent", "provider-ua")
+
+		resp, err := client.DoWithRetry(req, 0)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, "KakaoAK test-key", got.Get("Authorization"))
+		assert.Equal(t, "provider-ua", got.Get("User-Agent"))
+		assert.Equal(t, "trace-123", got.Get("X-Trace-Id"))
+	})
+}
+
+func TestClient_DoWithRetry_RecoversFromClosedConnection(t *testing.T) {
+	fastRetry(t)
+	server, calls := flakyServer(t, 1)
+	client := NewClient(5 * time.Second)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.DoWithRetry(req, 2)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_DoWithRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	fastRetry(t)
+	server, calls := flakyServer(t, 10)
+	client := NewClient(5 * time.Second)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.DoWithRetry(req, 2)
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_DoWithRetry_ReplaysBody(t *testing.T) {
+	fastRetry(t)
+	server, calls := flakyServer(t, 1)
+	client := NewClient(5 * time.Second)
+
+	// bytes.Reader 본문은 GetBody가 설정되어 재시도 시 다시 전송된다
+	req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewReader([]byte("payload")))
+	require.NoError(t, err)
+
+	resp, err := client.DoWithRetry(req, 2)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "payload", string(body))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_DoWithRetry_DoesNotRetry(t *testing.T) {
+	fastRetry(t)
+
+	t.Run("non-idempotent method", func(t *testing.T) {
+		server, calls := flakyServer(t, 1)
+		client := NewClient(5 * time.Second)
+
+		req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("payload")))
+		require.NoError(t, err)
+
+		_, err = client.DoWithRetry(req, 2)
+
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("HTTP error status", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		client := NewClient(5 * time.Second)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := client.DoWithRetry(req, 2)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		server, calls := flakyServer(t, 10)
+		client := NewClient(5 * time.Second)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.DoWithRetry(req, 2)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.LessOrEqual(t, calls.Load(), int32(1))
+	})
+
+	t.Run("TLS error", func(t *testing.T) {
+		var calls, handshakes atomic.Int32
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+		}))
+		server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				handshakes.Add(1)
+			}
+		}
+		server.Config.ErrorLog = log.New(io.Discard, "", 0)
+		server.StartTLS()
+		defer server.Close()
+		// 테스트 서버의 자체 서명 인증서를 신뢰하지 않는 클라이언트
+		client := NewClient(5 * time.Second)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.DoWithRetry(req, 2)
+
+		var authorityErr x509.UnknownAuthorityError
+		assert.ErrorAs(t, err, &authorityErr)
+		assert.Equal(t, int32(1), handshakes.Load())
+		assert.Zero(t, calls.Load())
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	ctx := context.Background()
+	wrap := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://api.example.com", Err: err}
+	}
+
+	assert.True(t, isRetryable(ctx, wrap(io.ErrUnexpectedEOF)))
+	assert.True(t, isRetryable(ctx, wrap(&net.OpError{Op: "read", Err: syscall.ECONNRESET})))
+	assert.False(t, isRetryable(ctx, wrap(&net.DNSError{Err: "no such host", Name: "api.example.com", IsNotFound: true})))
+	assert.False(t, isRetryable(ctx, wrap(&net.OpError{Op: "dial", Err: &net.DNSError{Err: "server misbehaving", Name: "api.example.com"}})))
+	assert.False(t, isRetryable(ctx, wrap(tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"})))
+	assert.False(t, isRetryable(ctx, wrap(&tls.CertificateVerificationError{Err: x509.HostnameError{Host: "api.example.com"}})))
+	assert.False(t, isRetryable(ctx, wrap(context.DeadlineExceeded)))
+}
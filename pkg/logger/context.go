@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// requestIDKey context 키 타입 (다른 패키지의 키와 충돌 방지)
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request correlation ID.
+// Loggers obtained through FromContext add it as the "request_id" field.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	if requestID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the correlation ID stored by WithRequestID, or "".
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// FromContext returns l with a "request_id" field when ctx carries a
+// correlation ID, and l unchanged otherwise.
+func FromContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	if requestID := RequestID(ctx); requestID != "" {
+		return l.With(zap.String("request_id", requestID))
+	}
+	return l
+}
@@ -2,13 +2,19 @@ package logger
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
-// New creates a new zap logger with the specified level and format
-func New(level string, format string) (*zap.Logger, error) {
+// New creates a new zap logger with the specified level and format.
+// outputs lists where logs are written: "stdout", "stderr", or file paths.
+// Each entry may itself be a comma-separated list (e.g. "stdout,logs/app.log").
+// Parent directories of file paths are created as needed. Defaults to stdout.
+func New(level string, format string, outputs ...string) (*zap.Logger, error) {
 	var config zap.Config
 	
 	// 기본 설정에 따라 config 선택
@@ -26,8 +32,12 @@ func New(level string, format string) (*zap.Logger, error) {
 	}
 	config.Level = zap.NewAtomicLevelAt(logLevel)
 	
-	// 출력 형식 설정
-	config.OutputPaths = []string{"stdout"}
+	// 출력 대상 설정
+	outputPaths, err := resolveOutputs(outputs)
+	if err != nil {
+		return nil, err
+	}
+	config.OutputPaths = outputPaths
 	config.ErrorOutputPaths = []string{"stderr"}
 	
 	// Logger 생성
@@ -39,6 +49,33 @@ func New(level string, format string) (*zap.Logger, error) {
 	return logger, nil
 }
 
+// resolveOutputs 출력 대상 목록 정리 및 파일 경로의 상위 디렉토리 생성
+func resolveOutputs(outputs []string) ([]string, error) {
+	var paths []string
+	for _, output := range outputs {
+		for _, path := range strings.Split(output, ",") {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				continue
+			}
+			paths = append(paths, path)
+
+			// stdout/stderr는 파일이 아님
+			if path == "stdout" || path == "stderr" {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return nil, fmt.Errorf("failed to create log directory: %w", err)
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return []string{"stdout"}, nil
+	}
+	return paths, nil
+}
+
 // parseLevel converts string log level to zapcore.Level
 func parseLevel(level string) (zapcore.Level, error) {
 	switch level {
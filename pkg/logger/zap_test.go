@@ -1,10 +1,14 @@
 package logger
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestNew(t *testing.T) {
@@ -50,3 +54,62 @@ func TestNewNop(t *testing.T) {
 	logger.Warn("warn message")
 	logger.Error("error message")
 }
+
+func TestNew_FileOutput(t *testing.T) {
+	// 존재하지 않는 하위 디렉토리도 생성되어야 함
+	path := filepath.Join(t.TempDir(), "nested", "app.log")
+
+	logger, err := New("info", "json", path)
+	require.NoError(t, err)
+
+	logger.Info("file output test", zap.String("key", "value"))
+	logger.Debug("filtered by level")
+	require.NoError(t, logger.Sync())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"msg":"file output test"`)
+	assert.Contains(t, lines[0], `"key":"value"`)
+}
+
+func TestNew_MultipleOutputs(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.log")
+	second := filepath.Join(dir, "logs", "second.log")
+
+	// 콤마 구분 문자열과 가변 인자 모두 지원
+	logger, err := New("info", "json", "stdout,"+first, second)
+	require.NoError(t, err)
+
+	logger.Info("multi output test")
+	logger.Sync()
+
+	for _, path := range []string{first, second} {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "multi output test")
+	}
+}
+
+func TestResolveOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		outputs []string
+		want    []string
+	}{
+		{"default to stdout", nil, []string{"stdout"}},
+		{"empty string", []string{""}, []string{"stdout"}},
+		{"stderr", []string{"stderr"}, []string{"stderr"}},
+		{"comma separated with spaces", []string{"stdout, stderr"}, []string{"stdout", "stderr"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveOutputs(tt.outputs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	"github.com/oursportsnation/k-geocode/internal/service"
+	"github.com/oursportsnation/k-geocode/internal/utils"
+)
+
+// AddressPreprocessor rewrites an address before it is validated and sent to
+// providers. See [Config.Preprocessors].
+type AddressPreprocessor func(address string) string
+
+// StripParentheses removes parenthesized notes such as "(태평로1가)" or
+// "(서울시청)", including nested ones. Unbalanced parentheses are kept.
+//
+//	StripParentheses("서울특별시 중구 세종대로 110 (태평로1가)") // "서울특별시 중구 세종대로 110"
+func StripParentheses(address string) string {
+	return utils.StripParentheses(address)
+}
+
+// ExpandSidoAbbreviations expands a short or colloquial 시/도 name in the
+// leading token to its official name, e.g. "서울" or "서울시" to "서울특별시",
+// "경기" to "경기도" and "전북" to "전북특별자치도". All 17 시/도 are covered.
+// "광주시" is left alone because it is also a city in 경기도.
+func ExpandSidoAbbreviations(address string) string {
+	return utils.ExpandSidoAbbreviations(address)
+}
+
+// toPreprocessors converts the public preprocessors to the internal type.
+func toPreprocessors(preprocessors []AddressPreprocessor) []service.Preprocessor {
+	if len(preprocessors) == 0 {
+		return nil
+	}
+	converted := make([]service.Preprocessor, len(preprocessors))
+	for i, preprocess := range preprocessors {
+		converted[i] = service.Preprocessor(preprocess)
+	}
+	return converted
+}
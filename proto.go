@@ -0,0 +1,161 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	geocodingv1 "github.com/oursportsnation/k-geocode/proto/geocoding/v1"
+)
+
+// ToProto converts the result to its protocol buffer representation.
+// It returns nil for a nil result. The message carries the coordinate, match
+// quality, address detail and attempts; Source, the road and parcel
+// coordinates, Snapped, CRS, IsFallback and Raw are not part of it.
+func (r *Result) ToProto() *geocodingv1.Result {
+	if r == nil {
+		return nil
+	}
+
+	pb := &geocodingv1.Result{
+		Latitude:       r.Latitude,
+		Longitude:      r.Longitude,
+		Provider:       r.Provider,
+		MatchLevel:     string(r.MatchLevel),
+		LowConfidence:  r.LowConfidence,
+		Confidence:     r.Confidence,
+		MatchedAddress: r.MatchedAddress,
+	}
+
+	if d := r.AddressDetail; d != nil {
+		pb.AddressDetail = &geocodingv1.AddressDetail{
+			RoadAddress:   d.RoadAddress,
+			ParcelAddress: d.ParcelAddress,
+			BuildingName:  d.BuildingName,
+			Zipcode:       d.Zipcode,
+			LegalDongCode: d.LegalDongCode,
+			AdminDongCode: d.AdminDongCode,
+			IsMountain:    d.IsMountain,
+			MainNo:        d.MainNo,
+			SubNo:         d.SubNo,
+
+			EnglishAddress:           d.EnglishAddress,
+			Sido:                     d.Sido,
+			Sigungu:                  d.Sigungu,
+			Dong:                     d.Dong,
+			AdminDong:                d.AdminDong,
+			RoadName:                 d.RoadName,
+			BuildingNo:               d.BuildingNo,
+			RefinedAddress:           d.RefinedAddress,
+			BuildingDong:             d.BuildingDong,
+			BuildingUnit:             d.BuildingUnit,
+			BuildingManagementNumber: d.BuildingManagementNumber,
+		}
+	}
+
+	for _, a := range r.Attempts {
+		pb.Attempts = append(pb.Attempts, &geocodingv1.Attempt{
+			Provider:   a.Provider,
+			Success:    a.Success,
+			Error:      a.Error,
+			RequestUrl: a.RequestURL,
+		})
+	}
+
+	return pb
+}
+
+// ResultFromProto converts a protocol buffer result back to a [Result].
+// It returns nil for a nil message.
+func ResultFromProto(pb *geocodingv1.Result) *Result {
+	if pb == nil {
+		return nil
+	}
+
+	r := &Result{
+		Latitude:       pb.GetLatitude(),
+		Longitude:      pb.GetLongitude(),
+		Provider:       pb.GetProvider(),
+		MatchLevel:     MatchLevel(pb.GetMatchLevel()),
+		LowConfidence:  pb.GetLowConfidence(),
+		Confidence:     pb.GetConfidence(),
+		MatchedAddress: pb.GetMatchedAddress(),
+	}
+
+	if d := pb.GetAddressDetail(); d != nil {
+		r.AddressDetail = &AddressDetail{
+			RoadAddress:   d.GetRoadAddress(),
+			ParcelAddress: d.GetParcelAddress(),
+			BuildingName:  d.GetBuildingName(),
+			Zipcode:       d.GetZipcode(),
+			LegalDongCode: d.GetLegalDongCode(),
+			AdminDongCode: d.GetAdminDongCode(),
+			IsMountain:    d.GetIsMountain(),
+			MainNo:        d.GetMainNo(),
+			SubNo:         d.GetSubNo(),
+
+			EnglishAddress:           d.GetEnglishAddress(),
+			Sido:                     d.GetSido(),
+			Sigungu:                  d.GetSigungu(),
+			Dong:                     d.GetDong(),
+			AdminDong:                d.GetAdminDong(),
+			RoadName:                 d.GetRoadName(),
+			BuildingNo:               d.GetBuildingNo(),
+			RefinedAddress:           d.GetRefinedAddress(),
+			BuildingDong:             d.GetBuildingDong(),
+			BuildingUnit:             d.GetBuildingUnit(),
+			BuildingManagementNumber: d.GetBuildingManagementNumber(),
+		}
+	}
+
+	for _, a := range pb.GetAttempts() {
+		r.Attempts = append(r.Attempts, Attempt{
+			Provider:   a.GetProvider(),
+			Success:    a.GetSuccess(),
+			Error:      a.GetError(),
+			RequestURL: a.GetRequestUrl(),
+		})
+	}
+
+	return r
+}
+
+// BatchToProto converts batch results, as returned by [Client.GeocodeBatch],
+// to a protocol buffer BatchResult. Nil entries (failed addresses) are kept
+// in place with success=false so input order is preserved.
+func BatchToProto(results []*Result) *geocodingv1.BatchResult {
+	pb := &geocodingv1.BatchResult{
+		Results: make([]*geocodingv1.BatchEntry, 0, len(results)),
+	}
+	for _, r := range results {
+		pb.Results = append(pb.Results, &geocodingv1.BatchEntry{
+			Success: r != nil,
+			Result:  r.ToProto(),
+		})
+	}
+	return pb
+}
+
+// BatchFromProto converts a protocol buffer BatchResult back to batch results.
+// Failed entries become nil, matching [Client.GeocodeBatch].
+func BatchFromProto(pb *geocodingv1.BatchResult) []*Result {
+	results := make([]*Result, 0, len(pb.GetResults()))
+	for _, entry := range pb.GetResults() {
+		if !entry.GetSuccess() {
+			results = append(results, nil)
+			continue
+		}
+		results = append(results, ResultFromProto(entry.GetResult()))
+	}
+	return results
+}
@@ -0,0 +1,657 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/geocoding/v1/geocoding.proto
+
+package geocodingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Result is a geocoding result containing WGS84 coordinates.
+type Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// WGS84 latitude.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// WGS84 longitude.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Name of the provider that returned this result (e.g., "vWorld", "Kakao").
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Additional address information, if available.
+	AddressDetail *AddressDetail `protobuf:"bytes,4,opt,name=address_detail,json=addressDetail,proto3" json:"address_detail,omitempty"`
+	// Provider attempts made during geocoding.
+	Attempts []*Attempt `protobuf:"bytes,5,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// Precision of the match: "ROOFTOP", "STREET" or "REGION". Empty for
+	// reverse geocoding results.
+	MatchLevel string `protobuf:"bytes,6,opt,name=match_level,json=matchLevel,proto3" json:"match_level,omitempty"`
+	// Whether the result is below the configured minimum confidence and was
+	// returned only because no provider did better.
+	LowConfidence bool `protobuf:"varint,7,opt,name=low_confidence,json=lowConfidence,proto3" json:"low_confidence,omitempty"`
+	// Estimated closeness of the match to the input address, from 0 to 1.
+	Confidence float64 `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// Address the provider matched, in its canonical form.
+	MatchedAddress string `protobuf:"bytes,9,opt,name=matched_address,json=matchedAddress,proto3" json:"matched_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Result) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Result) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Result) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Result) GetAddressDetail() *AddressDetail {
+	if x != nil {
+		return x.AddressDetail
+	}
+	return nil
+}
+
+func (x *Result) GetAttempts() []*Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *Result) GetMatchLevel() string {
+	if x != nil {
+		return x.MatchLevel
+	}
+	return ""
+}
+
+func (x *Result) GetLowConfidence() bool {
+	if x != nil {
+		return x.LowConfidence
+	}
+	return false
+}
+
+func (x *Result) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Result) GetMatchedAddress() string {
+	if x != nil {
+		return x.MatchedAddress
+	}
+	return ""
+}
+
+// AddressDetail contains detailed address information returned by the provider.
+type AddressDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Road-based address (도로명 주소).
+	RoadAddress string `protobuf:"bytes,1,opt,name=road_address,json=roadAddress,proto3" json:"road_address,omitempty"`
+	// Parcel-based address (지번 주소).
+	ParcelAddress string `protobuf:"bytes,2,opt,name=parcel_address,json=parcelAddress,proto3" json:"parcel_address,omitempty"`
+	// Building name, if applicable.
+	BuildingName string `protobuf:"bytes,3,opt,name=building_name,json=buildingName,proto3" json:"building_name,omitempty"`
+	// Postal code.
+	Zipcode string `protobuf:"bytes,4,opt,name=zipcode,proto3" json:"zipcode,omitempty"`
+	// Legal district code (법정동코드).
+	LegalDongCode string `protobuf:"bytes,5,opt,name=legal_dong_code,json=legalDongCode,proto3" json:"legal_dong_code,omitempty"`
+	// Administrative district code (행정동코드).
+	AdminDongCode string `protobuf:"bytes,6,opt,name=admin_dong_code,json=adminDongCode,proto3" json:"admin_dong_code,omitempty"`
+	// Whether the parcel is a mountain lot (산 번지).
+	IsMountain bool `protobuf:"varint,7,opt,name=is_mountain,json=isMountain,proto3" json:"is_mountain,omitempty"`
+	// Main parcel number (본번).
+	MainNo string `protobuf:"bytes,8,opt,name=main_no,json=mainNo,proto3" json:"main_no,omitempty"`
+	// Sub parcel number (부번).
+	SubNo string `protobuf:"bytes,9,opt,name=sub_no,json=subNo,proto3" json:"sub_no,omitempty"`
+	// Romanized road address.
+	EnglishAddress string `protobuf:"bytes,10,opt,name=english_address,json=englishAddress,proto3" json:"english_address,omitempty"`
+	// Province or metropolitan city (시·도).
+	Sido string `protobuf:"bytes,11,opt,name=sido,proto3" json:"sido,omitempty"`
+	// City, county or district (시·군·구).
+	Sigungu string `protobuf:"bytes,12,opt,name=sigungu,proto3" json:"sigungu,omitempty"`
+	// Legal town or neighborhood (법정 읍·면·동).
+	Dong string `protobuf:"bytes,13,opt,name=dong,proto3" json:"dong,omitempty"`
+	// Administrative neighborhood (행정동).
+	AdminDong string `protobuf:"bytes,14,opt,name=admin_dong,json=adminDong,proto3" json:"admin_dong,omitempty"`
+	// Road name (도로명).
+	RoadName string `protobuf:"bytes,15,opt,name=road_name,json=roadName,proto3" json:"road_name,omitempty"`
+	// Building number on the road.
+	BuildingNo string `protobuf:"bytes,16,opt,name=building_no,json=buildingNo,proto3" json:"building_no,omitempty"`
+	// Provider's normalized form of the input address.
+	RefinedAddress string `protobuf:"bytes,17,opt,name=refined_address,json=refinedAddress,proto3" json:"refined_address,omitempty"`
+	// Apartment building number (동) taken from the input address.
+	BuildingDong string `protobuf:"bytes,18,opt,name=building_dong,json=buildingDong,proto3" json:"building_dong,omitempty"`
+	// Unit number (호) taken from the input address.
+	BuildingUnit string `protobuf:"bytes,19,opt,name=building_unit,json=buildingUnit,proto3" json:"building_unit,omitempty"`
+	// Building management number (건물관리번호).
+	BuildingManagementNumber string `protobuf:"bytes,20,opt,name=building_management_number,json=buildingManagementNumber,proto3" json:"building_management_number,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AddressDetail) Reset() {
+	*x = AddressDetail{}
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressDetail) ProtoMessage() {}
+
+func (x *AddressDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressDetail.ProtoReflect.Descriptor instead.
+func (*AddressDetail) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddressDetail) GetRoadAddress() string {
+	if x != nil {
+		return x.RoadAddress
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetParcelAddress() string {
+	if x != nil {
+		return x.ParcelAddress
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetBuildingName() string {
+	if x != nil {
+		return x.BuildingName
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetZipcode() string {
+	if x != nil {
+		return x.Zipcode
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetLegalDongCode() string {
+	if x != nil {
+		return x.LegalDongCode
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetAdminDongCode() string {
+	if x != nil {
+		return x.AdminDongCode
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetIsMountain() bool {
+	if x != nil {
+		return x.IsMountain
+	}
+	return false
+}
+
+func (x *AddressDetail) GetMainNo() string {
+	if x != nil {
+		return x.MainNo
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetSubNo() string {
+	if x != nil {
+		return x.SubNo
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetEnglishAddress() string {
+	if x != nil {
+		return x.EnglishAddress
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetSido() string {
+	if x != nil {
+		return x.Sido
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetSigungu() string {
+	if x != nil {
+		return x.Sigungu
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetDong() string {
+	if x != nil {
+		return x.Dong
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetAdminDong() string {
+	if x != nil {
+		return x.AdminDong
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetRoadName() string {
+	if x != nil {
+		return x.RoadName
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetBuildingNo() string {
+	if x != nil {
+		return x.BuildingNo
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetRefinedAddress() string {
+	if x != nil {
+		return x.RefinedAddress
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetBuildingDong() string {
+	if x != nil {
+		return x.BuildingDong
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetBuildingUnit() string {
+	if x != nil {
+		return x.BuildingUnit
+	}
+	return ""
+}
+
+func (x *AddressDetail) GetBuildingManagementNumber() string {
+	if x != nil {
+		return x.BuildingManagementNumber
+	}
+	return ""
+}
+
+// Attempt records a single provider attempt during geocoding.
+type Attempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the provider that was tried.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Whether this attempt succeeded.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Error message if the attempt failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Request URL with the API key removed, recorded in debug mode.
+	RequestUrl    string `protobuf:"bytes,4,opt,name=request_url,json=requestUrl,proto3" json:"request_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attempt) Reset() {
+	*x = Attempt{}
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attempt) ProtoMessage() {}
+
+func (x *Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attempt.ProtoReflect.Descriptor instead.
+func (*Attempt) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Attempt) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Attempt) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Attempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Attempt) GetRequestUrl() string {
+	if x != nil {
+		return x.RequestUrl
+	}
+	return ""
+}
+
+// BatchResult holds the results of a batch geocoding call in input order.
+type BatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchEntry          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchResult) GetResults() []*BatchEntry {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchEntry is a single batch result. Failed addresses have success=false
+// and no result.
+type BatchEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchEntry) Reset() {
+	*x = BatchEntry{}
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEntry) ProtoMessage() {}
+
+func (x *BatchEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEntry.ProtoReflect.Descriptor instead.
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchEntry) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchEntry) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_proto_geocoding_v1_geocoding_proto protoreflect.FileDescriptor
+
+const file_proto_geocoding_v1_geocoding_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/geocoding/v1/geocoding.proto\x12\fgeocoding.v1\"\xe6\x02\n" +
+	"\x06Result\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12B\n" +
+	"\x0eaddress_detail\x18\x04 \x01(\v2\x1b.geocoding.v1.AddressDetailR\raddressDetail\x121\n" +
+	"\battempts\x18\x05 \x03(\v2\x15.geocoding.v1.AttemptR\battempts\x12\x1f\n" +
+	"\vmatch_level\x18\x06 \x01(\tR\n" +
+	"matchLevel\x12%\n" +
+	"\x0elow_confidence\x18\a \x01(\bR\rlowConfidence\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\x12'\n" +
+	"\x0fmatched_address\x18\t \x01(\tR\x0ematchedAddress\"\xb2\x05\n" +
+	"\rAddressDetail\x12!\n" +
+	"\froad_address\x18\x01 \x01(\tR\vroadAddress\x12%\n" +
+	"\x0eparcel_address\x18\x02 \x01(\tR\rparcelAddress\x12#\n" +
+	"\rbuilding_name\x18\x03 \x01(\tR\fbuildingName\x12\x18\n" +
+	"\azipcode\x18\x04 \x01(\tR\azipcode\x12&\n" +
+	"\x0flegal_dong_code\x18\x05 \x01(\tR\rlegalDongCode\x12&\n" +
+	"\x0fadmin_dong_code\x18\x06 \x01(\tR\radminDongCode\x12\x1f\n" +
+	"\vis_mountain\x18\a \x01(\bR\n" +
+	"isMountain\x12\x17\n" +
+	"\amain_no\x18\b \x01(\tR\x06mainNo\x12\x15\n" +
+	"\x06sub_no\x18\t \x01(\tR\x05subNo\x12'\n" +
+	"\x0fenglish_address\x18\n" +
+	" \x01(\tR\x0eenglishAddress\x12\x12\n" +
+	"\x04sido\x18\v \x01(\tR\x04sido\x12\x18\n" +
+	"\asigungu\x18\f \x01(\tR\asigungu\x12\x12\n" +
+	"\x04dong\x18\r \x01(\tR\x04dong\x12\x1d\n" +
+	"\n" +
+	"admin_dong\x18\x0e \x01(\tR\tadminDong\x12\x1b\n" +
+	"\troad_name\x18\x0f \x01(\tR\broadName\x12\x1f\n" +
+	"\vbuilding_no\x18\x10 \x01(\tR\n" +
+	"buildingNo\x12'\n" +
+	"\x0frefined_address\x18\x11 \x01(\tR\x0erefinedAddress\x12#\n" +
+	"\rbuilding_dong\x18\x12 \x01(\tR\fbuildingDong\x12#\n" +
+	"\rbuilding_unit\x18\x13 \x01(\tR\fbuildingUnit\x12<\n" +
+	"\x1abuilding_management_number\x18\x14 \x01(\tR\x18buildingManagementNumber\"v\n" +
+	"\aAttempt\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1f\n" +
+	"\vrequest_url\x18\x04 \x01(\tR\n" +
+	"requestUrl\"A\n" +
+	"\vBatchResult\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.geocoding.v1.BatchEntryR\aresults\"T\n" +
+	"\n" +
+	"BatchEntry\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12,\n" +
+	"\x06result\x18\x02 \x01(\v2\x14.geocoding.v1.ResultR\x06resultBEZCgithub.com/oursportsnation/k-geocode/proto/geocoding/v1;geocodingv1b\x06proto3"
+
+var (
+	file_proto_geocoding_v1_geocoding_proto_rawDescOnce sync.Once
+	file_proto_geocoding_v1_geocoding_proto_rawDescData []byte
+)
+
+func file_proto_geocoding_v1_geocoding_proto_rawDescGZIP() []byte {
+	file_proto_geocoding_v1_geocoding_proto_rawDescOnce.Do(func() {
+		file_proto_geocoding_v1_geocoding_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_geocoding_v1_geocoding_proto_rawDesc), len(file_proto_geocoding_v1_geocoding_proto_rawDesc)))
+	})
+	return file_proto_geocoding_v1_geocoding_proto_rawDescData
+}
+
+var file_proto_geocoding_v1_geocoding_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_geocoding_v1_geocoding_proto_goTypes = []any{
+	(*Result)(nil),        // 0: geocoding.v1.Result
+	(*AddressDetail)(nil), // 1: geocoding.v1.AddressDetail
+	(*Attempt)(nil),       // 2: geocoding.v1.Attempt
+	(*BatchResult)(nil),   // 3: geocoding.v1.BatchResult
+	(*BatchEntry)(nil),    // 4: geocoding.v1.BatchEntry
+}
+var file_proto_geocoding_v1_geocoding_proto_depIdxs = []int32{
+	1, // 0: geocoding.v1.Result.address_detail:type_name -> geocoding.v1.AddressDetail
+	2, // 1: geocoding.v1.Result.attempts:type_name -> geocoding.v1.Attempt
+	4, // 2: geocoding.v1.BatchResult.results:type_name -> geocoding.v1.BatchEntry
+	0, // 3: geocoding.v1.BatchEntry.result:type_name -> geocoding.v1.Result
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_geocoding_v1_geocoding_proto_init() }
+func file_proto_geocoding_v1_geocoding_proto_init() {
+	if File_proto_geocoding_v1_geocoding_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_geocoding_v1_geocoding_proto_rawDesc), len(file_proto_geocoding_v1_geocoding_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_geocoding_v1_geocoding_proto_goTypes,
+		DependencyIndexes: file_proto_geocoding_v1_geocoding_proto_depIdxs,
+		MessageInfos:      file_proto_geocoding_v1_geocoding_proto_msgTypes,
+	}.Build()
+	File_proto_geocoding_v1_geocoding_proto = out.File
+	file_proto_geocoding_v1_geocoding_proto_goTypes = nil
+	file_proto_geocoding_v1_geocoding_proto_depIdxs = nil
+}
@@ -0,0 +1,629 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/geocoding/v1/geocoding_service.proto
+
+package geocodingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GeocodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Korean address to geocode.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Optional address type: "ROAD" or "PARCEL". Empty tries both.
+	AddressType   string `protobuf:"bytes,2,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeocodeRequest) Reset() {
+	*x = GeocodeRequest{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeRequest) ProtoMessage() {}
+
+func (x *GeocodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeRequest.ProtoReflect.Descriptor instead.
+func (*GeocodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeocodeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GeocodeRequest) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+type GeocodeResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Present on success. On failure it may still carry attempts and the
+	// address detail returned by the provider.
+	Result *Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// Error message when success is false.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Whether result coordinates passed validation.
+	CoordinateValid bool `protobuf:"varint,4,opt,name=coordinate_valid,json=coordinateValid,proto3" json:"coordinate_valid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GeocodeResponse) Reset() {
+	*x = GeocodeResponse{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeResponse) ProtoMessage() {}
+
+func (x *GeocodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeResponse.ProtoReflect.Descriptor instead.
+func (*GeocodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeocodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GeocodeResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GeocodeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GeocodeResponse) GetCoordinateValid() bool {
+	if x != nil {
+		return x.CoordinateValid
+	}
+	return false
+}
+
+type GeocodeBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeocodeBatchRequest) Reset() {
+	*x = GeocodeBatchRequest{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeocodeBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeBatchRequest) ProtoMessage() {}
+
+func (x *GeocodeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeBatchRequest.ProtoReflect.Descriptor instead.
+func (*GeocodeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GeocodeBatchRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// BatchItem is a single streamed batch result.
+type BatchItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the address in the request.
+	Index         int32            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Address       string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Response      *GeocodeResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchItem) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItem) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BatchItem) GetResponse() *GeocodeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ReverseGeocodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseGeocodeRequest) Reset() {
+	*x = ReverseGeocodeRequest{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseGeocodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGeocodeRequest) ProtoMessage() {}
+
+func (x *ReverseGeocodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGeocodeRequest.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReverseGeocodeRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ReverseGeocodeRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type ReverseGeocodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseGeocodeResponse) Reset() {
+	*x = ReverseGeocodeResponse{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseGeocodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGeocodeResponse) ProtoMessage() {}
+
+func (x *ReverseGeocodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGeocodeResponse.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReverseGeocodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReverseGeocodeResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ReverseGeocodeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{6}
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Healthy       bool                   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Providers     []*ProviderStatus      `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HealthCheckResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *HealthCheckResponse) GetProviders() []*ProviderStatus {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type ProviderStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderStatus) Reset() {
+	*x = ProviderStatus{}
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderStatus) ProtoMessage() {}
+
+func (x *ProviderStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_geocoding_v1_geocoding_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderStatus.ProtoReflect.Descriptor instead.
+func (*ProviderStatus) Descriptor() ([]byte, []int) {
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderStatus) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+var File_proto_geocoding_v1_geocoding_service_proto protoreflect.FileDescriptor
+
+const file_proto_geocoding_v1_geocoding_service_proto_rawDesc = "" +
+	"\n" +
+	"*proto/geocoding/v1/geocoding_service.proto\x12\fgeocoding.v1\x1a\"proto/geocoding/v1/geocoding.proto\"M\n" +
+	"\x0eGeocodeRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12!\n" +
+	"\faddress_type\x18\x02 \x01(\tR\vaddressType\"\x9a\x01\n" +
+	"\x0fGeocodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12,\n" +
+	"\x06result\x18\x02 \x01(\v2\x14.geocoding.v1.ResultR\x06result\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12)\n" +
+	"\x10coordinate_valid\x18\x04 \x01(\bR\x0fcoordinateValid\"3\n" +
+	"\x13GeocodeBatchRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\"v\n" +
+	"\tBatchItem\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x129\n" +
+	"\bresponse\x18\x03 \x01(\v2\x1d.geocoding.v1.GeocodeResponseR\bresponse\"Q\n" +
+	"\x15ReverseGeocodeRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"v\n" +
+	"\x16ReverseGeocodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12,\n" +
+	"\x06result\x18\x02 \x01(\v2\x14.geocoding.v1.ResultR\x06result\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x14\n" +
+	"\x12HealthCheckRequest\"k\n" +
+	"\x13HealthCheckResponse\x12\x18\n" +
+	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12:\n" +
+	"\tproviders\x18\x02 \x03(\v2\x1c.geocoding.v1.ProviderStatusR\tproviders\"B\n" +
+	"\x0eProviderStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable2\xd9\x02\n" +
+	"\x10GeocodingService\x12F\n" +
+	"\aGeocode\x12\x1c.geocoding.v1.GeocodeRequest\x1a\x1d.geocoding.v1.GeocodeResponse\x12L\n" +
+	"\fGeocodeBatch\x12!.geocoding.v1.GeocodeBatchRequest\x1a\x17.geocoding.v1.BatchItem0\x01\x12[\n" +
+	"\x0eReverseGeocode\x12#.geocoding.v1.ReverseGeocodeRequest\x1a$.geocoding.v1.ReverseGeocodeResponse\x12R\n" +
+	"\vHealthCheck\x12 .geocoding.v1.HealthCheckRequest\x1a!.geocoding.v1.HealthCheckResponseBEZCgithub.com/oursportsnation/k-geocode/proto/geocoding/v1;geocodingv1b\x06proto3"
+
+var (
+	file_proto_geocoding_v1_geocoding_service_proto_rawDescOnce sync.Once
+	file_proto_geocoding_v1_geocoding_service_proto_rawDescData []byte
+)
+
+func file_proto_geocoding_v1_geocoding_service_proto_rawDescGZIP() []byte {
+	file_proto_geocoding_v1_geocoding_service_proto_rawDescOnce.Do(func() {
+		file_proto_geocoding_v1_geocoding_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_geocoding_v1_geocoding_service_proto_rawDesc), len(file_proto_geocoding_v1_geocoding_service_proto_rawDesc)))
+	})
+	return file_proto_geocoding_v1_geocoding_service_proto_rawDescData
+}
+
+var file_proto_geocoding_v1_geocoding_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_geocoding_v1_geocoding_service_proto_goTypes = []any{
+	(*GeocodeRequest)(nil),         // 0: geocoding.v1.GeocodeRequest
+	(*GeocodeResponse)(nil),        // 1: geocoding.v1.GeocodeResponse
+	(*GeocodeBatchRequest)(nil),    // 2: geocoding.v1.GeocodeBatchRequest
+	(*BatchItem)(nil),              // 3: geocoding.v1.BatchItem
+	(*ReverseGeocodeRequest)(nil),  // 4: geocoding.v1.ReverseGeocodeRequest
+	(*ReverseGeocodeResponse)(nil), // 5: geocoding.v1.ReverseGeocodeResponse
+	(*HealthCheckRequest)(nil),     // 6: geocoding.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),    // 7: geocoding.v1.HealthCheckResponse
+	(*ProviderStatus)(nil),         // 8: geocoding.v1.ProviderStatus
+	(*Result)(nil),                 // 9: geocoding.v1.Result
+}
+var file_proto_geocoding_v1_geocoding_service_proto_depIdxs = []int32{
+	9, // 0: geocoding.v1.GeocodeResponse.result:type_name -> geocoding.v1.Result
+	1, // 1: geocoding.v1.BatchItem.response:type_name -> geocoding.v1.GeocodeResponse
+	9, // 2: geocoding.v1.ReverseGeocodeResponse.result:type_name -> geocoding.v1.Result
+	8, // 3: geocoding.v1.HealthCheckResponse.providers:type_name -> geocoding.v1.ProviderStatus
+	0, // 4: geocoding.v1.GeocodingService.Geocode:input_type -> geocoding.v1.GeocodeRequest
+	2, // 5: geocoding.v1.GeocodingService.GeocodeBatch:input_type -> geocoding.v1.GeocodeBatchRequest
+	4, // 6: geocoding.v1.GeocodingService.ReverseGeocode:input_type -> geocoding.v1.ReverseGeocodeRequest
+	6, // 7: geocoding.v1.GeocodingService.HealthCheck:input_type -> geocoding.v1.HealthCheckRequest
+	1, // 8: geocoding.v1.GeocodingService.Geocode:output_type -> geocoding.v1.GeocodeResponse
+	3, // 9: geocoding.v1.GeocodingService.GeocodeBatch:output_type -> geocoding.v1.BatchItem
+	5, // 10: geocoding.v1.GeocodingService.ReverseGeocode:output_type -> geocoding.v1.ReverseGeocodeResponse
+	7, // 11: geocoding.v1.GeocodingService.HealthCheck:output_type -> geocoding.v1.HealthCheckResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_geocoding_v1_geocoding_service_proto_init() }
+func file_proto_geocoding_v1_geocoding_service_proto_init() {
+	if File_proto_geocoding_v1_geocoding_service_proto != nil {
+		return
+	}
+	file_proto_geocoding_v1_geocoding_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_geocoding_v1_geocoding_service_proto_rawDesc), len(file_proto_geocoding_v1_geocoding_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_geocoding_v1_geocoding_service_proto_goTypes,
+		DependencyIndexes: file_proto_geocoding_v1_geocoding_service_proto_depIdxs,
+		MessageInfos:      file_proto_geocoding_v1_geocoding_service_proto_msgTypes,
+	}.Build()
+	File_proto_geocoding_v1_geocoding_service_proto = out.File
+	file_proto_geocoding_v1_geocoding_service_proto_goTypes = nil
+	file_proto_geocoding_v1_geocoding_service_proto_depIdxs = nil
+}
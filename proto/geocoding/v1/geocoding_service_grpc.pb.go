@@ -0,0 +1,267 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: proto/geocoding/v1/geocoding_service.proto
+
+package geocodingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GeocodingService_Geocode_FullMethodName        = "/geocoding.v1.GeocodingService/Geocode"
+	GeocodingService_GeocodeBatch_FullMethodName   = "/geocoding.v1.GeocodingService/GeocodeBatch"
+	GeocodingService_ReverseGeocode_FullMethodName = "/geocoding.v1.GeocodingService/ReverseGeocode"
+	GeocodingService_HealthCheck_FullMethodName    = "/geocoding.v1.GeocodingService/HealthCheck"
+)
+
+// GeocodingServiceClient is the client API for GeocodingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GeocodingService converts Korean addresses to WGS84 coordinates.
+type GeocodingServiceClient interface {
+	// Geocode converts a single address.
+	Geocode(ctx context.Context, in *GeocodeRequest, opts ...grpc.CallOption) (*GeocodeResponse, error)
+	// GeocodeBatch converts multiple addresses, streaming each result as soon
+	// as it completes. Use BatchItem.index to restore input order.
+	GeocodeBatch(ctx context.Context, in *GeocodeBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchItem], error)
+	// ReverseGeocode converts a coordinate to an address.
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error)
+	// HealthCheck reports provider availability.
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type geocodingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeocodingServiceClient(cc grpc.ClientConnInterface) GeocodingServiceClient {
+	return &geocodingServiceClient{cc}
+}
+
+func (c *geocodingServiceClient) Geocode(ctx context.Context, in *GeocodeRequest, opts ...grpc.CallOption) (*GeocodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeocodeResponse)
+	err := c.cc.Invoke(ctx, GeocodingService_Geocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocodingServiceClient) GeocodeBatch(ctx context.Context, in *GeocodeBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BatchItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GeocodingService_ServiceDesc.Streams[0], GeocodingService_GeocodeBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GeocodeBatchRequest, BatchItem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GeocodingService_GeocodeBatchClient = grpc.ServerStreamingClient[BatchItem]
+
+func (c *geocodingServiceClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseGeocodeResponse)
+	err := c.cc.Invoke(ctx, GeocodingService_ReverseGeocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocodingServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, GeocodingService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeocodingServiceServer is the server API for GeocodingService service.
+// All implementations must embed UnimplementedGeocodingServiceServer
+// for forward compatibility.
+//
+// GeocodingService converts Korean addresses to WGS84 coordinates.
+type GeocodingServiceServer interface {
+	// Geocode converts a single address.
+	Geocode(context.Context, *GeocodeRequest) (*GeocodeResponse, error)
+	// GeocodeBatch converts multiple addresses, streaming each result as soon
+	// as it completes. Use BatchItem.index to restore input order.
+	GeocodeBatch(*GeocodeBatchRequest, grpc.ServerStreamingServer[BatchItem]) error
+	// ReverseGeocode converts a coordinate to an address.
+	ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error)
+	// HealthCheck reports provider availability.
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedGeocodingServiceServer()
+}
+
+// UnimplementedGeocodingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeocodingServiceServer struct{}
+
+func (UnimplementedGeocodingServiceServer) Geocode(context.Context, *GeocodeRequest) (*GeocodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Geocode not implemented")
+}
+func (UnimplementedGeocodingServiceServer) GeocodeBatch(*GeocodeBatchRequest, grpc.ServerStreamingServer[BatchItem]) error {
+	return status.Error(codes.Unimplemented, "method GeocodeBatch not implemented")
+}
+func (UnimplementedGeocodingServiceServer) ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
+func (UnimplementedGeocodingServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedGeocodingServiceServer) mustEmbedUnimplementedGeocodingServiceServer() {}
+func (UnimplementedGeocodingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGeocodingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeocodingServiceServer will
+// result in compilation errors.
+type UnsafeGeocodingServiceServer interface {
+	mustEmbedUnimplementedGeocodingServiceServer()
+}
+
+func RegisterGeocodingServiceServer(s grpc.ServiceRegistrar, srv GeocodingServiceServer) {
+	// If the following call panics, it indicates UnimplementedGeocodingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GeocodingService_ServiceDesc, srv)
+}
+
+func _GeocodingService_Geocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocodingServiceServer).Geocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocodingService_Geocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocodingServiceServer).Geocode(ctx, req.(*GeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocodingService_GeocodeBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GeocodeBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GeocodingServiceServer).GeocodeBatch(m, &grpc.GenericServerStream[GeocodeBatchRequest, BatchItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GeocodingService_GeocodeBatchServer = grpc.ServerStreamingServer[BatchItem]
+
+func _GeocodingService_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocodingServiceServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocodingService_ReverseGeocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocodingServiceServer).ReverseGeocode(ctx, req.(*ReverseGeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocodingService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocodingServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocodingService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocodingServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GeocodingService_ServiceDesc is the grpc.ServiceDesc for GeocodingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GeocodingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "geocoding.v1.GeocodingService",
+	HandlerType: (*GeocodingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Geocode",
+			Handler:    _GeocodingService_Geocode_Handler,
+		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _GeocodingService_ReverseGeocode_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _GeocodingService_HealthCheck_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GeocodeBatch",
+			Handler:       _GeocodingService_GeocodeBatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/geocoding/v1/geocoding_service.proto",
+}
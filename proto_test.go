@@ -0,0 +1,112 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import (
+	"testing"
+
+	geocodingv1 "github.com/oursportsnation/k-geocode/proto/geocoding/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func fullResult() *Result {
+	return &Result{
+		Latitude:       37.566535,
+		Longitude:      126.977969,
+		Provider:       "Kakao",
+		MatchLevel:     MatchLevelRooftop,
+		Confidence:     0.95,
+		LowConfidence:  true,
+		MatchedAddress: "서울 중구 세종대로 110",
+		AddressDetail: &AddressDetail{
+			RoadAddress:              "서울특별시 중구 세종대로 110",
+			ParcelAddress:            "서울특별시 중구 태평로1가 31",
+			BuildingName:             "서울특별시청",
+			EnglishAddress:           "110 Sejong-daero, Jung-gu, Seoul",
+			Zipcode:                  "04524",
+			LegalDongCode:            "1114010300",
+			AdminDongCode:            "1114055000",
+			Sido:                     "서울특별시",
+			Sigungu:                  "중구",
+			Dong:                     "태평로1가",
+			AdminDong:                "명동",
+			RoadName:                 "세종대로",
+			BuildingNo:               "110",
+			RefinedAddress:           "서울특별시 중구 세종대로 110",
+			MainNo:                   "31",
+			BuildingDong:             "101동",
+			BuildingUnit:             "1502호",
+			BuildingManagementNumber: "1114010300100310000000001",
+		},
+		Attempts: []Attempt{
+			{Provider: "vWorld", Success: false, Error: "address not found", RequestURL: "https://api.vworld.kr/req/address?key=REDACTED"},
+			{Provider: "Kakao", Success: true},
+		},
+	}
+}
+
+func TestResult_ProtoRoundTrip(t *testing.T) {
+	original := fullResult()
+
+	data, err := proto.Marshal(original.ToProto())
+	require.NoError(t, err)
+
+	var decoded geocodingv1.Result
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	assert.Equal(t, original, ResultFromProto(&decoded))
+}
+
+func TestResult_ProtoRoundTrip_Minimal(t *testing.T) {
+	original := &Result{Latitude: 35.1796, Longitude: 129.0756, Provider: "vWorld"}
+
+	data, err := proto.Marshal(original.ToProto())
+	require.NoError(t, err)
+
+	var decoded geocodingv1.Result
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	restored := ResultFromProto(&decoded)
+	assert.Equal(t, original, restored)
+	assert.Nil(t, restored.AddressDetail)
+	assert.Nil(t, restored.Attempts)
+}
+
+func TestResult_ToProto_Nil(t *testing.T) {
+	var r *Result
+	assert.Nil(t, r.ToProto())
+	assert.Nil(t, ResultFromProto(nil))
+}
+
+func TestBatch_ProtoRoundTrip(t *testing.T) {
+	original := []*Result{
+		fullResult(),
+		nil, // 실패한 주소
+		{Latitude: 35.1796, Longitude: 129.0756, Provider: "vWorld"},
+	}
+
+	data, err := proto.Marshal(BatchToProto(original))
+	require.NoError(t, err)
+
+	var decoded geocodingv1.BatchResult
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	restored := BatchFromProto(&decoded)
+	require.Len(t, restored, 3)
+	assert.Equal(t, original, restored)
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 Our Sports Nation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geocoding
+
+import "github.com/oursportsnation/k-geocode/internal/utils"
+
+// AddressSimilarity scores from 0 to 1 how well matched corresponds to
+// input, typically a [Result.MatchedAddress] against the address that was
+// geocoded. Use it to reject loose matches with a threshold of your choice.
+//
+// Both addresses are normalized first (whitespace, parenthesized notes,
+// 시/도 abbreviations, "세종대로110" → "세종대로 110", "번지") and then compared
+// token by token. Building and lot numbers weigh twice as much as 시/도,
+// 시/군/구, 동/리 and road name tokens, so the same road with a different
+// number scores about 0.6. Addresses in different 시/도 score 0.
+//
+//	AddressSimilarity("서울 중구 세종대로110", "서울특별시 중구 세종대로 110") // 1
+//	AddressSimilarity("서울특별시 중구 세종대로 110", "서울특별시 중구 세종대로 112") // 0.6
+func AddressSimilarity(input, matched string) float64 {
+	return utils.AddressSimilarity(input, matched)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -33,14 +34,20 @@ func (m *MockGeocodingService) Geocode(ctx context.Context, address string, addr
 }
 
 // GeocodeBatch implements service.GeocodingServiceInterface
-func (m *MockGeocodingService) GeocodeBatch(ctx context.Context, addresses []string) (*model.BulkResponse, error) {
-	args := m.Called(ctx, addresses)
+func (m *MockGeocodingService) GeocodeBatch(ctx context.Context, addresses []string, addressType string) (*model.BulkResponse, error) {
+	args := m.Called(ctx, addresses, addressType)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.BulkResponse), args.Error(1)
 }
 
+// GeocodeBatchStream implements service.GeocodingServiceInterface
+func (m *MockGeocodingService) GeocodeBatchStream(ctx context.Context, addresses []string, opts service.BatchOptions, send func(index int, result *model.GeocodingResponse) error) error {
+	args := m.Called(ctx, addresses, opts, send)
+	return args.Error(0)
+}
+
 // MockCoordinator 코디네이터 모킹
 type MockCoordinator struct {
 	mock.Mock
@@ -142,11 +149,13 @@ func TestGeocodingHandler_Geocode_NotFound(t *testing.T) {
 	// 검증
 	assert.Equal(t, http.StatusNotFound, w.Code)
 
-	var resp model.GeocodingResponse
+	var resp model.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.False(t, resp.Success)
-	assert.Equal(t, "address not found", resp.Error)
+	assert.Equal(t, model.ErrorCodeAddressNotFound, resp.Error.Code)
+	assert.Equal(t, "address not found", resp.Error.Message)
+	require.NotNil(t, resp.Result)
+	assert.False(t, resp.Result.Success)
 
 	mockService.AssertExpectations(t)
 }
@@ -172,10 +181,11 @@ func TestGeocodingHandler_Geocode_InvalidRequest(t *testing.T) {
 	// 검증
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 
-	var resp map[string]string
+	var resp model.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Contains(t, resp["error"], "invalid request format")
+	assert.Equal(t, model.ErrorCodeInvalidRequest, resp.Error.Code)
+	assert.Contains(t, resp.Error.Message, "invalid request format")
 }
 
 func TestGeocodingHandler_Geocode_ServiceError(t *testing.T) {
@@ -204,10 +214,11 @@ func TestGeocodingHandler_Geocode_ServiceError(t *testing.T) {
 	// 검증
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 
-	var resp map[string]string
+	var resp model.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Equal(t, "internal server error", resp["error"])
+	assert.Equal(t, model.ErrorCodeInternal, resp.Error.Code)
+	assert.Equal(t, "internal server error", resp.Error.Message)
 
 	mockService.AssertExpectations(t)
 }
@@ -243,7 +254,7 @@ func TestGeocodingHandler_GeocodeBulk_Success(t *testing.T) {
 	expectedResp.Summary.Total = 2
 	expectedResp.Summary.Success = 2
 	expectedResp.Summary.Failed = 0
-	mockService.On("GeocodeBatch", mock.Anything, addresses).Return(expectedResp, nil)
+	mockService.On("GeocodeBatch", mock.Anything, addresses, "").Return(expectedResp, nil)
 
 	// Handler 생성
 	h := handler.NewGeocodingHandler(mockService, logger)
@@ -302,11 +313,11 @@ func TestGeocodingHandler_GeocodeBulk_TooManyAddresses(t *testing.T) {
 	// 검증
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 
-	var resp map[string]string
+	var resp model.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	// Gin의 binding validation이 먼저 실패하므로 "invalid request format" 에러 발생
-	assert.Equal(t, "invalid request format", resp["error"])
+	assert.Equal(t, model.ErrorCodeTooManyAddresses, resp.Error.Code)
+	assert.Equal(t, "maximum 100 addresses allowed", resp.Error.Message)
 }
 
 func TestGeocodingHandler_GeocodeBulk_InvalidRequest(t *testing.T) {
@@ -330,10 +341,11 @@ func TestGeocodingHandler_GeocodeBulk_InvalidRequest(t *testing.T) {
 	// 검증
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 
-	var resp map[string]string
+	var resp model.ErrorResponse
 	err := json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.NoError(t, err)
-	assert.Contains(t, resp["error"], "invalid request format")
+	assert.Equal(t, model.ErrorCodeInvalidRequest, resp.Error.Code)
+	assert.Contains(t, resp.Error.Message, "invalid request format")
 }
 
 func TestHealthHandler_Ping(t *testing.T) {
@@ -31,6 +31,11 @@ func (m *MockProvider) Disable(reason string) {
 	m.disableReason = reason
 }
 
+func (m *MockProvider) Enable() {
+	m.disabled = false
+	m.disableReason = ""
+}
+
 func (m *MockProvider) IsDisabled() bool {
 	return m.disabled
 }
@@ -204,7 +204,7 @@ func TestGeocodeBatch_Success(t *testing.T) {
 		"부산시 해운대구",
 	}
 	
-	resp, err := svc.GeocodeBatch(context.Background(), addresses)
+	resp, err := svc.GeocodeBatch(context.Background(), addresses, "")
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -253,7 +253,7 @@ func TestGeocodeBatch_PartialFailure(t *testing.T) {
 		"!@#$%",          // 잘못된 주소 (실패)
 	}
 	
-	resp, err := svc.GeocodeBatch(context.Background(), addresses)
+	resp, err := svc.GeocodeBatch(context.Background(), addresses, "")
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -277,7 +277,7 @@ func TestGeocodeBatch_Empty(t *testing.T) {
 	logger := zap.NewNop()
 	svc := service.NewGeocodingService(nil, logger)
 	
-	resp, err := svc.GeocodeBatch(context.Background(), []string{})
+	resp, err := svc.GeocodeBatch(context.Background(), []string{}, "")
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -316,7 +316,7 @@ func TestGeocodeBatch_ConcurrentProcessing(t *testing.T) {
 	}
 	
 	start := time.Now()
-	resp, err := svc.GeocodeBatch(context.Background(), addresses)
+	resp, err := svc.GeocodeBatch(context.Background(), addresses, "")
 	elapsed := time.Since(start)
 	
 	if err != nil {
@@ -14,6 +14,13 @@
 
 package geocoding
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
 // AddressType represents the type of Korean address format.
 type AddressType string
 
@@ -25,24 +32,315 @@ const (
 	AddressTypeParcel AddressType = "PARCEL"
 )
 
+// Strategy selects how the client calls its providers. See [Config.Strategy].
+type Strategy string
+
+const (
+	// StrategyFallback tries providers in order, moving to the next one only
+	// when the current one fails.
+	StrategyFallback Strategy = "fallback"
+
+	// StrategyParallel calls every provider concurrently and returns the
+	// first successful result, cancelling the others.
+	StrategyParallel Strategy = "parallel"
+
+	// StrategyAdaptive tries providers one after another like
+	// StrategyFallback, but orders them by their recent success rate so the
+	// provider that has been succeeding most often is tried first. See
+	// [Config.AdaptiveWindow].
+	StrategyAdaptive Strategy = "adaptive"
+
+	// StrategyBalanced tries providers one after another like
+	// StrategyFallback, but spreads load across several keys for the same
+	// provider (e.g. a comma-separated [Config.VWorldAPIKey]): the key tried
+	// first is picked at random, weighted by its remaining daily quota.
+	// Different providers are still tried in their usual order.
+	StrategyBalanced Strategy = "balanced"
+)
+
+// KakaoAnalyzeType selects how Kakao's address search matches the query.
+// See [Config.KakaoAnalyzeType].
+type KakaoAnalyzeType string
+
+const (
+	// KakaoAnalyzeSimilar also returns addresses similar to the query, such
+	// as a nearby building number. It finds more addresses but may return a
+	// wrong-but-similar one.
+	KakaoAnalyzeSimilar KakaoAnalyzeType = "similar"
+
+	// KakaoAnalyzeExact returns only addresses that match the query exactly.
+	KakaoAnalyzeExact KakaoAnalyzeType = "exact"
+)
+
+// MatchLevel describes how precisely a geocoding result matches the address.
+type MatchLevel string
+
+const (
+	// MatchLevelRooftop means the full address, including the building or
+	// lot number, was matched.
+	MatchLevelRooftop MatchLevel = "ROOFTOP"
+
+	// MatchLevelStreet means only the road or 동/리 was matched; the building
+	// or lot number was dropped. See [Config.RegionFallback].
+	MatchLevelStreet MatchLevel = "STREET"
+
+	// MatchLevelRegion means only an administrative region (구/군/시 or 시/도)
+	// was matched, and the coordinate is approximately its centroid.
+	// See [Config.RegionFallback].
+	MatchLevelRegion MatchLevel = "REGION"
+)
+
+// GeocodeOptions overrides client defaults for a single
+// [Client.GeocodeWithOptions] call. Zero-valued fields use the client defaults.
+type GeocodeOptions struct {
+	// AddressType is the address type to try first. Default: detected from
+	// the address.
+	AddressType AddressType
+
+	// Providers restricts this call to the named providers (e.g., "Kakao").
+	// Names are matched case-insensitively and tried in the client's provider
+	// order. Default: all configured providers.
+	Providers []string
+
+	// Timeout bounds the whole call, including fallbacks. Default: no
+	// deadline beyond the context and [Config.Timeout].
+	Timeout time.Duration
+
+	// SkipCache bypasses the result cache for this lookup. A successful
+	// result still refreshes the cache. It has no effect unless
+	// [Config.CacheTTL] is set.
+	SkipCache bool
+
+	// KakaoAnalyzeType overrides [Config.KakaoAnalyzeType] for this call.
+	// Default: the client configuration.
+	KakaoAnalyzeType KakaoAnalyzeType
+
+	// AddressTypes overrides [Config.VWorldAddressTypes] for this call.
+	// Default: the client configuration.
+	AddressTypes []AddressType
+
+	// BothCoordinates geocodes the address as a road address and as a
+	// parcel address and reports both matches in [Result.RoadCoordinate]
+	// and [Result.ParcelCoordinate], for uses such as surveying where the
+	// few meters between them matter. Only providers that can search by
+	// address type (currently vWorld) are used, and each type costs one
+	// provider call. The second lookup uses the counterpart address of the
+	// first match, and both lookups share [GeocodeOptions.Timeout].
+	// Latitude and Longitude hold the AddressType match (road by default),
+	// or the other one if it failed. Default: false.
+	BothCoordinates bool
+
+	// CRS is the coordinate reference system of the returned coordinate, as
+	// an EPSG code such as "EPSG:5179" (UTM-K) or "EPSG:5186" (Korea 2000
+	// Central Belt). A CRS other than WGS84 is produced natively by vWorld, so
+	// the lookup uses only vWorld; it fails if vWorld is not configured or
+	// Providers excludes it. Latitude holds the northing (Y) and Longitude
+	// the easting (X) of a projected CRS, and [Result.CRS] labels the result.
+	// Default: "EPSG:4326" (WGS84).
+	CRS string
+
+	// Bias is a WGS84 point that results should favor, for inputs such as
+	// place names ("City Hall Station") that exist in many regions. Providers
+	// that cannot take a location hint ignore it; currently it applies to the
+	// Kakao keyword fallback ([Config.KakaoKeywordFallback]). Default: none.
+	Bias *Coordinate
+
+	// BiasRadius limits results to within this many meters of Bias, up to
+	// 20000. Zero ranks results by distance from Bias without excluding any.
+	// It is ignored when Bias is nil.
+	BiasRadius int
+
+	// IncludeRaw attaches the raw JSON response of the provider that
+	// produced the result to [Result.Raw], with API keys redacted, for
+	// reproducing provider bugs. Raw responses are not cached, so the lookup
+	// always calls the providers. Default: false.
+	IncludeRaw bool
+}
+
+// BatchOptions overrides client defaults for a single
+// [Client.GeocodeBatchWithOptions] call.
+type BatchOptions struct {
+	// AddressType is applied to every address in the batch. Default: detected
+	// from each address.
+	AddressType AddressType
+
+	// Bounds drops results outside this bounding box; such items fail with
+	// [ErrOutsideBounds]. Unlike [Config.KoreanBounds], it applies to this
+	// call only and does not fall back to other providers. Default: nil (no
+	// filtering).
+	Bounds *Bounds
+}
+
+// BatchResult is the outcome of a single address in
+// [Client.GeocodeBatchWithOptions]. Exactly one of Result and Err is set.
+type BatchResult struct {
+	Result *Result
+	Err    error
+}
+
+// AddressValidation is the outcome of validating a single address with
+// [Client.ValidateBatch].
+type AddressValidation struct {
+	// Address is the input address as given.
+	Address string `json:"address"`
+
+	// Normalized is the address after whitespace, full-width character and
+	// legacy district name normalization. This is the form sent to providers.
+	Normalized string `json:"normalized"`
+
+	// Valid reports whether the normalized address passes basic validation.
+	// Invalid addresses fail geocoding without any provider call.
+	Valid bool `json:"valid"`
+
+	// AddressType is the detected address format, or empty if it could not
+	// be determined.
+	AddressType AddressType `json:"address_type,omitempty"`
+
+	// Zipcode is a 5-digit postal code found in the address, if any.
+	Zipcode string `json:"zipcode,omitempty"`
+}
+
 // Result represents a geocoding result containing WGS84 coordinates.
 type Result struct {
-	// Latitude is the WGS84 latitude coordinate.
+	// Latitude is the WGS84 latitude coordinate, or the northing (Y) when
+	// [Result.CRS] is set.
 	Latitude float64 `json:"latitude"`
 
-	// Longitude is the WGS84 longitude coordinate.
+	// Longitude is the WGS84 longitude coordinate, or the easting (X) when
+	// [Result.CRS] is set.
 	Longitude float64 `json:"longitude"`
 
 	// Provider is the name of the provider that returned this result (e.g., "vWorld", "Kakao").
 	Provider string `json:"provider"`
 
+	// Source is "keyword" when the result came from a place-name keyword
+	// search rather than an address search (see [Config.KakaoKeywordFallback]).
+	// It is empty for address search results.
+	Source string `json:"source,omitempty"`
+
+	// MatchLevel is the precision of the match. It is [MatchLevelRooftop]
+	// unless [Config.RegionFallback] matched a coarser form of the address
+	// or the provider itself reported a street or region match (Nominatim).
+	// Reverse geocoding results leave it empty.
+	MatchLevel MatchLevel `json:"match_level,omitempty"`
+
+	// Confidence estimates how closely the result matches the input address,
+	// from 0 to 1. Exact address matches score higher than similar-address or
+	// keyword matches, results with both road and parcel addresses resolved
+	// score slightly higher, and coordinates outside Korea or coarser
+	// [MatchLevel] results score lower. Compare it against a threshold rather
+	// than treating it as a probability.
+	Confidence float64 `json:"confidence,omitempty"`
+
+	// LowConfidence reports that Confidence is below [Config.MinConfidence]:
+	// no provider matched the address more confidently, so the best
+	// low-confidence match was returned instead of an error.
+	LowConfidence bool `json:"low_confidence,omitempty"`
+
+	// MatchedAddress is the provider's canonical form of the address it
+	// matched, e.g. "서울특별시 중구 세종대로 110" for the input
+	// "서울 중구 세종대로110": vWorld's refined address or Kakao's
+	// address_name. It is empty when the provider does not report one.
+	// Compare it with the input using [AddressSimilarity].
+	MatchedAddress string `json:"matched_address,omitempty"`
+
 	// AddressDetail contains additional address information if available.
 	AddressDetail *AddressDetail `json:"address_detail,omitempty"`
 
+	// RoadCoordinate is the coordinate matched for the road address (도로명)
+	// and ParcelCoordinate the one matched for the parcel address (지번).
+	// They are only set by [GeocodeOptions.BothCoordinates], and each is nil
+	// when that address type could not be matched exactly ([MatchLevelRooftop]).
+	RoadCoordinate   *Coordinate `json:"road_coordinate,omitempty"`
+	ParcelCoordinate *Coordinate `json:"parcel_coordinate,omitempty"`
+
+	// Snapped reports that Latitude and Longitude were moved onto the road
+	// network by [Client.GeocodeSnapped].
+	Snapped bool `json:"snapped,omitempty"`
+
+	// CRS is the coordinate reference system of Latitude and Longitude when
+	// [GeocodeOptions.CRS] selected one other than WGS84, e.g. "EPSG:5179".
+	// It is empty for WGS84 coordinates.
+	CRS string `json:"crs,omitempty"`
+
+	// IsFallback reports that no provider matched the address and Latitude
+	// and Longitude are [Config.FallbackCoordinate] or, with
+	// [Config.FallbackToRegionCenter], the office of the address's 시·도.
+	// Provider is empty and MatchLevel is [MatchLevelRegion] for a region
+	// center and empty otherwise. Callers should style such results as
+	// approximate.
+	IsFallback bool `json:"is_fallback,omitempty"`
+
+	// Raw is the provider's raw JSON response, with API keys redacted, when
+	// [GeocodeOptions.IncludeRaw] is set. When the provider made several
+	// requests, such as an address search followed by a keyword search, it is
+	// the last response. It is nil otherwise.
+	Raw json.RawMessage `json:"raw,omitempty"`
+
 	// Attempts contains the list of provider attempts made during geocoding.
 	Attempts []Attempt `json:"attempts,omitempty"`
 }
 
+// ResultHook post-processes a successful geocoding result; see
+// [Config.ResultHook]. Returning an error fails the result.
+type ResultHook func(ctx context.Context, result *Result) error
+
+// Coordinate is a WGS84 coordinate used as input to reverse geocoding.
+type Coordinate struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// FullResult is every representation of one address returned by
+// [Client.GeocodeFull]. Forms the matched provider does not supply are empty.
+type FullResult struct {
+	// Coordinate is the WGS84 coordinate of the address.
+	Coordinate Coordinate `json:"coordinate"`
+
+	// Provider is the name of the provider that matched the address.
+	Provider string `json:"provider"`
+
+	// MatchLevel is the precision of the match, as in [Result.MatchLevel].
+	// The address forms of a coarser match describe the matched street or
+	// region rather than the input address.
+	MatchLevel MatchLevel `json:"match_level,omitempty"`
+
+	// LowConfidence reports a match below [Config.MinConfidence], as in
+	// [Result.LowConfidence].
+	LowConfidence bool `json:"low_confidence,omitempty"`
+
+	// RoadAddress is the road-based address (도로명 주소).
+	RoadAddress string `json:"road_address,omitempty"`
+
+	// ParcelAddress is the parcel-based address (지번 주소).
+	ParcelAddress string `json:"parcel_address,omitempty"`
+
+	// EnglishAddress is the romanized road address.
+	EnglishAddress string `json:"english_address,omitempty"`
+
+	// Zipcode is the postal code.
+	Zipcode string `json:"zipcode,omitempty"`
+}
+
+// Bounds is a WGS84 bounding box.
+type Bounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// validate checks that b is a non-empty box within the WGS84 range.
+func (b Bounds) validate() error {
+	if b.MinLatitude < -90 || b.MaxLatitude > 90 || b.MinLongitude < -180 || b.MaxLongitude > 180 {
+		return errors.New("must be within WGS84 range")
+	}
+	if b.MinLatitude >= b.MaxLatitude || b.MinLongitude >= b.MaxLongitude {
+		return errors.New("minimum must be less than maximum")
+	}
+	return nil
+}
+
 // AddressDetail contains detailed address information returned by the provider.
 type AddressDetail struct {
 	// RoadAddress is the road-based address (도로명 주소).
@@ -54,8 +352,67 @@ type AddressDetail struct {
 	// BuildingName is the name of the building, if applicable.
 	BuildingName string `json:"building_name,omitempty"`
 
+	// EnglishAddress is the romanized road address, e.g.
+	// "110 Sejong-daero, Jung-gu, Seoul". Only the local dataset
+	// ([Config.LocalDatasetPath]) and the road name address API
+	// ([Config.JusoAPIKey]) supply it.
+	EnglishAddress string `json:"english_address,omitempty"`
+
 	// Zipcode is the postal code.
 	Zipcode string `json:"zipcode,omitempty"`
+
+	// LegalDongCode is the legal district code (법정동코드), truncated
+	// according to [Config.AdminCodeLength].
+	LegalDongCode string `json:"legal_dong_code,omitempty"`
+
+	// AdminDongCode is the administrative district code (행정동코드), truncated
+	// according to [Config.AdminCodeLength].
+	AdminDongCode string `json:"admin_dong_code,omitempty"`
+
+	// Sido is the province or metropolitan city (시·도), e.g. "서울특별시".
+	Sido string `json:"sido,omitempty"`
+
+	// Sigungu is the city, county or district (시·군·구), including a
+	// non-autonomous district, e.g. "성남시 분당구".
+	Sigungu string `json:"sigungu,omitempty"`
+
+	// Dong is the legal town or neighborhood (법정 읍·면·동).
+	Dong string `json:"dong,omitempty"`
+
+	// AdminDong is the administrative neighborhood (행정동).
+	AdminDong string `json:"admin_dong,omitempty"`
+
+	// RoadName is the road name (도로명), e.g. "세종대로".
+	RoadName string `json:"road_name,omitempty"`
+
+	// BuildingNo is the building number on the road, e.g. "110" or "12-3".
+	BuildingNo string `json:"building_no,omitempty"`
+
+	// RefinedAddress is the provider's normalized form of the input address,
+	// as returned (vWorld refined text). Empty for other providers.
+	RefinedAddress string `json:"refined_address,omitempty"`
+
+	// IsMountain reports whether the parcel is a mountain lot (산 번지).
+	IsMountain bool `json:"is_mountain,omitempty"`
+
+	// MainNo is the main parcel number (본번).
+	MainNo string `json:"main_no,omitempty"`
+
+	// SubNo is the sub parcel number (부번), empty when the parcel has none.
+	SubNo string `json:"sub_no,omitempty"`
+
+	// BuildingManagementNumber is the building management number
+	// (건물관리번호). Only the road name address API ([Config.JusoAPIKey])
+	// supplies it.
+	BuildingManagementNumber string `json:"building_management_number,omitempty"`
+
+	// BuildingDong is the apartment building number (동, e.g. "101동") taken
+	// from the input address. Providers geocode the building without it.
+	BuildingDong string `json:"building_dong,omitempty"`
+
+	// BuildingUnit is the unit number (호, e.g. "1502호") taken from the
+	// input address.
+	BuildingUnit string `json:"building_unit,omitempty"`
 }
 
 // Attempt records a single provider attempt during the geocoding process.
@@ -68,4 +425,25 @@ type Attempt struct {
 
 	// Error contains the error message if the attempt failed.
 	Error string `json:"error,omitempty"`
+
+	// RequestURL is the provider request URL with API keys redacted.
+	// It is only set when [Config.Debug] is enabled. Kakao sends its key in
+	// the Authorization header, which is never included.
+	RequestURL string `json:"request_url,omitempty"`
+}
+
+// ProviderStats is a snapshot of a provider's geocoding call counters,
+// returned by [Client.Stats].
+type ProviderStats struct {
+	// Calls is the total number of geocoding calls made to the provider.
+	Calls int64 `json:"calls"`
+
+	// Successes is the number of calls that found a result.
+	Successes int64 `json:"successes"`
+
+	// Failures is the number of calls that found no result or returned an error.
+	Failures int64 `json:"failures"`
+
+	// AvgLatency is the mean duration of a call, or 0 if none were made.
+	AvgLatency time.Duration `json:"avg_latency"`
 }